@@ -28,6 +28,7 @@ func NewGetCommand(cmdFactory cmdutil.Factory, streams genericclioptions.IOStrea
 	cmd.AddCommand(NewGetWorkerGroupCommand(cmdFactory, streams))
 	cmd.AddCommand(NewGetNodesCommand(cmdFactory, streams))
 	cmd.AddCommand(NewGetTokenCommand(cmdFactory, streams))
+	cmd.AddCommand(NewGetRevisionsCommand(cmdFactory, streams))
 	return cmd
 }
 
@@ -0,0 +1,141 @@
+package get
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/ray-project/kuberay/kubectl-plugin/pkg/util/client"
+	"github.com/ray-project/kuberay/kubectl-plugin/pkg/util/completion"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+type GetRevisionsOptions struct {
+	cmdFactory cmdutil.Factory
+	ioStreams  *genericclioptions.IOStreams
+	namespace  string
+	rayService string
+}
+
+var getRevisionsExample = templates.Examples(`
+		# Get the revision history of a RayService in the default namespace
+		kubectl ray get revisions my-rayservice
+
+		# Get the revision history of a RayService in a namespace
+		kubectl ray get revisions my-rayservice --namespace my-namespace
+
+		# Roll back the RayService to revision 2
+		kubectl annotate rayservice my-rayservice ray.io/rollback-to-revision=2
+	`)
+
+func NewGetRevisionsOptions(cmdFactory cmdutil.Factory, streams genericclioptions.IOStreams) *GetRevisionsOptions {
+	return &GetRevisionsOptions{
+		cmdFactory: cmdFactory,
+		ioStreams:  &streams,
+	}
+}
+
+func NewGetRevisionsCommand(cmdFactory cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.Command {
+	options := NewGetRevisionsOptions(cmdFactory, streams)
+
+	cmd := &cobra.Command{
+		Use:               "revisions [RAYSERVICE NAME]",
+		Aliases:           []string{"revision"},
+		Short:             "Get the revision history of a RayService.",
+		Example:           getRevisionsExample,
+		SilenceUsage:      true,
+		ValidArgsFunction: completion.RayServiceCompletionFunc(cmdFactory),
+		Args:              cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(args, cmd); err != nil {
+				return err
+			}
+			// running cmd.Execute or cmd.ExecuteE sets the context, which will be done by root
+			k8sClient, err := client.NewClient(cmdFactory)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+			return options.Run(cmd.Context(), k8sClient)
+		},
+	}
+	return cmd
+}
+
+func (options *GetRevisionsOptions) Complete(args []string, cmd *cobra.Command) error {
+	namespace, err := cmd.Flags().GetString("namespace")
+	if err != nil {
+		return fmt.Errorf("failed to get namespace: %w", err)
+	}
+	options.namespace = namespace
+	if options.namespace == "" {
+		options.namespace = "default"
+	}
+	// guarded by cobra.ExactArgs(1)
+	options.rayService = args[0]
+	return nil
+}
+
+func (options *GetRevisionsOptions) Run(ctx context.Context, k8sClient client.Client) error {
+	if _, err := k8sClient.RayClient().RayV1().RayServices(options.namespace).Get(ctx, options.rayService, v1.GetOptions{}); err != nil {
+		return fmt.Errorf("failed to get RayService %s/%s: %w", options.namespace, options.rayService, err)
+	}
+
+	revisionList, err := k8sClient.KubernetesClient().AppsV1().ControllerRevisions(options.namespace).List(ctx, v1.ListOptions{
+		LabelSelector: joinLabelMap(map[string]string{
+			utils.RayOriginatedFromCRNameLabelKey: options.rayService,
+			utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list revisions of RayService %s/%s: %w", options.namespace, options.rayService, err)
+	}
+
+	return printRevisions(revisionList.Items, options.ioStreams.Out)
+}
+
+// printRevisions prints the revisions sorted by revision number
+func printRevisions(revisions []appsv1.ControllerRevision, output io.Writer) error {
+	slices.SortFunc(revisions, func(a, b appsv1.ControllerRevision) int {
+		return cmp.Compare(a.Revision, b.Revision)
+	})
+
+	resultTablePrinter := printers.NewTablePrinter(printers.PrintOptions{})
+	resTable := &v1.Table{
+		ColumnDefinitions: []v1.TableColumnDefinition{
+			{Name: "Revision", Type: "string"},
+			{Name: "Name", Type: "string"},
+			{Name: "Cluster Hash", Type: "string"},
+			{Name: "Age", Type: "string"},
+		},
+	}
+
+	for _, revision := range revisions {
+		age := duration.HumanDuration(time.Since(revision.GetCreationTimestamp().Time))
+		if revision.GetCreationTimestamp().Time.IsZero() {
+			age = "<unknown>"
+		}
+		resTable.Rows = append(resTable.Rows, v1.TableRow{
+			Cells: []any{
+				strconv.FormatInt(revision.Revision, 10),
+				revision.Name,
+				revision.Annotations[utils.HashWithoutReplicasAndWorkersToDeleteKey],
+				age,
+			},
+		})
+	}
+
+	return resultTablePrinter.PrintObj(resTable, output)
+}
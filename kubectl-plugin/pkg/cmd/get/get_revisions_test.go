@@ -0,0 +1,89 @@
+package get
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/ray-project/kuberay/kubectl-plugin/pkg/util/client"
+	clienttesting "github.com/ray-project/kuberay/kubectl-plugin/pkg/util/client/testing"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// Tests the Run() step of the command and ensure that the output is as expected.
+func TestGetRevisionsRun(t *testing.T) {
+	cmdFactory := cmdutil.NewFactory(genericclioptions.NewConfigFlags(true))
+
+	testStreams, _, resBuf, _ := genericclioptions.NewTestIOStreams()
+	fakeGetRevisionsOptions := NewGetRevisionsOptions(cmdFactory, testStreams)
+
+	rayService := &rayv1.RayService{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "rayservice-sample",
+			Namespace: "test",
+		},
+	}
+
+	newRevision := func(name, rayServiceName, clusterHash string, revision int64) *appsv1.ControllerRevision {
+		return &appsv1.ControllerRevision{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+				Labels: map[string]string{
+					utils.RayOriginatedFromCRNameLabelKey: rayServiceName,
+					utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+				},
+				Annotations: map[string]string{
+					utils.HashWithoutReplicasAndWorkersToDeleteKey: clusterHash,
+				},
+			},
+			Revision: revision,
+		}
+	}
+
+	kubeClientSet := kubefake.NewClientset(
+		newRevision("rayservice-sample-bbbbbbbbbb", rayService.Name, "hash-2", 2),
+		newRevision("rayservice-sample-aaaaaaaaaa", rayService.Name, "hash-1", 1),
+		newRevision("other-rayservice-cccccccccc", "other-rayservice", "hash-3", 1),
+	)
+	rayClient := clienttesting.NewRayClientset(rayService)
+	k8sClients := client.NewClientForTesting(kubeClientSet, rayClient)
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringVarP(&fakeGetRevisionsOptions.namespace, "namespace", "n", rayService.Namespace, "")
+	err := fakeGetRevisionsOptions.Complete([]string{rayService.Name}, cmd)
+	require.NoError(t, err)
+	err = fakeGetRevisionsOptions.Run(t.Context(), k8sClients)
+	require.NoError(t, err)
+
+	expectedOutput := `REVISION   NAME                           CLUSTER HASH   AGE
+1          rayservice-sample-aaaaaaaaaa   hash-1         <unknown>
+2          rayservice-sample-bbbbbbbbbb   hash-2         <unknown>
+`
+	assert.Equal(t, expectedOutput, resBuf.String())
+}
+
+// Tests that the command fails if the RayService does not exist.
+func TestGetRevisionsRunRayServiceNotFound(t *testing.T) {
+	cmdFactory := cmdutil.NewFactory(genericclioptions.NewConfigFlags(true))
+
+	testStreams, _, _, _ := genericclioptions.NewTestIOStreams()
+	fakeGetRevisionsOptions := NewGetRevisionsOptions(cmdFactory, testStreams)
+
+	k8sClients := client.NewClientForTesting(kubefake.NewClientset(), clienttesting.NewRayClientset())
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringVarP(&fakeGetRevisionsOptions.namespace, "namespace", "n", "test", "")
+	err := fakeGetRevisionsOptions.Complete([]string{"rayservice-sample"}, cmd)
+	require.NoError(t, err)
+	err = fakeGetRevisionsOptions.Run(t.Context(), k8sClients)
+	require.ErrorContains(t, err, "failed to get RayService test/rayservice-sample")
+}
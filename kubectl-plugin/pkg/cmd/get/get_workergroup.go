@@ -5,12 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"math"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/printers"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
@@ -32,6 +37,8 @@ type GetWorkerGroupsOptions struct {
 }
 
 type enrichedWorkerGroupSpec struct {
+	// status is nil if the KubeRay operator doesn't report the status of the worker group.
+	status    *rayv1.WorkerGroupStatus
 	namespace string
 	cluster   string
 	spec      rayv1.WorkerGroupSpec
@@ -42,11 +49,13 @@ type workerGroup struct {
 	totalGPU        resource.Quantity
 	totalTPU        resource.Quantity
 	totalMemory     resource.Quantity
+	status          *rayv1.WorkerGroupStatus
 	namespace       string
 	name            string
 	cluster         string
 	readyReplicas   int32
 	desiredReplicas int32
+	availablePods   int32
 	minReplicas     int32
 	maxReplicas     int32
 }
@@ -154,24 +163,16 @@ func (options *GetWorkerGroupsOptions) Run(ctx context.Context, k8sClient client
 
 	enrichedWorkerGroupSpecs := []enrichedWorkerGroupSpec{}
 	for _, rayCluster := range rayClusters {
-		if options.workerGroup == "" {
-			for _, spec := range rayCluster.Spec.WorkerGroupSpecs {
-				enrichedWorkerGroupSpecs = append(enrichedWorkerGroupSpecs, enrichedWorkerGroupSpec{
-					namespace: rayCluster.Namespace,
-					cluster:   rayCluster.Name,
-					spec:      spec,
-				})
-			}
-		} else {
-			for _, spec := range rayCluster.Spec.WorkerGroupSpecs {
-				if spec.GroupName == options.workerGroup {
-					enrichedWorkerGroupSpecs = append(enrichedWorkerGroupSpecs, enrichedWorkerGroupSpec{
-						namespace: rayCluster.Namespace,
-						cluster:   rayCluster.Name,
-						spec:      spec,
-					})
-				}
+		for _, spec := range rayCluster.Spec.WorkerGroupSpecs {
+			if options.workerGroup != "" && spec.GroupName != options.workerGroup {
+				continue
 			}
+			enrichedWorkerGroupSpecs = append(enrichedWorkerGroupSpecs, enrichedWorkerGroupSpec{
+				namespace: rayCluster.Namespace,
+				cluster:   rayCluster.Name,
+				spec:      spec,
+				status:    findWorkerGroupStatus(rayCluster.Status.WorkerGroupStatuses, spec.GroupName),
+			})
 		}
 	}
 
@@ -206,6 +207,16 @@ func errorMessageForWorkerGroupNotFound(workerGroup, cluster, namespace string,
 	return errMsg
 }
 
+// findWorkerGroupStatus returns the status of the worker group named groupName, or nil if it isn't reported.
+func findWorkerGroupStatus(statuses []rayv1.WorkerGroupStatus, groupName string) *rayv1.WorkerGroupStatus {
+	for i := range statuses {
+		if statuses[i].GroupName == groupName {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
 // getWorkerGroupDetails takes an array of enrichedWorkerGroupSpecs, gets the corresponding K8s Pod, and returns an array of worker groups
 func getWorkerGroupDetails(ctx context.Context, enrichedWorkerGroupSpecs []enrichedWorkerGroupSpec, k8sClient client.Client) ([]workerGroup, error) {
 	var workerGroups []workerGroup
@@ -224,6 +235,13 @@ func getWorkerGroupDetails(ctx context.Context, enrichedWorkerGroupSpecs []enric
 		}
 
 		readyWorkerReplicas := calculateReadyReplicas(*podList)
+		availableWorkerPods := calculateAvailableReplicas(*podList)
+		if ewgs.status != nil {
+			// Prefer the status reported by the KubeRay operator, which only counts the replicas of
+			// multi-host worker groups whose Pods are all ready.
+			readyWorkerReplicas = ewgs.status.ReadyReplicaGroups
+			availableWorkerPods = ewgs.status.AvailableReplicas
+		}
 
 		workerGroupResources := calculateDesiredResourcesForWorkerGroup(ewgs.spec)
 
@@ -245,10 +263,12 @@ func getWorkerGroupDetails(ctx context.Context, enrichedWorkerGroupSpecs []enric
 			name:            ewgs.spec.GroupName,
 			readyReplicas:   readyWorkerReplicas,
 			desiredReplicas: *ewgs.spec.Replicas,
+			availablePods:   availableWorkerPods,
 			totalCPU:        *workerGroupResources.Cpu(),
 			totalGPU:        workerGroupResources[corev1.ResourceName(util.ResourceNvidiaGPU)],
 			totalTPU:        workerGroupResources[corev1.ResourceName(util.ResourceGoogleTPU)],
 			totalMemory:     *workerGroupResources.Memory(),
+			status:          ewgs.status,
 			cluster:         ewgs.cluster,
 			minReplicas:     minReplicas,
 			maxReplicas:     maxReplicas,
@@ -289,10 +309,13 @@ func printWorkerGroups(workerGroups []workerGroup, allNamespaces bool, output io
 		{Name: "Min", Type: "string"},
 		{Name: "Max", Type: "string"},
 		{Name: "Replicas", Type: "string"},
+		{Name: "Available", Type: "string"},
 		{Name: "CPUs", Type: "string"},
 		{Name: "GPUs", Type: "string"},
 		{Name: "TPUs", Type: "string"},
 		{Name: "Memory", Type: "string"},
+		{Name: "Pending", Type: "string"},
+		{Name: "Last Scaled", Type: "string"},
 		{Name: "Cluster", Type: "string"},
 	}...)
 
@@ -307,15 +330,28 @@ func printWorkerGroups(workerGroups []workerGroup, allNamespaces bool, output io
 		minStr := fmt.Sprintf("%d", wg.minReplicas)
 		maxStr := fmt.Sprintf("%d", wg.maxReplicas)
 
+		// The pending Pods and the last scale time are only known if the KubeRay operator reports them.
+		pending := "<unknown>"
+		lastScaled := "<unknown>"
+		if wg.status != nil {
+			pending = formatPendingPodReasons(wg.status.PendingPodReasons)
+			if wg.status.LastScaleTime != nil {
+				lastScaled = duration.HumanDuration(time.Since(wg.status.LastScaleTime.Time))
+			}
+		}
+
 		row.Cells = append(row.Cells, []any{
 			wg.name,
 			minStr,
 			maxStr,
 			fmt.Sprintf("%d/%d", wg.readyReplicas, wg.desiredReplicas),
+			fmt.Sprintf("%d", wg.availablePods),
 			wg.totalCPU.String(),
 			wg.totalGPU.String(),
 			wg.totalTPU.String(),
 			wg.totalMemory.String(),
+			pending,
+			lastScaled,
 			wg.cluster,
 		}...)
 
@@ -325,6 +361,18 @@ func printWorkerGroups(workerGroups []workerGroup, allNamespaces bool, output io
 	return resultTablePrinter.PrintObj(resTable, output)
 }
 
+// formatPendingPodReasons formats the number of pending Pods per reason, e.g. "ImagePullBackOff:1,Unschedulable:2".
+func formatPendingPodReasons(pendingPodReasons map[string]int32) string {
+	if len(pendingPodReasons) == 0 {
+		return "<none>"
+	}
+	reasons := make([]string, 0, len(pendingPodReasons))
+	for _, reason := range slices.Sorted(maps.Keys(pendingPodReasons)) {
+		reasons = append(reasons, fmt.Sprintf("%s:%d", reason, pendingPodReasons[reason]))
+	}
+	return strings.Join(reasons, ",")
+}
+
 // calculateDesiredResourcesForWorkerGroup calculates the desired resources for a worker group
 func calculateDesiredResourcesForWorkerGroup(workerGroupSpec rayv1.WorkerGroupSpec) corev1.ResourceList {
 	if workerGroupSpec.Suspend != nil && *workerGroupSpec.Suspend {
@@ -390,6 +438,22 @@ func calculateReadyReplicas(pods corev1.PodList) int32 {
 	return count
 }
 
+// calculateAvailableReplicas calculates available worker replicas
+// A worker is available if its Pod is running
+func calculateAvailableReplicas(pods corev1.PodList) int32 {
+	count := int32(0)
+	for _, pod := range pods.Items {
+		if val, ok := pod.Labels[util.RayNodeTypeLabelKey]; !ok || val != string(rayv1.WorkerNode) {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodRunning {
+			count++
+		}
+	}
+
+	return count
+}
+
 // isRunningAndReady returns true if Pod is in the PodRunning Phase, if it has a condition of PodReady.
 func isRunningAndReady(pod *corev1.Pod) bool {
 	if pod.Status.Phase != corev1.PodRunning {
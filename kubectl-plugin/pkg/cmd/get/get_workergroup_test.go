@@ -6,6 +6,7 @@ import (
 	"errors"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -189,6 +190,19 @@ func TestRayWorkerGroupsGetRun(t *testing.T) {
 					},
 				},
 			},
+			Status: rayv1.RayClusterStatus{
+				WorkerGroupStatuses: []rayv1.WorkerGroupStatus{
+					{
+						GroupName:          "group-1",
+						DesiredReplicas:    2,
+						ReadyReplicas:      1,
+						AvailableReplicas:  1,
+						ReadyReplicaGroups: 1,
+						PendingPodReasons:  map[string]int32{"Unschedulable": 1},
+						LastScaleTime:      &v1.Time{Time: time.Now().Add(-2 * time.Hour)},
+					},
+				},
+			},
 		},
 	}
 
@@ -272,11 +286,11 @@ func TestRayWorkerGroupsGetRun(t *testing.T) {
 			allNamespaces: true,
 			rayClusters:   rayClusters,
 			pods:          pods,
-			expected: `NAMESPACE     NAME      MIN   MAX          REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-namespace-1   group-1   1     5            1/1        2      1      1      1Gi      cluster-1
-namespace-1   group-2   0     2147483647   1/1        2      1      1      1Gi      cluster-2
-namespace-2   group-1   1     4            1/2        4      2      2      2Gi      cluster-1
-namespace-2   group-4   0     3            0/0        0      0      0      0        cluster-1
+			expected: `NAMESPACE     NAME      MIN   MAX          REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING           LAST SCALED   CLUSTER
+namespace-1   group-1   1     5            1/1        1           2      1      1      1Gi      <unknown>         <unknown>     cluster-1
+namespace-1   group-2   0     2147483647   1/1        1           2      1      1      1Gi      <unknown>         <unknown>     cluster-2
+namespace-2   group-1   1     4            1/2        1           4      2      2      2Gi      Unschedulable:1   120m          cluster-1
+namespace-2   group-4   0     3            0/0        0           0      0      0      0        <unknown>         <unknown>     cluster-1
 `,
 		},
 		{
@@ -288,10 +302,10 @@ namespace-2   group-4   0     3            0/0        0      0      0      0
 			// See https://github.com/kubernetes/client-go/issues/326
 			rayClusters: []runtime.Object{rayClusters[0], rayClusters[2]},
 			pods:        pods,
-			expected: `NAMESPACE     NAME      MIN   MAX   REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-namespace-1   group-1   1     5     1/1        2      1      1      1Gi      cluster-1
-namespace-2   group-1   1     4     1/2        4      2      2      2Gi      cluster-1
-namespace-2   group-4   0     3     0/0        0      0      0      0        cluster-1
+			expected: `NAMESPACE     NAME      MIN   MAX   REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING           LAST SCALED   CLUSTER
+namespace-1   group-1   1     5     1/1        1           2      1      1      1Gi      <unknown>         <unknown>     cluster-1
+namespace-2   group-1   1     4     1/2        1           4      2      2      2Gi      Unschedulable:1   120m          cluster-1
+namespace-2   group-4   0     3     0/0        0           0      0      0      0        <unknown>         <unknown>     cluster-1
 `,
 		},
 		{
@@ -300,9 +314,9 @@ namespace-2   group-4   0     3     0/0        0      0      0      0        clu
 			allNamespaces: false,
 			rayClusters:   rayClusters,
 			pods:          pods,
-			expected: `NAME      MIN   MAX          REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-group-1   1     5            1/1        2      1      1      1Gi      cluster-1
-group-2   0     2147483647   1/1        2      1      1      1Gi      cluster-2
+			expected: `NAME      MIN   MAX          REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING     LAST SCALED   CLUSTER
+group-1   1     5            1/1        1           2      1      1      1Gi      <unknown>   <unknown>     cluster-1
+group-2   0     2147483647   1/1        1           2      1      1      1Gi      <unknown>   <unknown>     cluster-2
 `,
 		},
 		{
@@ -315,8 +329,8 @@ group-2   0     2147483647   1/1        2      1      1      1Gi      cluster-2
 			// See https://github.com/kubernetes/client-go/issues/326
 			rayClusters: rayClusters[:1],
 			pods:        pods,
-			expected: `NAME      MIN   MAX   REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-group-1   1     5     1/1        2      1      1      1Gi      cluster-1
+			expected: `NAME      MIN   MAX   REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING     LAST SCALED   CLUSTER
+group-1   1     5     1/1        1           2      1      1      1Gi      <unknown>   <unknown>     cluster-1
 `,
 		},
 		{
@@ -325,9 +339,9 @@ group-1   1     5     1/1        2      1      1      1Gi      cluster-1
 			workerGroup:   "group-1",
 			rayClusters:   rayClusters,
 			pods:          pods,
-			expected: `NAMESPACE     NAME      MIN   MAX   REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-namespace-1   group-1   1     5     1/1        2      1      1      1Gi      cluster-1
-namespace-2   group-1   1     4     1/2        4      2      2      2Gi      cluster-1
+			expected: `NAMESPACE     NAME      MIN   MAX   REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING           LAST SCALED   CLUSTER
+namespace-1   group-1   1     5     1/1        1           2      1      1      1Gi      <unknown>         <unknown>     cluster-1
+namespace-2   group-1   1     4     1/2        1           4      2      2      2Gi      Unschedulable:1   120m          cluster-1
 `,
 		},
 		{
@@ -340,9 +354,9 @@ namespace-2   group-1   1     4     1/2        4      2      2      2Gi      clu
 			// See https://github.com/kubernetes/client-go/issues/326
 			rayClusters: []runtime.Object{rayClusters[0], rayClusters[2]},
 			pods:        pods,
-			expected: `NAMESPACE     NAME      MIN   MAX   REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-namespace-1   group-1   1     5     1/1        2      1      1      1Gi      cluster-1
-namespace-2   group-1   1     4     1/2        4      2      2      2Gi      cluster-1
+			expected: `NAMESPACE     NAME      MIN   MAX   REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING           LAST SCALED   CLUSTER
+namespace-1   group-1   1     5     1/1        1           2      1      1      1Gi      <unknown>         <unknown>     cluster-1
+namespace-2   group-1   1     4     1/2        1           4      2      2      2Gi      Unschedulable:1   120m          cluster-1
 `,
 		},
 		{
@@ -352,8 +366,8 @@ namespace-2   group-1   1     4     1/2        4      2      2      2Gi      clu
 			workerGroup:   "group-1",
 			rayClusters:   rayClusters,
 			pods:          pods,
-			expected: `NAME      MIN   MAX   REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-group-1   1     5     1/1        2      1      1      1Gi      cluster-1
+			expected: `NAME      MIN   MAX   REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING     LAST SCALED   CLUSTER
+group-1   1     5     1/1        1           2      1      1      1Gi      <unknown>   <unknown>     cluster-1
 `,
 		},
 		{
@@ -367,8 +381,8 @@ group-1   1     5     1/1        2      1      1      1Gi      cluster-1
 			// See https://github.com/kubernetes/client-go/issues/326
 			rayClusters: rayClusters[:1],
 			pods:        pods,
-			expected: `NAME      MIN   MAX   REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-group-1   1     5     1/1        2      1      1      1Gi      cluster-1
+			expected: `NAME      MIN   MAX   REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING     LAST SCALED   CLUSTER
+group-1   1     5     1/1        1           2      1      1      1Gi      <unknown>   <unknown>     cluster-1
 `,
 		},
 		{
@@ -562,6 +576,13 @@ func TestGetWorkerGroupDetails(t *testing.T) {
 		},
 	}
 
+	// The status reported by the KubeRay operator takes precedence over the Pods.
+	workerGroupStatus := &rayv1.WorkerGroupStatus{
+		GroupName:          "group-1",
+		AvailableReplicas:  2,
+		ReadyReplicaGroups: 0,
+	}
+
 	tests := []struct {
 		name                     string
 		enrichedWorkerGroupSpecs []enrichedWorkerGroupSpec
@@ -625,6 +646,7 @@ func TestGetWorkerGroupDetails(t *testing.T) {
 						Replicas:  ptr.To(int32(1)),
 						Template:  podTemplate,
 					},
+					status: workerGroupStatus,
 				},
 			},
 			pods: pods,
@@ -636,6 +658,7 @@ func TestGetWorkerGroupDetails(t *testing.T) {
 					maxReplicas:     math.MaxInt32,
 					readyReplicas:   1,
 					desiredReplicas: 1,
+					availablePods:   1,
 					totalCPU:        *resources.Cpu(),
 					totalGPU:        *resources.Name(util.ResourceNvidiaGPU, resource.DecimalSI),
 					totalTPU:        *resources.Name(util.ResourceGoogleTPU, resource.DecimalSI),
@@ -648,6 +671,7 @@ func TestGetWorkerGroupDetails(t *testing.T) {
 					maxReplicas:     math.MaxInt32,
 					readyReplicas:   1,
 					desiredReplicas: 1,
+					availablePods:   1,
 					totalCPU:        *resources.Cpu(),
 					totalGPU:        *resources.Name(util.ResourceNvidiaGPU, resource.DecimalSI),
 					totalTPU:        *resources.Name(util.ResourceGoogleTPU, resource.DecimalSI),
@@ -658,8 +682,10 @@ func TestGetWorkerGroupDetails(t *testing.T) {
 					cluster:         "cluster-1",
 					name:            "group-1",
 					maxReplicas:     math.MaxInt32,
-					readyReplicas:   1,
+					readyReplicas:   0,
 					desiredReplicas: 1,
+					availablePods:   2,
+					status:          workerGroupStatus,
 					totalCPU:        *resources.Cpu(),
 					totalGPU:        *resources.Name(util.ResourceNvidiaGPU, resource.DecimalSI),
 					totalTPU:        *resources.Name(util.ResourceGoogleTPU, resource.DecimalSI),
@@ -767,12 +793,17 @@ func TestPrintWorkerGroups(t *testing.T) {
 			name:            "pod-2",
 			readyReplicas:   3,
 			desiredReplicas: 3,
+			availablePods:   3,
 			minReplicas:     1,
 			maxReplicas:     5,
 			totalCPU:        *resources.Cpu(),
 			totalGPU:        *resources.Name(util.ResourceNvidiaGPU, resource.DecimalSI),
 			totalTPU:        *resources.Name(util.ResourceGoogleTPU, resource.DecimalSI),
 			totalMemory:     *resources.Memory(),
+			status: &rayv1.WorkerGroupStatus{
+				GroupName:     "pod-2",
+				LastScaleTime: &v1.Time{Time: time.Now().Add(-5 * time.Minute)},
+			},
 		},
 	}
 
@@ -784,17 +815,17 @@ func TestPrintWorkerGroups(t *testing.T) {
 		{
 			name:          "one namespace",
 			allNamespaces: false,
-			expected: `NAME    MIN   MAX          REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-pod-1   0     2147483647   1/2        1      1      1      1Gi      cluster-1
-pod-2   1     5            3/3        1      1      1      1Gi      cluster-2
+			expected: `NAME    MIN   MAX          REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING     LAST SCALED   CLUSTER
+pod-1   0     2147483647   1/2        0           1      1      1      1Gi      <unknown>   <unknown>     cluster-1
+pod-2   1     5            3/3        3           1      1      1      1Gi      <none>      5m            cluster-2
 `,
 		},
 		{
 			name:          "all namespaces",
 			allNamespaces: true,
-			expected: `NAMESPACE     NAME    MIN   MAX          REPLICAS   CPUS   GPUS   TPUS   MEMORY   CLUSTER
-namespace-1   pod-1   0     2147483647   1/2        1      1      1      1Gi      cluster-1
-namespace-2   pod-2   1     5            3/3        1      1      1      1Gi      cluster-2
+			expected: `NAMESPACE     NAME    MIN   MAX          REPLICAS   AVAILABLE   CPUS   GPUS   TPUS   MEMORY   PENDING     LAST SCALED   CLUSTER
+namespace-1   pod-1   0     2147483647   1/2        0           1      1      1      1Gi      <unknown>   <unknown>     cluster-1
+namespace-2   pod-2   1     5            3/3        3           1      1      1      1Gi      <none>      5m            cluster-2
 `,
 		},
 	}
@@ -2,16 +2,17 @@ package v1alpha1
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	kaischeduler "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/kai-scheduler"
-	schedulerplugins "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/scheduler-plugins"
-	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/volcano"
-	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/yunikorn"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
-func ValidateBatchSchedulerConfig(logger logr.Logger, config Configuration) error {
+// ValidateBatchSchedulerConfig validates the batch scheduler options. registeredSchedulers are the names of the batch
+// scheduler plugins compiled into the operator, which batchscheduler.RegisteredBatchSchedulers returns.
+func ValidateBatchSchedulerConfig(logger logr.Logger, config Configuration, registeredSchedulers []string) error {
 	if config.EnableBatchScheduler && len(config.BatchScheduler) > 0 {
 		return fmt.Errorf("both feature flags enable-batch-scheduler (deprecated) and batch-scheduler are set. Please use batch-scheduler only")
 	}
@@ -23,14 +24,87 @@ func ValidateBatchSchedulerConfig(logger logr.Logger, config Configuration) erro
 	}
 
 	if len(config.BatchScheduler) > 0 {
-		// if a customized scheduler is configured, check it is supported
-		if config.BatchScheduler == volcano.GetPluginName() || config.BatchScheduler == yunikorn.GetPluginName() || config.BatchScheduler == schedulerplugins.GetPluginName() || config.BatchScheduler == kaischeduler.GetPluginName() {
+		// if a customized scheduler is configured, check it is registered
+		if slices.Contains(registeredSchedulers, config.BatchScheduler) {
 			logger.Info("Feature flag batch-scheduler is enabled",
 				"scheduler name", config.BatchScheduler)
 		} else {
-			return fmt.Errorf("scheduler is not supported, name=%s", config.BatchScheduler)
+			return fmt.Errorf("scheduler is not supported, name=%s, registered schedulers=%v", config.BatchScheduler, registeredSchedulers)
 		}
+	} else if config.BatchSchedulerConfig != nil {
+		return fmt.Errorf("batchSchedulerConfig is set without batchScheduler")
 	}
 
 	return nil
 }
+
+func ValidateDashboardClientConfig(config Configuration) error {
+	c := config.DashboardClient
+	if c == nil {
+		return nil
+	}
+	durations := []struct {
+		value *metav1.Duration
+		name  string
+	}{
+		{c.Timeout, "timeout"},
+		{c.InitialBackoff, "initialBackoff"},
+		{c.MaxBackoff, "maxBackoff"},
+		{c.CircuitBreakerOpenDuration, "circuitBreakerOpenDuration"},
+	}
+	for _, d := range durations {
+		if d.value != nil && d.value.Duration < 0 {
+			return fmt.Errorf("dashboardClient.%s must not be negative, got %s", d.name, d.value.Duration)
+		}
+	}
+	if c.MaxRetries != nil && *c.MaxRetries < 0 {
+		return fmt.Errorf("dashboardClient.maxRetries must not be negative, got %d", *c.MaxRetries)
+	}
+	if c.CircuitBreakerFailureThreshold != nil && *c.CircuitBreakerFailureThreshold < 0 {
+		return fmt.Errorf("dashboardClient.circuitBreakerFailureThreshold must not be negative, got %d", *c.CircuitBreakerFailureThreshold)
+	}
+	return nil
+}
+
+func ValidateAsyncJobInfoQueryConfig(config Configuration) error {
+	c := config.AsyncJobInfoQuery
+	if c == nil {
+		return nil
+	}
+	if c.Workers != nil && *c.Workers <= 0 {
+		return fmt.Errorf("asyncJobInfoQuery.workers must be positive, got %d", *c.Workers)
+	}
+	if c.CacheSize != nil && *c.CacheSize <= 0 {
+		return fmt.Errorf("asyncJobInfoQuery.cacheSize must be positive, got %d", *c.CacheSize)
+	}
+	if c.QueryInterval != nil && c.QueryInterval.Duration <= 0 {
+		return fmt.Errorf("asyncJobInfoQuery.queryInterval must be positive, got %s", c.QueryInterval.Duration)
+	}
+	if c.CacheExpiry != nil && c.CacheExpiry.Duration <= 0 {
+		return fmt.Errorf("asyncJobInfoQuery.cacheExpiry must be positive, got %s", c.CacheExpiry.Duration)
+	}
+	return nil
+}
+
+func ValidateAcceleratorsConfig(config Configuration) error {
+	resourceNames := map[string]bool{}
+	for i, accelerator := range config.Accelerators {
+		if accelerator.ResourceName == "" {
+			return fmt.Errorf("accelerators[%d].resourceName is required", i)
+		}
+		if resourceNames[accelerator.ResourceName] {
+			return fmt.Errorf("accelerators[%d].resourceName %q is duplicated", i, accelerator.ResourceName)
+		}
+		resourceNames[accelerator.ResourceName] = true
+		if accelerator.RayResourceName == "" {
+			return fmt.Errorf("accelerators[%d].rayResourceName is required", i)
+		}
+		if slices.Contains([]string{"CPU", "memory", "object_store_memory"}, accelerator.RayResourceName) {
+			return fmt.Errorf("accelerators[%d].rayResourceName must not be the Ray resource %q", i, accelerator.RayResourceName)
+		}
+		if accelerator.Type != "" && accelerator.Type != utils.AcceleratorTypeGPU && accelerator.Type != utils.AcceleratorTypeTPU {
+			return fmt.Errorf("accelerators[%d].type must be %q, %q, or empty, got %q", i, utils.AcceleratorTypeGPU, utils.AcceleratorTypeTPU, accelerator.Type)
+		}
+	}
+	return nil
+}
@@ -2,17 +2,30 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	kaischeduler "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/kai-scheduler"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/kueue"
 	schedulerPlugins "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/scheduler-plugins"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/volcano"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/yunikorn"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
 )
 
 func TestValidateBatchSchedulerConfig(t *testing.T) {
+	registeredSchedulers := []string{
+		kaischeduler.GetPluginName(),
+		kueue.GetPluginName(),
+		schedulerPlugins.GetPluginName(),
+		volcano.GetPluginName(),
+		yunikorn.GetPluginName(),
+	}
 	type args struct {
 		logger logr.Logger
 		config Configuration
@@ -82,6 +95,16 @@ func TestValidateBatchSchedulerConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid option, batch-scheduler=kueue",
+			args: args{
+				logger: testr.New(t),
+				config: Configuration{
+					BatchScheduler: kueue.GetPluginName(),
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid option, invalid scheduler name",
 			args: args{
@@ -119,9 +142,182 @@ func TestValidateBatchSchedulerConfig(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := ValidateBatchSchedulerConfig(tt.args.logger, tt.args.config); (err != nil) != tt.wantErr {
+			if err := ValidateBatchSchedulerConfig(tt.args.logger, tt.args.config, registeredSchedulers); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBatchSchedulerConfig() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
+
+func TestValidateDashboardClientConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Configuration
+		wantErr bool
+	}{
+		{
+			name:    "no dashboard client configuration",
+			config:  Configuration{},
+			wantErr: false,
+		},
+		{
+			name: "valid dashboard client configuration",
+			config: Configuration{
+				DashboardClient: &DashboardClientConfiguration{
+					Timeout:                        &metav1.Duration{Duration: 5 * time.Second},
+					MaxRetries:                     ptr.To(0),
+					CircuitBreakerFailureThreshold: ptr.To(0),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative backoff",
+			config: Configuration{
+				DashboardClient: &DashboardClientConfiguration{
+					InitialBackoff: &metav1.Duration{Duration: -time.Second},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative max retries",
+			config: Configuration{
+				DashboardClient: &DashboardClientConfiguration{
+					MaxRetries: ptr.To(-1),
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateDashboardClientConfig(tt.config); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDashboardClientConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetDashboardClientOptions(t *testing.T) {
+	options := Configuration{}.GetDashboardClientOptions()
+	if options != dashboardclient.DefaultClientOptions() {
+		t.Errorf("GetDashboardClientOptions() = %+v, want the default options", options)
+	}
+
+	options = Configuration{
+		DashboardClient: &DashboardClientConfiguration{
+			MaxRetries:                 ptr.To(0),
+			CircuitBreakerOpenDuration: &metav1.Duration{Duration: time.Minute},
+		},
+	}.GetDashboardClientOptions()
+	want := dashboardclient.DefaultClientOptions()
+	want.MaxRetries = 0
+	want.CircuitBreakerOpenDuration = time.Minute
+	if options != want {
+		t.Errorf("GetDashboardClientOptions() = %+v, want %+v", options, want)
+	}
+}
+
+func TestValidateAsyncJobInfoQueryConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Configuration
+		wantErr bool
+	}{
+		{
+			name:    "no async job info query configuration",
+			config:  Configuration{},
+			wantErr: false,
+		},
+		{
+			name: "valid async job info query configuration",
+			config: Configuration{
+				AsyncJobInfoQuery: &AsyncJobInfoQueryConfiguration{
+					Workers:       ptr.To(4),
+					QueryInterval: &metav1.Duration{Duration: time.Second},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "zero cache size",
+			config: Configuration{
+				AsyncJobInfoQuery: &AsyncJobInfoQueryConfiguration{
+					CacheSize: ptr.To(0),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero query interval",
+			config: Configuration{
+				AsyncJobInfoQuery: &AsyncJobInfoQueryConfiguration{
+					QueryInterval: &metav1.Duration{},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateAsyncJobInfoQueryConfig(tt.config); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAsyncJobInfoQueryConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAcceleratorsConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		accelerators []AcceleratorConfiguration
+		wantErr      bool
+	}{
+		{
+			name:    "no accelerators configuration",
+			wantErr: false,
+		},
+		{
+			name: "valid accelerators configuration",
+			accelerators: []AcceleratorConfiguration{
+				{ResourceName: "example.com/npu", RayResourceName: "NPU"},
+				{ResourceName: "example.com/vgpu", RayResourceName: "GPU", Type: utils.AcceleratorTypeGPU},
+			},
+			wantErr: false,
+		},
+		{
+			name:         "missing Ray resource name",
+			accelerators: []AcceleratorConfiguration{{ResourceName: "example.com/npu"}},
+			wantErr:      true,
+		},
+		{
+			name: "duplicated resource name",
+			accelerators: []AcceleratorConfiguration{
+				{ResourceName: "example.com/npu", RayResourceName: "NPU"},
+				{ResourceName: "example.com/npu", RayResourceName: "NPU_V2"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "reserved Ray resource name",
+			accelerators: []AcceleratorConfiguration{{ResourceName: "example.com/npu", RayResourceName: "CPU"}},
+			wantErr:      true,
+		},
+		{
+			name:         "unknown type",
+			accelerators: []AcceleratorConfiguration{{ResourceName: "example.com/npu", RayResourceName: "NPU", Type: "NPU"}},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateAcceleratorsConfig(Configuration{Accelerators: tt.accelerators}); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAcceleratorsConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -48,10 +49,19 @@ type Configuration struct {
 	// resources live. Defaults to the pod namesapce if not set.
 	LeaderElectionNamespace string `json:"leaderElectionNamespace,omitempty"`
 
+	// OperatorNamespace is the namespace that the operator runs in, which the NetworkPolicies of RayClusters allow to
+	// reach the dashboard. Defaults to the pod namespace if not set. NetworkPolicies aren't created if the operator
+	// doesn't run in a Pod and it's not set.
+	OperatorNamespace string `json:"operatorNamespace,omitempty"`
+
 	// BatchScheduler enables the batch scheduler integration with a specific scheduler
-	// based on the given name, currently, supported values are volcano, yunikorn, kai-scheduler.
+	// based on the given name, currently, supported values are volcano, yunikorn, kai-scheduler, kueue.
 	BatchScheduler string `json:"batchScheduler,omitempty"`
 
+	// BatchSchedulerConfig is the plugin-specific configuration of the batch scheduler selected by BatchScheduler.
+	// It is decoded by the plugin, which must support configuration.
+	BatchSchedulerConfig *runtime.RawExtension `json:"batchSchedulerConfig,omitempty"`
+
 	// MetricsAddr is the address the metrics endpoint binds to.
 	MetricsAddr string `json:"metricsAddr,omitempty"`
 
@@ -70,6 +80,19 @@ type Configuration struct {
 	// DefaultContainerEnvs specifies default environment variables to inject into all Ray containers
 	DefaultContainerEnvs []corev1.EnvVar `json:"defaultContainerEnvs,omitempty"`
 
+	// DeviceClassResourceNames maps the names of DRA device classes to extended resource names, such as
+	// "gpu.nvidia.com" to "nvidia.com/gpu". Devices that the Ray container claims through ResourceClaimTemplates are
+	// translated to Ray resources like container resource limits of the mapped resources. When autoscaling is enabled,
+	// they're also added to the rayStartParams in the RayCluster spec, where the Ray autoscaler reads them. The entries
+	// are added to the default mapping of common GPU drivers, and an entry with an empty resource name removes a
+	// device class.
+	DeviceClassResourceNames map[string]string `json:"deviceClassResourceNames,omitempty"`
+
+	// Accelerators maps extended resources of the Ray container to Ray resources. The entries are added to the
+	// default mapping of "aws.amazon.com/neuroncore" and "google.com/tpu", and override the default entries and
+	// the GPUs detected from the resource names with the same resource names.
+	Accelerators []AcceleratorConfiguration `json:"accelerators,omitempty"`
+
 	// ReconcileConcurrency is the max concurrency for each reconciler.
 	ReconcileConcurrency int `json:"reconcileConcurrency,omitempty"`
 
@@ -88,12 +111,163 @@ type Configuration struct {
 
 	// EnableMetrics indicates whether KubeRay operator should emit control plane metrics.
 	EnableMetrics bool `json:"enableMetrics,omitempty"`
+
+	// DashboardClient configures the timeout, the retries, and the circuit breaker of the client
+	// that KubeRay uses to call the Ray dashboard of RayJobs and RayServices.
+	DashboardClient *DashboardClientConfiguration `json:"dashboardClient,omitempty"`
+
+	// AsyncJobInfoQuery configures the cache that fetches the job info of RayJobs in the background
+	// when the AsyncJobInfoQuery feature gate is enabled.
+	AsyncJobInfoQuery *AsyncJobInfoQueryConfiguration `json:"asyncJobInfoQuery,omitempty"`
+}
+
+// DashboardClientConfiguration configures the client that KubeRay uses to call the Ray dashboard.
+type DashboardClientConfiguration struct {
+	// Timeout is the timeout of a single request to the Ray dashboard.
+	// Defaults to 2s, or 10s if UseKubernetesProxy is set.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// MaxRetries is the maximum number of retries of idempotent requests, such as getting
+	// job info or Serve details, when the Ray dashboard is unreachable or unavailable.
+	// Default: 2
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// InitialBackoff is the backoff before the first retry. It doubles on each retry, with jitter, up to MaxBackoff.
+	// Default: 100ms
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff is the maximum backoff between retries.
+	// Default: 1s
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+
+	// CircuitBreakerFailureThreshold is the number of consecutive failed requests to a RayCluster's dashboard
+	// after which requests to it are short-circuited. Setting it to 0 disables the circuit breaker.
+	// Default: 5
+	CircuitBreakerFailureThreshold *int `json:"circuitBreakerFailureThreshold,omitempty"`
+
+	// CircuitBreakerOpenDuration is how long requests to an unhealthy Ray dashboard are short-circuited
+	// before a single probe request is let through.
+	// Default: 30s
+	CircuitBreakerOpenDuration *metav1.Duration `json:"circuitBreakerOpenDuration,omitempty"`
+}
+
+// AcceleratorConfiguration maps an extended resource to a Ray resource.
+type AcceleratorConfiguration struct {
+	// ResourceName is the name of the extended resource, such as "example.com/npu".
+	ResourceName string `json:"resourceName"`
+
+	// RayResourceName is the name of the Ray resource that the extended resource is exported as, such as "NPU".
+	// "GPU" is exported through the `num-gpus` option of `ray start`.
+	RayResourceName string `json:"rayResourceName"`
+
+	// Type is the type of the accelerator. Accelerators of type "GPU" count toward the DesiredGPU status of
+	// RayClusters, and accelerators of type "TPU" count toward DesiredTPU.
+	// +optional
+	Type utils.AcceleratorType `json:"type,omitempty"`
+}
+
+// AsyncJobInfoQueryConfiguration configures the worker pool and the storage of the job info cache.
+type AsyncJobInfoQueryConfiguration struct {
+	// Workers is the number of goroutines that query job info from the Ray dashboard.
+	// Default: 8
+	Workers *int `json:"workers,omitempty"`
+
+	// QueryInterval is the interval between two queries of the job info of a running Ray job.
+	// Default: 3s
+	QueryInterval *metav1.Duration `json:"queryInterval,omitempty"`
+
+	// CacheSize is the maximum number of cached job infos.
+	// Default: 10000
+	CacheSize *int `json:"cacheSize,omitempty"`
+
+	// CacheExpiry is how long a job info that is no longer updated stays in the cache.
+	// Default: 10m
+	CacheExpiry *metav1.Duration `json:"cacheExpiry,omitempty"`
+}
+
+// GetRayDashboardCacheOptions returns the job info cache options, using the defaults for the unset fields.
+func (config Configuration) GetRayDashboardCacheOptions() dashboardclient.RayDashboardCacheOptions {
+	options := dashboardclient.DefaultRayDashboardCacheOptions()
+	c := config.AsyncJobInfoQuery
+	if c == nil {
+		return options
+	}
+	if c.Workers != nil {
+		options.Workers = *c.Workers
+	}
+	if c.QueryInterval != nil {
+		options.QueryInterval = c.QueryInterval.Duration
+	}
+	if c.CacheSize != nil {
+		options.CacheSize = *c.CacheSize
+	}
+	if c.CacheExpiry != nil {
+		options.CacheExpiry = c.CacheExpiry.Duration
+	}
+	return options
+}
+
+// GetAccelerators returns the configured accelerators.
+func (config Configuration) GetAccelerators() []utils.Accelerator {
+	accelerators := make([]utils.Accelerator, 0, len(config.Accelerators))
+	for _, accelerator := range config.Accelerators {
+		accelerators = append(accelerators, utils.Accelerator{
+			ResourceName:    corev1.ResourceName(accelerator.ResourceName),
+			RayResourceName: accelerator.RayResourceName,
+			Type:            accelerator.Type,
+		})
+	}
+	return accelerators
+}
+
+// GetDashboardClientOptions returns the dashboard client options, using the defaults for the unset fields.
+func (config Configuration) GetDashboardClientOptions() dashboardclient.ClientOptions {
+	options := dashboardclient.DefaultClientOptions()
+	c := config.DashboardClient
+	if c == nil {
+		return options
+	}
+	if c.Timeout != nil {
+		options.Timeout = c.Timeout.Duration
+	}
+	if c.MaxRetries != nil {
+		options.MaxRetries = *c.MaxRetries
+	}
+	if c.InitialBackoff != nil {
+		options.InitialBackoff = c.InitialBackoff.Duration
+	}
+	if c.MaxBackoff != nil {
+		options.MaxBackoff = c.MaxBackoff.Duration
+	}
+	if c.CircuitBreakerFailureThreshold != nil {
+		options.CircuitBreakerFailureThreshold = *c.CircuitBreakerFailureThreshold
+	}
+	if c.CircuitBreakerOpenDuration != nil {
+		options.CircuitBreakerOpenDuration = c.CircuitBreakerOpenDuration.Duration
+	}
+	return options
+}
+
+// ClientProvider provides the Ray dashboard and HTTP proxy clients of the reconcilers. The dashboard clients of all
+// reconcilers share the circuit breakers of the provider.
+// +kubebuilder:object:generate=false
+type ClientProvider struct {
+	CircuitBreakers *dashboardclient.CircuitBreakers
+	Config          Configuration
+}
+
+// NewClientProvider creates a ClientProvider with its own circuit breakers.
+func NewClientProvider(config Configuration) ClientProvider {
+	return ClientProvider{
+		CircuitBreakers: dashboardclient.NewCircuitBreakers(),
+		Config:          config,
+	}
 }
 
-func (config Configuration) GetDashboardClient(ctx context.Context, mgr manager.Manager) func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error) {
-	return utils.GetRayDashboardClientFunc(ctx, mgr, config.UseKubernetesProxy)
+func (provider ClientProvider) GetDashboardClient(ctx context.Context, mgr manager.Manager) func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error) {
+	return utils.GetRayDashboardClientFunc(ctx, mgr, provider.Config.UseKubernetesProxy, provider.Config.GetDashboardClientOptions(), provider.CircuitBreakers)
 }
 
-func (config Configuration) GetHttpProxyClient(mgr manager.Manager) func(hostIp, podNamespace, podName string, port int) utils.RayHttpProxyClientInterface {
-	return utils.GetRayHttpProxyClientFunc(mgr, config.UseKubernetesProxy)
+func (provider ClientProvider) GetHttpProxyClient(mgr manager.Manager) func(rayCluster *rayv1.RayCluster, hostIp, podNamespace, podName string, port int) (utils.RayHttpProxyClientInterface, error) {
+	return utils.GetRayHttpProxyClientFunc(mgr, provider.Config.UseKubernetesProxy)
 }
@@ -6,9 +6,60 @@ package v1alpha1
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AcceleratorConfiguration) DeepCopyInto(out *AcceleratorConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcceleratorConfiguration.
+func (in *AcceleratorConfiguration) DeepCopy() *AcceleratorConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AcceleratorConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AsyncJobInfoQueryConfiguration) DeepCopyInto(out *AsyncJobInfoQueryConfiguration) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int)
+		**out = **in
+	}
+	if in.QueryInterval != nil {
+		in, out := &in.QueryInterval, &out.QueryInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CacheSize != nil {
+		in, out := &in.CacheSize, &out.CacheSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.CacheExpiry != nil {
+		in, out := &in.CacheExpiry, &out.CacheExpiry
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AsyncJobInfoQueryConfiguration.
+func (in *AsyncJobInfoQueryConfiguration) DeepCopy() *AsyncJobInfoQueryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AsyncJobInfoQueryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -28,6 +79,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		**out = **in
 	}
 	out.TypeMeta = in.TypeMeta
+	if in.BatchSchedulerConfig != nil {
+		in, out := &in.BatchSchedulerConfig, &out.BatchSchedulerConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.WorkerSidecarContainers != nil {
 		in, out := &in.WorkerSidecarContainers, &out.WorkerSidecarContainers
 		*out = make([]v1.Container, len(*in))
@@ -49,6 +105,28 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DeviceClassResourceNames != nil {
+		in, out := &in.DeviceClassResourceNames, &out.DeviceClassResourceNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Accelerators != nil {
+		in, out := &in.Accelerators, &out.Accelerators
+		*out = make([]AcceleratorConfiguration, len(*in))
+		copy(*out, *in)
+	}
+	if in.DashboardClient != nil {
+		in, out := &in.DashboardClient, &out.DashboardClient
+		*out = new(DashboardClientConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AsyncJobInfoQuery != nil {
+		in, out := &in.AsyncJobInfoQuery, &out.AsyncJobInfoQuery
+		*out = new(AsyncJobInfoQueryConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
@@ -68,3 +146,48 @@ func (in *Configuration) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardClientConfiguration) DeepCopyInto(out *DashboardClientConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CircuitBreakerFailureThreshold != nil {
+		in, out := &in.CircuitBreakerFailureThreshold, &out.CircuitBreakerFailureThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.CircuitBreakerOpenDuration != nil {
+		in, out := &in.CircuitBreakerOpenDuration, &out.CircuitBreakerOpenDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardClientConfiguration.
+func (in *DashboardClientConfiguration) DeepCopy() *DashboardClientConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardClientConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -2,8 +2,11 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -17,10 +20,22 @@ type RayClusterSpec struct {
 	// AuthOptions specifies the authentication options for the RayCluster.
 	// +optional
 	AuthOptions *AuthOptions `json:"authOptions,omitempty"`
+	// TLSOptions specifies the TLS options for the RayCluster. When set, KubeRay mounts the
+	// certificates into every Ray Pod and connects to the Ray dashboard and Serve proxy over HTTPS.
+	// +optional
+	TLSOptions *TLSOptions `json:"tlsOptions,omitempty"`
 	// Suspend indicates whether a RayCluster should be suspended.
 	// A suspended RayCluster will have head pods and worker pods deleted.
 	// +optional
 	Suspend *bool `json:"suspend,omitempty"`
+	// IdlePolicy suspends or deletes the RayCluster once it has been idle for a while. It's intended for standalone
+	// RayClusters used interactively and isn't supported for RayClusters created by a RayJob or a RayService.
+	// +optional
+	IdlePolicy *IdlePolicy `json:"idlePolicy,omitempty"`
+	// PriorityClassName is the priority class of the Ray Pods whose template doesn't set one. It's also propagated
+	// to the batch scheduler, for example to the Volcano PodGroup.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 	// ManagedBy is an optional configuration for the controller or entity that manages a RayCluster.
 	// The value must be either 'ray.io/kuberay-operator' or 'kueue.x-k8s.io/multikueue'.
 	// The kuberay-operator reconciles a RayCluster which doesn't have this field at all or
@@ -50,8 +65,105 @@ type RayClusterSpec struct {
 	// WorkerGroupSpecs are the specs for the worker pods
 	// +optional
 	WorkerGroupSpecs []WorkerGroupSpec `json:"workerGroupSpecs,omitempty"`
+	// VolumeClaimRetentionPolicy specifies whether the PersistentVolumeClaims created from the volume claim
+	// templates of the head and worker groups are deleted. By default, they are retained.
+	// +optional
+	VolumeClaimRetentionPolicy *VolumeClaimRetentionPolicy `json:"volumeClaimRetentionPolicy,omitempty"`
+	// NetworkIsolation makes KubeRay create a NetworkPolicy that restricts the ingress traffic to the Pods of the
+	// RayCluster. Traffic between the Pods of the RayCluster, and from the KubeRay operator and the submitter Pods of
+	// the RayJob in K8sJobMode that created the RayCluster to the dashboard port, is always allowed. The KubeRay
+	// operator can also reach the serving port of the RayClusters of a RayService, to check the health of Serve.
+	// +optional
+	NetworkIsolation *NetworkIsolation `json:"networkIsolation,omitempty"`
+	// ImagePrePull makes KubeRay create a DaemonSet for the head group and each worker group that pulls the images of
+	// the group on the nodes that its Pods can be scheduled on, so that new Pods don't wait for the images to be pulled.
+	// +optional
+	ImagePrePull *ImagePrePull `json:"imagePrePull,omitempty"`
+}
+
+// ImagePrePull configures the DaemonSets that pre-pull the images of a RayCluster. The DaemonSet Pods of a group use its
+// nodeSelector, node affinity, tolerations, and image pull secrets. The containers of the images run a static binary
+// from the helper image, so the images don't need to provide any command.
+type ImagePrePull struct {
+	// Resources are the compute resources of the containers of the DaemonSet Pods. Defaults to 10m CPU and 32Mi memory.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// HelperImage is the image whose statically linked `/bin/busybox` binary is copied into the DaemonSet Pods and run
+	// from the images of the group. Defaults to busybox:1.36.
+	// +optional
+	HelperImage string `json:"helperImage,omitempty"`
+}
+
+// NetworkIsolation configures the NetworkPolicy that KubeRay creates for a RayCluster.
+type NetworkIsolation struct {
+	// IngressFrom are the sources that are allowed to reach the ports of the head service, such as the client,
+	// dashboard, and serve ports. If empty, these ports are only reachable from the RayCluster, the KubeRay operator,
+	// and the submitter Pods of the RayJob in K8sJobMode that created the RayCluster.
+	// +optional
+	IngressFrom []networkingv1.NetworkPolicyPeer `json:"ingressFrom,omitempty"`
 }
 
+// VolumeClaimTemplate is a template of the PersistentVolumeClaims that KubeRay creates for each Pod of a group.
+// The claim of a Pod is named after the template, the RayCluster, the group, and the replica and host indices
+// of the Pod, so a Pod that replaces another one mounts the same claim.
+type VolumeClaimTemplate struct {
+	// Name is the name of the volume that mounts the claim in the Pods. Containers mount it with a volumeMount
+	// of the same name. It replaces a volume of the same name in the Pod template.
+	Name string `json:"name"`
+	// Spec is the spec of the PersistentVolumeClaims.
+	Spec corev1.PersistentVolumeClaimSpec `json:"spec"`
+}
+
+// VolumeClaimRetentionPolicy specifies when the PersistentVolumeClaims created from volume claim templates are deleted.
+type VolumeClaimRetentionPolicy struct {
+	// WhenDeleted specifies what happens to the claims when the RayCluster is deleted. Defaults to Retain.
+	// +kubebuilder:default:=Retain
+	// +optional
+	WhenDeleted VolumeClaimRetentionPolicyType `json:"whenDeleted,omitempty"`
+	// WhenScaled specifies what happens to the claims of a worker group that are no longer used by a Pod once
+	// the worker group is scaled down below their replica index, or the worker group or the volume claim template
+	// is removed. Defaults to Retain.
+	// +kubebuilder:default:=Retain
+	// +optional
+	WhenScaled VolumeClaimRetentionPolicyType `json:"whenScaled,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Retain;Delete
+type VolumeClaimRetentionPolicyType string
+
+const (
+	VolumeClaimRetentionPolicyRetain VolumeClaimRetentionPolicyType = "Retain"
+	VolumeClaimRetentionPolicyDelete VolumeClaimRetentionPolicyType = "Delete"
+)
+
+// IdlePolicy defines when and how KubeRay reclaims an idle RayCluster. A RayCluster is idle when it has no
+// pending or running Ray jobs, no alive actors, and no logical CPU, GPU, or custom resources in use, as reported
+// by the Ray dashboard.
+type IdlePolicy struct {
+	// Action is the action taken once the RayCluster has been idle for IdleTimeoutSeconds. Suspend sets
+	// `spec.suspend` to true, so that the RayCluster can be resumed later. Delete deletes the RayCluster.
+	// Defaults to Suspend.
+	// +kubebuilder:validation:Enum=Suspend;Delete
+	// +kubebuilder:default:=Suspend
+	// +optional
+	Action *IdleAction `json:"action,omitempty"`
+	// IdleTimeoutSeconds is how long the RayCluster must be idle before the action is taken.
+	// +kubebuilder:validation:Minimum=1
+	IdleTimeoutSeconds int32 `json:"idleTimeoutSeconds"`
+	// WarningPeriodSeconds is how long before the action a Warning event is emitted on the RayCluster.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	WarningPeriodSeconds *int32 `json:"warningPeriodSeconds,omitempty"`
+}
+
+type IdleAction string
+
+const (
+	IdleActionSuspend IdleAction = "Suspend"
+	IdleActionDelete  IdleAction = "Delete"
+)
+
 // +kubebuilder:validation:Enum=Recreate;None
 type RayClusterUpgradeType string
 
@@ -104,17 +216,87 @@ type AuthOptions struct {
 	// +kubebuilder:validation:Enum=disabled;token
 	// +optional
 	Mode AuthMode `json:"mode,omitempty"`
+
+	// TokenRotationPeriodSeconds is the period after which KubeRay rotates the auth token it generates.
+	// Ray accepts a single token, so KubeRay recreates all the Ray Pods at once after a rotation, starting
+	// with the head Pod, which interrupts the workloads running on the RayCluster.
+	// Rotation is not supported when SecretName is set or EnableK8sTokenAuth is true.
+	// +optional
+	TokenRotationPeriodSeconds *int32 `json:"tokenRotationPeriodSeconds,omitempty"`
+
+	// TokenRotationGracePeriodSeconds is the minimum time the previous token is kept in the Secret after a
+	// rotation, so that clients that still use it, including KubeRay itself, can fall back to it. The previous
+	// token is kept longer while any Ray Pod still uses it. Defaults to 600.
+	// +optional
+	TokenRotationGracePeriodSeconds *int32 `json:"tokenRotationGracePeriodSeconds,omitempty"`
 }
 
+// TLSOptions defines the TLS options for a RayCluster.
+type TLSOptions struct {
+	// SecretName is the name of the Secret in the RayCluster's namespace that contains the
+	// TLS materials. The Secret must have the data keys `tls.crt` and `tls.key` with the
+	// certificate and private key presented by Ray Pods and by KubeRay as a client, and
+	// `ca.crt` with the CA used to verify peers.
+	// KubeRay sets RAY_USE_TLS, RAY_TLS_SERVER_CERT, RAY_TLS_SERVER_KEY, and RAY_TLS_CA_CERT
+	// in Ray containers unless they are already set. These only secure Ray's gRPC traffic.
+	SecretName string `json:"secretName"`
+
+	// EnableHTTPS makes KubeRay, including the RayJob submitter, connect to the Ray dashboard and
+	// Serve proxy over HTTPS with the certificates in the Secret. Ray serves these endpoints over
+	// plain HTTP, so only set this if the Ray containers are configured to serve them over HTTPS.
+	// Defaults to false.
+	// +optional
+	EnableHTTPS *bool `json:"enableHTTPS,omitempty"`
+}
+
+// GcsStorageBackendType is the type of external storage that GCS persists its metadata to.
+// +kubebuilder:validation:Enum=Redis
+type GcsStorageBackendType string
+
+const (
+	// GcsStorageBackendRedis stores the GCS metadata in Redis. It is configured by the
+	// RedisAddress, RedisUsername and RedisPassword fields of GcsFaultToleranceOptions.
+	GcsStorageBackendRedis GcsStorageBackendType = "Redis"
+)
+
 // GcsFaultToleranceOptions contains configs for GCS FT
+// +kubebuilder:validation:XValidation:rule="(has(self.storageBackend) && self.storageBackend != 'Redis') || has(self.redisAddress) || has(self.managedRedis)",message="either redisAddress or managedRedis is required when the storage backend is Redis"
 type GcsFaultToleranceOptions struct {
+	// StorageBackend is the type of external storage used for GCS fault tolerance.
+	// Defaults to Redis.
+	// +optional
+	StorageBackend *GcsStorageBackendType `json:"storageBackend,omitempty"`
 	// +optional
 	RedisUsername *RedisCredential `json:"redisUsername,omitempty"`
 	// +optional
 	RedisPassword *RedisCredential `json:"redisPassword,omitempty"`
 	// +optional
 	ExternalStorageNamespace string `json:"externalStorageNamespace,omitempty"`
-	RedisAddress             string `json:"redisAddress"`
+	// RedisAddress is the address of the Redis server. It is required when the storage backend is Redis,
+	// unless ManagedRedis is set.
+	RedisAddress string `json:"redisAddress,omitempty"`
+	// ManagedRedis makes KubeRay create a Redis StatefulSet, Service, and password Secret owned by the
+	// RayCluster, and connect the head Pod to it. It can't be used together with RedisAddress,
+	// RedisUsername, or RedisPassword.
+	// +optional
+	ManagedRedis *ManagedRedisOptions `json:"managedRedis,omitempty"`
+}
+
+// ManagedRedisOptions configures the Redis that KubeRay creates for GCS fault tolerance.
+type ManagedRedisOptions struct {
+	// StorageSize is the size of the PersistentVolumeClaim that stores the Redis data. If it is not set,
+	// the data is stored in an emptyDir volume and is lost when the Redis Pod is deleted.
+	// +optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+	// StorageClassName is the StorageClass of the PersistentVolumeClaim that stores the Redis data.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Resources are the compute resources of the Redis container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Image is the Redis container image. Defaults to redis:7.4.
+	// +optional
+	Image string `json:"image,omitempty"`
 }
 
 // RedisCredential is the redis username/password or a reference to the source containing the username/password
@@ -151,6 +333,16 @@ type HeadGroupSpec struct {
 	// ServiceType is Kubernetes service type of the head service. it will be used by the workers to connect to the head pod
 	// +optional
 	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+	// PodDisruptionBudget configures the PodDisruptionBudget that KubeRay creates for the head Pod.
+	// No PodDisruptionBudget is created unless this field is set. Note that `minAvailable: 1` prevents
+	// the head Pod from ever being evicted, which blocks node drains until the RayCluster is deleted.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+	// VolumeClaimTemplates are templates of the PersistentVolumeClaims that KubeRay creates for the head Pod.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	VolumeClaimTemplates []VolumeClaimTemplate `json:"volumeClaimTemplates,omitempty"`
 }
 
 // WorkerGroupSpec are the specs for the worker pods
@@ -198,6 +390,114 @@ type WorkerGroupSpec struct {
 	// +kubebuilder:default:=1
 	// +optional
 	NumOfHosts int32 `json:"numOfHosts,omitempty"`
+	// PodDisruptionBudget configures the PodDisruptionBudget that KubeRay creates for the Pods of this worker group.
+	// If the RayCluster is created by a RayService and this field is not set, the worker group is protected with
+	// `maxUnavailable: 1`. Otherwise, no PodDisruptionBudget is created unless this field is set.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+	// ScalingSchedules override the replicas, minReplicas, or maxReplicas of this worker group during recurring time
+	// windows. While a window is active, KubeRay reconciles the worker group with the overridden fields without
+	// changing the RayCluster spec, and reports the window in the worker group status. If several windows are active
+	// at the same time, the first one in the list takes effect. If autoscaling is enabled, the scaling schedules can
+	// only override minReplicas and maxReplicas, and KubeRay keeps the Pods of the worker group within the overridden
+	// bounds. The bounds are reported in the worker group status for the autoscaler.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ScalingSchedules []ScalingSchedule `json:"scalingSchedules,omitempty"`
+	// VolumeClaimTemplates are templates of the PersistentVolumeClaims that KubeRay creates for each Pod of this
+	// worker group. They require the RayMultiHostIndexing feature gate, which assigns the replica indices.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	VolumeClaimTemplates []VolumeClaimTemplate `json:"volumeClaimTemplates,omitempty"`
+	// Topology configures the placement of the hosts of each replica of this worker group. It requires NumOfHosts
+	// greater than 1 and the RayMultiHostIndexing feature gate.
+	// +optional
+	Topology *WorkerGroupTopology `json:"topology,omitempty"`
+	// Fallback configures another worker group that takes over the replicas of this worker group while its Pods can't
+	// be scheduled or are repeatedly preempted, such as when spot capacity is unavailable. Only supported for RayClusters.
+	// +optional
+	Fallback *WorkerGroupFallback `json:"fallback,omitempty"`
+}
+
+// WorkerGroupFallback configures when a worker group falls back to another worker group, and for how long.
+// While the fallback is active, KubeRay creates the replicas of the worker group in the fallback group instead, and
+// adds the minReplicas and maxReplicas of the worker group to those of the fallback group. The RayCluster spec isn't
+// changed, so the Ray autoscaler keeps scaling the worker group, and its replicas move back once the fallback ends.
+// The active fallback is reported in the worker group status.
+type WorkerGroupFallback struct {
+	// GroupName is the name of the worker group to fall back to.
+	GroupName string `json:"groupName"`
+	// UnschedulableTimeoutSeconds is how long a Pod of the worker group can stay unschedulable before KubeRay falls
+	// back. Defaults to 300.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UnschedulableTimeoutSeconds *int32 `json:"unschedulableTimeoutSeconds,omitempty"`
+	// MaxPreemptions is the number of Pods of the worker group that can be preempted within PreemptionWindowSeconds
+	// before KubeRay falls back. Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxPreemptions *int32 `json:"maxPreemptions,omitempty"`
+	// PreemptionWindowSeconds is the length of the window in which MaxPreemptions preemptions trigger the fallback.
+	// Defaults to 600.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PreemptionWindowSeconds *int32 `json:"preemptionWindowSeconds,omitempty"`
+	// DurationSeconds is how long the fallback lasts before KubeRay restores the replicas of the worker group and
+	// tries it again. Defaults to 1800.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DurationSeconds *int32 `json:"durationSeconds,omitempty"`
+}
+
+// WorkerGroupTopology configures the placement of the hosts of each multi-host replica of a worker group.
+type WorkerGroupTopology struct {
+	// RequiredTopologyKey is the key of the node label whose value defines a topology domain, such as a TPU slice or
+	// an NVLink domain. All hosts of a replica must run on nodes in the same domain. KubeRay adds a required Pod
+	// affinity to the hosts of each replica, which pins them to the domain of the first scheduled host, and
+	// recreates the replica if its hosts are scheduled onto more than one domain.
+	// +kubebuilder:validation:MinLength=1
+	RequiredTopologyKey string `json:"requiredTopologyKey"`
+}
+
+// ScalingSchedule defines a recurring time window during which the replica settings of a worker group are overridden.
+type ScalingSchedule struct {
+	// Name identifies the scaling schedule within the worker group.
+	Name string `json:"name"`
+	// Schedule is the cron schedule, in the standard five-field format, on which the window starts.
+	Schedule string `json:"schedule"`
+	// TimeZone is the name of the IANA time zone in which the schedule is interpreted, such as "America/New_York".
+	// Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+	// DurationSeconds is the length of the window after each start time.
+	// +kubebuilder:validation:Minimum=1
+	DurationSeconds int32 `json:"durationSeconds"`
+	// Replicas overrides the number of desired Pods for the worker group during the window.
+	// It can't be set if autoscaling is enabled, since the autoscaler sets the replicas of the worker group.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// MinReplicas overrides the minimum number of desired Pods for the worker group during the window.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas overrides the maximum number of desired Pods for the worker group during the window.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// PodDisruptionBudgetSpec configures the PodDisruptionBudget that KubeRay creates for a head or worker group.
+// Exactly one of MinAvailable and MaxUnavailable must be set.
+type PodDisruptionBudgetSpec struct {
+	// MinAvailable is the number or percentage of Pods in the group that must remain available after an eviction.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of Pods in the group that can be unavailable after an eviction.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// UnhealthyPodEvictionPolicy defines the criteria for when unhealthy Pods should be considered for eviction.
+	// +optional
+	UnhealthyPodEvictionPolicy *policyv1.UnhealthyPodEvictionPolicyType `json:"unhealthyPodEvictionPolicy,omitempty"`
 }
 
 // ScaleStrategy to remove workers
@@ -294,6 +594,10 @@ type RayClusterStatus struct {
 	// DesiredTPU indicates total desired TPUs for the cluster
 	// +optional
 	DesiredTPU resource.Quantity `json:"desiredTPU,omitempty"`
+	// DesiredAccelerators indicates total desired accelerators for the cluster, keyed by the Ray resources
+	// that they are exported as, such as "GPU", "TPU", or "neuron_cores".
+	// +optional
+	DesiredAccelerators map[string]resource.Quantity `json:"desiredAccelerators,omitempty"`
 	// LastUpdateTime indicates last update timestamp for this cluster status.
 	// +nullable
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
@@ -341,12 +645,126 @@ type RayClusterStatus struct {
 	// It is named "replicas" to maintain backward compatibility.
 	// +optional
 	MaxWorkerReplicas int32 `json:"maxWorkerReplicas,omitempty"`
+	// WorkerGroupStatuses indicates the observed state of each worker group.
+	// +listType=map
+	// +listMapKey=groupName
+	// +optional
+	WorkerGroupStatuses []WorkerGroupStatus `json:"workerGroupStatuses,omitempty"`
+	// AuthToken records the rotation of the auth token generated by KubeRay.
+	// +optional
+	AuthToken *AuthTokenStatus `json:"authToken,omitempty"`
+	// Idle records the activity of a RayCluster with an idle policy.
+	// +optional
+	Idle *IdleStatus `json:"idle,omitempty"`
 	// observedGeneration is the most recent generation observed for this RayCluster. It corresponds to the
 	// RayCluster's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
+// AuthTokenStatus records the rotation of the auth token generated by KubeRay.
+type AuthTokenStatus struct {
+	// LastRotationTime is the time when the auth token was last rotated.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// NextRotationTime is the time when the auth token will be rotated next.
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+	// PreviousTokenExpirationTime is the time after which the previous auth token is removed from the Secret
+	// once no Ray Pod uses it. It is unset when there is no previous token.
+	// +optional
+	PreviousTokenExpirationTime *metav1.Time `json:"previousTokenExpirationTime,omitempty"`
+}
+
+// IdleStatus records the activity of a RayCluster with an idle policy.
+type IdleStatus struct {
+	// LastActivityTime is the last time the RayCluster was observed to be in use.
+	// +optional
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty"`
+	// WarningTime is the time the Warning event about the upcoming idle action was emitted. It's reset
+	// when the RayCluster is in use again.
+	// +optional
+	WarningTime *metav1.Time `json:"warningTime,omitempty"`
+}
+
+// WorkerGroupStatus describes the observed state of a worker group.
+type WorkerGroupStatus struct {
+	// GroupName is the name of the worker group.
+	GroupName string `json:"groupName"`
+	// DesiredReplicas indicates the desired number of worker Pods in the worker group,
+	// calculated as `replicas * numOfHosts`.
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+	// ReadyReplicas indicates the number of worker Pods in the worker group that are in the Ready state.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// AvailableReplicas indicates the number of worker Pods in the worker group that are running.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// ReadyReplicaGroups indicates the number of replicas of the worker group whose `numOfHosts` Pods are all
+	// in the Ready state. It only differs from ReadyReplicas for multi-host worker groups.
+	// +optional
+	ReadyReplicaGroups int32 `json:"readyReplicaGroups,omitempty"`
+	// PendingPodReasons maps the reasons why worker Pods are not running, such as Unschedulable or
+	// ImagePullBackOff, to the number of Pods in the worker group that are pending for that reason.
+	// +optional
+	PendingPodReasons map[string]int32 `json:"pendingPodReasons,omitempty"`
+	// LastScaleTime is the last time the desired number of worker Pods in the worker group changed.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+	// ActiveScalingSchedule is the scaling schedule whose window is currently applied to the worker group.
+	// +optional
+	ActiveScalingSchedule *ActiveScalingSchedule `json:"activeScalingSchedule,omitempty"`
+	// Preemptions is the number of Pods of the worker group that have been preempted, that is, disrupted by
+	// Kubernetes because the scheduler preempted them or the kubelet terminated them, such as on node reclamation.
+	// +optional
+	Preemptions int32 `json:"preemptions,omitempty"`
+	// LastPreemptionTime is the last time a Pod of the worker group was preempted.
+	// +optional
+	LastPreemptionTime *metav1.Time `json:"lastPreemptionTime,omitempty"`
+	// RecentPreemptionTimes are the times of the most recent preemptions that count toward the fallback of the worker
+	// group, up to `fallback.maxPreemptions` of them.
+	// +optional
+	RecentPreemptionTimes []metav1.Time `json:"recentPreemptionTimes,omitempty"`
+	// PreemptedPodUIDs are the UIDs of the preempted Pods of the worker group that still exist and are already
+	// counted in Preemptions, so that each Pod is counted once.
+	// +optional
+	PreemptedPodUIDs []string `json:"preemptedPodUIDs,omitempty"`
+	// ActiveFallback is the fallback that is currently applied to the worker group.
+	// +optional
+	ActiveFallback *ActiveFallback `json:"activeFallback,omitempty"`
+}
+
+// ActiveFallback describes a fallback of a worker group to another worker group.
+type ActiveFallback struct {
+	// GroupName is the name of the worker group that took over the replicas.
+	GroupName string `json:"groupName"`
+	// Reason is the reason why the worker group fell back.
+	Reason string `json:"reason"`
+	// StartTime is the time at which the fallback started.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the time at which the fallback ends.
+	EndTime metav1.Time `json:"endTime"`
+}
+
+// ActiveScalingSchedule describes the window of a scaling schedule that is applied to a worker group.
+type ActiveScalingSchedule struct {
+	// Name is the name of the scaling schedule.
+	Name string `json:"name"`
+	// StartTime is the time at which the window started.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the time at which the window ends.
+	EndTime metav1.Time `json:"endTime"`
+	// MinReplicas is the minimum number of replicas of the worker group during the window. Autoscalers must not
+	// scale the worker group below it.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of replicas of the worker group during the window. Autoscalers must not
+	// scale the worker group above it. It is unset if the worker group has no maximum.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
 type RayClusterConditionType string
 
 // Custom Reason for RayClusterCondition
@@ -355,6 +773,8 @@ const (
 	RayClusterPodsProvisioning     = "RayClusterPodsProvisioning"
 	HeadPodNotFound                = "HeadPodNotFound"
 	HeadPodRunningAndReady         = "HeadPodRunningAndReady"
+	AllImagesPrePulled             = "AllImagesPrePulled"
+	ImagesPrePulling               = "ImagesPrePulling"
 	// UnknownReason says that the reason for the condition is unknown.
 	UnknownReason = "Unknown"
 )
@@ -371,6 +791,9 @@ const (
 	RayClusterSuspending RayClusterConditionType = "RayClusterSuspending"
 	// RayClusterSuspended is set to true when all Pods belonging to a suspending RayCluster are deleted. Note that RayClusterSuspending and RayClusterSuspended cannot both be true at the same time.
 	RayClusterSuspended RayClusterConditionType = "RayClusterSuspended"
+	// RayClusterImagesPrePulled indicates whether the images of the RayCluster have been pulled on all the nodes that
+	// its Pods can be scheduled on. It's only set if .Spec.ImagePrePull is set.
+	RayClusterImagesPrePulled RayClusterConditionType = "ImagesPrePulled"
 )
 
 // HeadInfo gives info about head
@@ -74,6 +74,9 @@ const (
 	AppFailed                                        JobFailedReason = "AppFailed"
 	JobDeploymentStatusTransitionGracePeriodExceeded JobFailedReason = "JobDeploymentStatusTransitionGracePeriodExceeded"
 	ValidationFailed                                 JobFailedReason = "ValidationFailed"
+	// Preempted is the reason of a RayJob that fails, is retried, or is suspended because its RayCluster was preempted.
+	// It's kept until the next attempt of the RayJob starts.
+	Preempted JobFailedReason = "Preempted"
 )
 
 type JobSubmissionMode string
@@ -227,6 +230,12 @@ type RayJobSpec struct {
 	// Configurations of submitter k8s job.
 	// +optional
 	SubmitterConfig *SubmitterConfig `json:"submitterConfig,omitempty"`
+	// PriorityClassName is the priority class of the RayJob. It's propagated to the RayCluster and the submitter
+	// Pod, and to the batch scheduler, for example to the Volcano PodGroup or the Kueue Workload.
+	// If the RayCluster of a RayJob is preempted, the RayJob is retried without counting toward `backoffLimit`,
+	// or suspended if it's queued by a batch scheduler that admits suspended RayJobs.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 	// ManagedBy is an optional configuration for the controller or entity that manages a RayJob.
 	// The value must be either 'ray.io/kuberay-operator' or 'kueue.x-k8s.io/multikueue'.
 	// The kuberay-operator reconciles a RayJob which doesn't have this field at all or
@@ -296,6 +305,10 @@ type RayJobSpec struct {
 	// In case of transition to false a new RayCluster will be created.
 	// +optional
 	Suspend bool `json:"suspend,omitempty"`
+	// WaitForImagePrePull makes the RayJob wait for the ImagesPrePulled condition of the RayCluster to be true before
+	// it submits the job. The RayCluster must set imagePrePull. It can't be used with ClusterSelector.
+	// +optional
+	WaitForImagePrePull bool `json:"waitForImagePrePull,omitempty"`
 }
 
 // RayJobStatus defines the observed state of RayJob
@@ -84,6 +84,42 @@ type RayServiceUpgradeStrategy struct {
 	ClusterUpgradeOptions *ClusterUpgradeOptions `json:"clusterUpgradeOptions,omitempty"`
 }
 
+type ServeRolloutStrategyType string
+
+const (
+	// AllAtOnce strategy submits all Serve applications in the Serve config in a single request.
+	ServeRolloutAllAtOnce ServeRolloutStrategyType = "AllAtOnce"
+	// Sequential strategy submits the Serve applications one at a time and waits for each application to become
+	// RUNNING before submitting the next one.
+	ServeRolloutSequential ServeRolloutStrategyType = "Sequential"
+)
+
+type ServeRolloutStrategy struct {
+	// Type represents the strategy used when submitting the Serve applications to a RayCluster. Currently supports
+	// `AllAtOnce` and `Sequential`. Defaults to `AllAtOnce`.
+	// If an application fails to deploy with `Sequential`, the rollout stops, and the applications after it keep
+	// their previously submitted config until the Serve config changes. The status message of the failed application
+	// records that the rollout is stopped.
+	// +optional
+	Type *ServeRolloutStrategyType `json:"type,omitempty"`
+	// ApplicationOrder is the order in which the Serve applications are rolled out with the `Sequential` type, for
+	// example to deploy an application before the applications that depend on it. Applications that are not listed
+	// are rolled out after the listed ones, in the order of the Serve config.
+	// +optional
+	ApplicationOrder []string `json:"applicationOrder,omitempty"`
+}
+
+// ServeConfigV2Source references a key of a ConfigMap or Secret that contains the Serve config.
+// Exactly one of ConfigMapKeyRef and SecretKeyRef must be set.
+type ServeConfigV2Source struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the RayService's namespace.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// SecretKeyRef selects a key of a Secret in the RayService's namespace.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
 // RayServiceSpec defines the desired state of RayService
 type RayServiceSpec struct {
 	// RayClusterDeletionDelaySeconds specifies the delay, in seconds, before deleting old RayClusters.
@@ -91,6 +127,12 @@ type RayServiceSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	RayClusterDeletionDelaySeconds *int32 `json:"rayClusterDeletionDelaySeconds,omitempty"`
+	// RevisionHistoryLimit is the number of revisions of the RayCluster spec and Serve config to retain as
+	// ControllerRevisions. A retained revision can be restored with the `ray.io/rollback-to-revision` annotation.
+	// The default value is 10.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 	// Deprecated: This field is not used anymore. ref: https://github.com/ray-project/kuberay/issues/1685
 	// +optional
 	ServiceUnhealthySecondThreshold *int32 `json:"serviceUnhealthySecondThreshold,omitempty"`
@@ -103,6 +145,9 @@ type RayServiceSpec struct {
 	// UpgradeStrategy defines the scaling policy used when upgrading the RayService.
 	// +optional
 	UpgradeStrategy *RayServiceUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+	// ServeRolloutStrategy defines how the Serve applications are submitted to a RayCluster.
+	// +optional
+	ServeRolloutStrategy *ServeRolloutStrategy `json:"serveRolloutStrategy,omitempty"`
 	// ManagedBy is an optional configuration for the controller or entity that manages a RayService.
 	// The value must be either 'ray.io/kuberay-operator' or 'kueue.x-k8s.io/multikueue'.
 	// The kuberay-operator reconciles a RayService which doesn't have this field at all or
@@ -116,8 +161,13 @@ type RayServiceSpec struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	// Defines the applications and deployments to deploy, should be a YAML multi-line scalar string.
 	// +optional
-	ServeConfigV2  string         `json:"serveConfigV2,omitempty"`
-	RayClusterSpec RayClusterSpec `json:"rayClusterConfig"`
+	ServeConfigV2 string `json:"serveConfigV2,omitempty"`
+	// ServeConfigV2From references a ConfigMap or Secret key that contains the Serve config.
+	// KubeRay watches the referenced object and resubmits the Serve applications when its data changes.
+	// ServeConfigV2From and ServeConfigV2 are mutually exclusive.
+	// +optional
+	ServeConfigV2From *ServeConfigV2Source `json:"serveConfigV2From,omitempty"`
+	RayClusterSpec    RayClusterSpec       `json:"rayClusterConfig"`
 	// If the field is set to true, the value of the label `ray.io/serve` on the head Pod should always be false.
 	// Therefore, the head Pod's endpoint will not be added to the Kubernetes Serve service.
 	// +optional
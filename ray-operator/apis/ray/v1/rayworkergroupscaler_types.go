@@ -0,0 +1,92 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayWorkerGroupScalerSpec defines the desired state of RayWorkerGroupScaler
+type RayWorkerGroupScalerSpec struct {
+	// Replicas is the desired number of replicas of the target worker group. It is usually
+	// managed by a HorizontalPodAutoscaler or KEDA through the scale subresource, and it is
+	// clamped to the worker group's MinReplicas and MaxReplicas before being applied.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// RayClusterName is the name of the RayCluster in the same namespace that owns the worker group.
+	RayClusterName string `json:"rayClusterName"`
+	// GroupName is the name of the worker group to scale.
+	GroupName string `json:"groupName"`
+}
+
+// RayWorkerGroupScalerStatus defines the observed state of RayWorkerGroupScaler
+type RayWorkerGroupScalerStatus struct {
+	// Selector is the label selector of the worker group's Pods in string form, used by
+	// the HorizontalPodAutoscaler to collect Pod metrics.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+	// Represents the latest available observations of the RayWorkerGroupScaler's current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+	// Replicas is the number of replicas of the worker group that currently exist.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// observedGeneration is the most recent generation observed for this RayWorkerGroupScaler.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+type RayWorkerGroupScalerConditionType string
+
+// Custom Reason for RayWorkerGroupScalerCondition
+const (
+	WorkerGroupScaled            = "WorkerGroupScaled"
+	TargetRayClusterNotFound     = "RayClusterNotFound"
+	TargetWorkerGroupNotFound    = "WorkerGroupNotFound"
+	InTreeAutoscalingEnabled     = "InTreeAutoscalingEnabled"
+	WorkerGroupScalerSpecInvalid = "InvalidSpec"
+)
+
+const (
+	// RayWorkerGroupScalerReady indicates whether the RayWorkerGroupScaler is able to drive the replicas of its target worker group.
+	RayWorkerGroupScalerReady RayWorkerGroupScalerConditionType = "Ready"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+//+kubebuilder:printcolumn:name="cluster",type=string,JSONPath=".spec.rayClusterName",priority=0
+//+kubebuilder:printcolumn:name="group",type=string,JSONPath=".spec.groupName",priority=0
+//+kubebuilder:printcolumn:name="desired",type=integer,JSONPath=".spec.replicas",priority=0
+//+kubebuilder:printcolumn:name="current",type=integer,JSONPath=".status.replicas",priority=0
+//+kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+
+// +genclient
+// +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
+// +kubebuilder:resource:categories=all
+// +kubebuilder:storageversion
+//
+// RayWorkerGroupScaler exposes the scale subresource for a single worker group of a RayCluster,
+// so that a HorizontalPodAutoscaler or KEDA can drive the group's replicas.
+type RayWorkerGroupScaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RayWorkerGroupScalerSpec   `json:"spec,omitempty"`
+	Status            RayWorkerGroupScalerStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RayWorkerGroupScalerList contains a list of RayWorkerGroupScaler
+type RayWorkerGroupScalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RayWorkerGroupScaler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RayWorkerGroupScaler{}, &RayWorkerGroupScalerList{})
+}
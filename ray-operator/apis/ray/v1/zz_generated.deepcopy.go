@@ -6,10 +6,58 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveFallback) DeepCopyInto(out *ActiveFallback) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveFallback.
+func (in *ActiveFallback) DeepCopy() *ActiveFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveScalingSchedule) DeepCopyInto(out *ActiveScalingSchedule) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveScalingSchedule.
+func (in *ActiveScalingSchedule) DeepCopy() *ActiveScalingSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveScalingSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppStatus) DeepCopyInto(out *AppStatus) {
 	*out = *in
@@ -45,6 +93,16 @@ func (in *AuthOptions) DeepCopyInto(out *AuthOptions) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TokenRotationPeriodSeconds != nil {
+		in, out := &in.TokenRotationPeriodSeconds, &out.TokenRotationPeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TokenRotationGracePeriodSeconds != nil {
+		in, out := &in.TokenRotationGracePeriodSeconds, &out.TokenRotationGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthOptions.
@@ -57,6 +115,33 @@ func (in *AuthOptions) DeepCopy() *AuthOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthTokenStatus) DeepCopyInto(out *AuthTokenStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousTokenExpirationTime != nil {
+		in, out := &in.PreviousTokenExpirationTime, &out.PreviousTokenExpirationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthTokenStatus.
+func (in *AuthTokenStatus) DeepCopy() *AuthTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoscalerOptions) DeepCopyInto(out *AutoscalerOptions) {
 	*out = *in
@@ -254,6 +339,11 @@ func (in *DeletionStrategy) DeepCopy() *DeletionStrategy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GcsFaultToleranceOptions) DeepCopyInto(out *GcsFaultToleranceOptions) {
 	*out = *in
+	if in.StorageBackend != nil {
+		in, out := &in.StorageBackend, &out.StorageBackend
+		*out = new(GcsStorageBackendType)
+		**out = **in
+	}
 	if in.RedisUsername != nil {
 		in, out := &in.RedisUsername, &out.RedisUsername
 		*out = new(RedisCredential)
@@ -264,6 +354,11 @@ func (in *GcsFaultToleranceOptions) DeepCopyInto(out *GcsFaultToleranceOptions)
 		*out = new(RedisCredential)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManagedRedis != nil {
+		in, out := &in.ManagedRedis, &out.ManagedRedis
+		*out = new(ManagedRedisOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcsFaultToleranceOptions.
@@ -311,6 +406,18 @@ func (in *HeadGroupSpec) DeepCopyInto(out *HeadGroupSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]VolumeClaimTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeadGroupSpec.
@@ -338,6 +445,152 @@ func (in *HeadInfo) DeepCopy() *HeadInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdlePolicy) DeepCopyInto(out *IdlePolicy) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(IdleAction)
+		**out = **in
+	}
+	if in.WarningPeriodSeconds != nil {
+		in, out := &in.WarningPeriodSeconds, &out.WarningPeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdlePolicy.
+func (in *IdlePolicy) DeepCopy() *IdlePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IdlePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdleStatus) DeepCopyInto(out *IdleStatus) {
+	*out = *in
+	if in.LastActivityTime != nil {
+		in, out := &in.LastActivityTime, &out.LastActivityTime
+		*out = (*in).DeepCopy()
+	}
+	if in.WarningTime != nil {
+		in, out := &in.WarningTime, &out.WarningTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdleStatus.
+func (in *IdleStatus) DeepCopy() *IdleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IdleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrePull) DeepCopyInto(out *ImagePrePull) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrePull.
+func (in *ImagePrePull) DeepCopy() *ImagePrePull {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrePull)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRedisOptions) DeepCopyInto(out *ManagedRedisOptions) {
+	*out = *in
+	if in.StorageSize != nil {
+		in, out := &in.StorageSize, &out.StorageSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRedisOptions.
+func (in *ManagedRedisOptions) DeepCopy() *ManagedRedisOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRedisOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkIsolation) DeepCopyInto(out *NetworkIsolation) {
+	*out = *in
+	if in.IngressFrom != nil {
+		in, out := &in.IngressFrom, &out.IngressFrom
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkIsolation.
+func (in *NetworkIsolation) DeepCopy() *NetworkIsolation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkIsolation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.UnhealthyPodEvictionPolicy != nil {
+		in, out := &in.UnhealthyPodEvictionPolicy, &out.UnhealthyPodEvictionPolicy
+		*out = new(policyv1.UnhealthyPodEvictionPolicyType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
+func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RayCluster) DeepCopyInto(out *RayCluster) {
 	*out = *in
@@ -410,11 +663,21 @@ func (in *RayClusterSpec) DeepCopyInto(out *RayClusterSpec) {
 		*out = new(AuthOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLSOptions != nil {
+		in, out := &in.TLSOptions, &out.TLSOptions
+		*out = new(TLSOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Suspend != nil {
 		in, out := &in.Suspend, &out.Suspend
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IdlePolicy != nil {
+		in, out := &in.IdlePolicy, &out.IdlePolicy
+		*out = new(IdlePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedBy != nil {
 		in, out := &in.ManagedBy, &out.ManagedBy
 		*out = new(string)
@@ -450,6 +713,21 @@ func (in *RayClusterSpec) DeepCopyInto(out *RayClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VolumeClaimRetentionPolicy != nil {
+		in, out := &in.VolumeClaimRetentionPolicy, &out.VolumeClaimRetentionPolicy
+		*out = new(VolumeClaimRetentionPolicy)
+		**out = **in
+	}
+	if in.NetworkIsolation != nil {
+		in, out := &in.NetworkIsolation, &out.NetworkIsolation
+		*out = new(NetworkIsolation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePrePull != nil {
+		in, out := &in.ImagePrePull, &out.ImagePrePull
+		*out = new(ImagePrePull)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterSpec.
@@ -469,6 +747,13 @@ func (in *RayClusterStatus) DeepCopyInto(out *RayClusterStatus) {
 	out.DesiredMemory = in.DesiredMemory.DeepCopy()
 	out.DesiredGPU = in.DesiredGPU.DeepCopy()
 	out.DesiredTPU = in.DesiredTPU.DeepCopy()
+	if in.DesiredAccelerators != nil {
+		in, out := &in.DesiredAccelerators, &out.DesiredAccelerators
+		*out = make(map[string]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	if in.LastUpdateTime != nil {
 		in, out := &in.LastUpdateTime, &out.LastUpdateTime
 		*out = (*in).DeepCopy()
@@ -495,6 +780,23 @@ func (in *RayClusterStatus) DeepCopyInto(out *RayClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WorkerGroupStatuses != nil {
+		in, out := &in.WorkerGroupStatuses, &out.WorkerGroupStatuses
+		*out = make([]WorkerGroupStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(AuthTokenStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Idle != nil {
+		in, out := &in.Idle, &out.Idle
+		*out = new(IdleStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterStatus.
@@ -874,6 +1176,11 @@ func (in *RayServiceSpec) DeepCopyInto(out *RayServiceSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ServiceUnhealthySecondThreshold != nil {
 		in, out := &in.ServiceUnhealthySecondThreshold, &out.ServiceUnhealthySecondThreshold
 		*out = new(int32)
@@ -894,11 +1201,21 @@ func (in *RayServiceSpec) DeepCopyInto(out *RayServiceSpec) {
 		*out = new(RayServiceUpgradeStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServeRolloutStrategy != nil {
+		in, out := &in.ServeRolloutStrategy, &out.ServeRolloutStrategy
+		*out = new(ServeRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedBy != nil {
 		in, out := &in.ManagedBy, &out.ManagedBy
 		*out = new(string)
 		**out = **in
 	}
+	if in.ServeConfigV2From != nil {
+		in, out := &in.ServeConfigV2From, &out.ServeConfigV2From
+		*out = new(ServeConfigV2Source)
+		(*in).DeepCopyInto(*out)
+	}
 	in.RayClusterSpec.DeepCopyInto(&out.RayClusterSpec)
 }
 
@@ -1002,6 +1319,107 @@ func (in *RayServiceUpgradeStrategy) DeepCopy() *RayServiceUpgradeStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayWorkerGroupScaler) DeepCopyInto(out *RayWorkerGroupScaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayWorkerGroupScaler.
+func (in *RayWorkerGroupScaler) DeepCopy() *RayWorkerGroupScaler {
+	if in == nil {
+		return nil
+	}
+	out := new(RayWorkerGroupScaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayWorkerGroupScaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayWorkerGroupScalerList) DeepCopyInto(out *RayWorkerGroupScalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RayWorkerGroupScaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayWorkerGroupScalerList.
+func (in *RayWorkerGroupScalerList) DeepCopy() *RayWorkerGroupScalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(RayWorkerGroupScalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayWorkerGroupScalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayWorkerGroupScalerSpec) DeepCopyInto(out *RayWorkerGroupScalerSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayWorkerGroupScalerSpec.
+func (in *RayWorkerGroupScalerSpec) DeepCopy() *RayWorkerGroupScalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RayWorkerGroupScalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayWorkerGroupScalerStatus) DeepCopyInto(out *RayWorkerGroupScalerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayWorkerGroupScalerStatus.
+func (in *RayWorkerGroupScalerStatus) DeepCopy() *RayWorkerGroupScalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RayWorkerGroupScalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisCredential) DeepCopyInto(out *RedisCredential) {
 	*out = *in
@@ -1042,6 +1460,66 @@ func (in *ScaleStrategy) DeepCopy() *ScaleStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingSchedule) DeepCopyInto(out *ScalingSchedule) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingSchedule.
+func (in *ScalingSchedule) DeepCopy() *ScalingSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServeConfigV2Source) DeepCopyInto(out *ServeConfigV2Source) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServeConfigV2Source.
+func (in *ServeConfigV2Source) DeepCopy() *ServeConfigV2Source {
+	if in == nil {
+		return nil
+	}
+	out := new(ServeConfigV2Source)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServeDeploymentStatus) DeepCopyInto(out *ServeDeploymentStatus) {
 	*out = *in
@@ -1057,6 +1535,31 @@ func (in *ServeDeploymentStatus) DeepCopy() *ServeDeploymentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServeRolloutStrategy) DeepCopyInto(out *ServeRolloutStrategy) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ServeRolloutStrategyType)
+		**out = **in
+	}
+	if in.ApplicationOrder != nil {
+		in, out := &in.ApplicationOrder, &out.ApplicationOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServeRolloutStrategy.
+func (in *ServeRolloutStrategy) DeepCopy() *ServeRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ServeRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubmitterConfig) DeepCopyInto(out *SubmitterConfig) {
 	*out = *in
@@ -1077,6 +1580,92 @@ func (in *SubmitterConfig) DeepCopy() *SubmitterConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSOptions) DeepCopyInto(out *TLSOptions) {
+	*out = *in
+	if in.EnableHTTPS != nil {
+		in, out := &in.EnableHTTPS, &out.EnableHTTPS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSOptions.
+func (in *TLSOptions) DeepCopy() *TLSOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeClaimRetentionPolicy) DeepCopyInto(out *VolumeClaimRetentionPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeClaimRetentionPolicy.
+func (in *VolumeClaimRetentionPolicy) DeepCopy() *VolumeClaimRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeClaimRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeClaimTemplate) DeepCopyInto(out *VolumeClaimTemplate) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeClaimTemplate.
+func (in *VolumeClaimTemplate) DeepCopy() *VolumeClaimTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeClaimTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroupFallback) DeepCopyInto(out *WorkerGroupFallback) {
+	*out = *in
+	if in.UnschedulableTimeoutSeconds != nil {
+		in, out := &in.UnschedulableTimeoutSeconds, &out.UnschedulableTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPreemptions != nil {
+		in, out := &in.MaxPreemptions, &out.MaxPreemptions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreemptionWindowSeconds != nil {
+		in, out := &in.PreemptionWindowSeconds, &out.PreemptionWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DurationSeconds != nil {
+		in, out := &in.DurationSeconds, &out.DurationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupFallback.
+func (in *WorkerGroupFallback) DeepCopy() *WorkerGroupFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroupFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerGroupSpec) DeepCopyInto(out *WorkerGroupSpec) {
 	*out = *in
@@ -1128,6 +1717,35 @@ func (in *WorkerGroupSpec) DeepCopyInto(out *WorkerGroupSpec) {
 	}
 	in.Template.DeepCopyInto(&out.Template)
 	in.ScaleStrategy.DeepCopyInto(&out.ScaleStrategy)
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScalingSchedules != nil {
+		in, out := &in.ScalingSchedules, &out.ScalingSchedules
+		*out = make([]ScalingSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]VolumeClaimTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(WorkerGroupTopology)
+		**out = **in
+	}
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(WorkerGroupFallback)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupSpec.
@@ -1139,3 +1757,70 @@ func (in *WorkerGroupSpec) DeepCopy() *WorkerGroupSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroupStatus) DeepCopyInto(out *WorkerGroupStatus) {
+	*out = *in
+	if in.PendingPodReasons != nil {
+		in, out := &in.PendingPodReasons, &out.PendingPodReasons
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ActiveScalingSchedule != nil {
+		in, out := &in.ActiveScalingSchedule, &out.ActiveScalingSchedule
+		*out = new(ActiveScalingSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastPreemptionTime != nil {
+		in, out := &in.LastPreemptionTime, &out.LastPreemptionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RecentPreemptionTimes != nil {
+		in, out := &in.RecentPreemptionTimes, &out.RecentPreemptionTimes
+		*out = make([]metav1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreemptedPodUIDs != nil {
+		in, out := &in.PreemptedPodUIDs, &out.PreemptedPodUIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveFallback != nil {
+		in, out := &in.ActiveFallback, &out.ActiveFallback
+		*out = new(ActiveFallback)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupStatus.
+func (in *WorkerGroupStatus) DeepCopy() *WorkerGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroupTopology) DeepCopyInto(out *WorkerGroupTopology) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupTopology.
+func (in *WorkerGroupTopology) DeepCopy() *WorkerGroupTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroupTopology)
+	in.DeepCopyInto(out)
+	return out
+}
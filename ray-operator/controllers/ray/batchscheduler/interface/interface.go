@@ -8,6 +8,8 @@ import (
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 )
 
 // BatchScheduler manages submitting RayCluster pods to a third-party scheduler.
@@ -29,6 +31,33 @@ type BatchScheduler interface {
 	// This is a no-op for schedulers that don't need cleanup.
 	// Returns (didCleanup, error) where didCleanup indicates whether actual cleanup was performed.
 	CleanupOnCompletion(ctx context.Context, object metav1.Object) (didCleanup bool, err error)
+
+	// OnWorkerGroupReplicasChange is called by the RayCluster controller before it creates or deletes the Pods of a
+	// worker group whose number of Pods differs from desiredPods, for example after the Ray autoscaler scales it.
+	// Gang schedulers update the gang of the RayCluster, if they support it, so that new Pods are scheduled as part of it.
+	// The worker group isn't scaled if an error is returned, for example because the queue rejects the resize, but the
+	// other worker groups are still reconciled.
+	OnWorkerGroupReplicasChange(ctx context.Context, rayCluster *rayv1.RayCluster, groupName string, desiredPods int32) error
+}
+
+// SuspendedJobAdmitter is implemented by batch schedulers that admit suspended RayJobs, such as Kueue. The RayJob
+// controller calls AdmitSuspendedJob while a RayJob is suspended, and the batch scheduler resumes the RayJob by
+// setting `spec.suspend` to false once it's admitted.
+type SuspendedJobAdmitter interface {
+	// ManagesRayJob returns whether the batch scheduler admits the RayJob. The RayJob controller suspends a managed
+	// RayJob whose RayCluster is preempted, so that it's queued again.
+	ManagesRayJob(rayJob *rayv1.RayJob) bool
+
+	// AdmitSuspendedJob returns whether the RayJob should be checked for admission again later.
+	AdmitSuspendedJob(ctx context.Context, rayJob *rayv1.RayJob) (requeue bool, err error)
+}
+
+// GangScheduler is implemented by batch schedulers that can schedule the Pods of a RayJob's RayCluster as a gang. The
+// RayJob controller handles the preemption of a worker Pod of a gang like the preemption of the head Pod, since the
+// RayCluster no longer has all the Pods that the gang was scheduled with.
+type GangScheduler interface {
+	// IsGangScheduled returns whether the Pods of the RayJob's RayCluster are scheduled as a gang.
+	IsGangScheduled(rayJob *rayv1.RayJob) bool
 }
 
 // BatchSchedulerFactory handles initial setup of the scheduler plugin by registering the
@@ -68,6 +97,10 @@ func (d *DefaultBatchScheduler) CleanupOnCompletion(_ context.Context, _ metav1.
 	return false, nil
 }
 
+func (d *DefaultBatchScheduler) OnWorkerGroupReplicasChange(_ context.Context, _ *rayv1.RayCluster, _ string, _ int32) error {
+	return nil
+}
+
 func (df *DefaultBatchSchedulerFactory) New(_ context.Context, _ *rest.Config, _ client.Client) (BatchScheduler, error) {
 	return &DefaultBatchScheduler{}, nil
 }
@@ -0,0 +1,74 @@
+package schedulerinterface
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConfigurableBatchSchedulerFactory is implemented by the BatchSchedulerFactories that accept plugin-specific
+// configuration, which is passed through `batchSchedulerConfig` in the operator configuration.
+type ConfigurableBatchSchedulerFactory interface {
+	BatchSchedulerFactory
+
+	// Configure decodes the plugin-specific configuration. It is called before New.
+	Configure(config runtime.RawExtension) error
+}
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]func() BatchSchedulerFactory{}
+)
+
+// RegisterBatchSchedulerFactory makes a batch scheduler plugin available by name, so that it can be selected with
+// the `batchScheduler` option. Plugins call it from an init function, which lets out-of-tree plugins be compiled
+// into the operator by importing their package. It panics if the name is empty or already registered.
+func RegisterBatchSchedulerFactory(name string, newFactory func() BatchSchedulerFactory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	if name == "" || newFactory == nil {
+		panic("batch scheduler plugins must be registered with a name and a factory")
+	}
+	if _, ok := factories[name]; ok {
+		panic(fmt.Sprintf("batch scheduler plugin %q is registered twice", name))
+	}
+	factories[name] = newFactory
+}
+
+// UnregisterBatchSchedulerFactory removes a registered batch scheduler plugin. It lets tests register plugins
+// without leaking them into other tests.
+func UnregisterBatchSchedulerFactory(name string) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	delete(factories, name)
+}
+
+// NewBatchSchedulerFactory returns a new BatchSchedulerFactory of the registered plugin, or false if no plugin is
+// registered with the name.
+func NewBatchSchedulerFactory(name string) (BatchSchedulerFactory, bool) {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	newFactory, ok := factories[name]
+	if !ok {
+		return nil, false
+	}
+	return newFactory(), true
+}
+
+// RegisteredBatchSchedulers returns the sorted names of the registered batch scheduler plugins.
+func RegisteredBatchSchedulers() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
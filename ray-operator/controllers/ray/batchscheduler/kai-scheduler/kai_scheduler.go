@@ -25,12 +25,20 @@ import (
 
 const (
 	QueueLabelName = "kai.scheduler/queue"
+	// PriorityClassLabelName is the label that KAI-Scheduler reads the priority class of a workload from.
+	PriorityClassLabelName = "priorityClassName"
 )
 
 type KaiScheduler struct{}
 
 type KaiSchedulerFactory struct{}
 
+func init() {
+	schedulerinterface.RegisterBatchSchedulerFactory(GetPluginName(), func() schedulerinterface.BatchSchedulerFactory {
+		return &KaiSchedulerFactory{}
+	})
+}
+
 func GetPluginName() string { return "kai-scheduler" }
 
 func (k *KaiScheduler) Name() string { return GetPluginName() }
@@ -54,6 +62,15 @@ func (k *KaiScheduler) AddMetadataToChildResource(ctx context.Context, parent me
 	logger := ctrl.LoggerFrom(ctx).WithName("kai-scheduler")
 	utils.AddSchedulerNameToObject(child, k.Name())
 
+	if priorityClassName := utils.GetPriorityClassName(parent); priorityClassName != "" {
+		childLabels := child.GetLabels()
+		if childLabels == nil {
+			childLabels = make(map[string]string)
+		}
+		childLabels[PriorityClassLabelName] = priorityClassName
+		child.SetLabels(childLabels)
+	}
+
 	parentLabel := parent.GetLabels()
 	queue, ok := parentLabel[QueueLabelName]
 	if !ok || queue == "" {
@@ -75,6 +92,10 @@ func (k *KaiScheduler) CleanupOnCompletion(_ context.Context, _ metav1.Object) (
 	return false, nil
 }
 
+func (k *KaiScheduler) OnWorkerGroupReplicasChange(_ context.Context, _ *rayv1.RayCluster, _ string, _ int32) error {
+	return nil
+}
+
 func (kf *KaiSchedulerFactory) New(_ context.Context, _ *rest.Config, _ client.Client) (schedulerinterface.BatchScheduler, error) {
 	return &KaiScheduler{}, nil
 }
@@ -188,3 +188,19 @@ func TestAddMetadataToChildResource_WithRayJob_WithoutQueueLabel(t *testing.T) {
 		a.False(exists)
 	}
 }
+
+func TestAddMetadataToChildResource_WithPriorityClassName(t *testing.T) {
+	a := assert.New(t)
+	scheduler := &KaiScheduler{}
+	ctx := context.Background()
+
+	rayJob := createTestRayJob(map[string]string{
+		QueueLabelName: "test-queue",
+	})
+	rayJob.Spec.PriorityClassName = "train"
+	pod := createTestPod()
+
+	scheduler.AddMetadataToChildResource(ctx, rayJob, pod, "test-group")
+
+	a.Equal("train", pod.Labels[PriorityClassLabelName])
+}
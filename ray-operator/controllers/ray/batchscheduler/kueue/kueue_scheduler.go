@@ -0,0 +1,376 @@
+package kueue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
+	batchschedulerutils "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/utils"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const (
+	pluginName = "kueue"
+	// QueueNameLabelKey selects the Kueue LocalQueue of a RayJob. RayJobs without it aren't managed by the plugin.
+	// It differs from the `kueue.x-k8s.io/queue-name` label of Kueue's own RayJob integration so that a RayJob isn't
+	// queued by both. The plugin and Kueue's RayJob integration are mutually exclusive: Kueue must not be configured
+	// to manage RayJobs without a queue name while the plugin is enabled.
+	// RayJobs with it must create their RayCluster and delete it when they finish, see ValidateRayJobSpec.
+	QueueNameLabelKey = utils.KueueQueueNameLabelKey
+	// PriorityClassLabelKey selects the WorkloadPriorityClass of a RayJob.
+	PriorityClassLabelKey = "ray.io/kueue-priority-class"
+
+	headPodSetName      = "head"
+	submitterPodSetName = "submitter"
+	// maxPodSets is the maximum number of PodSets of a Kueue Workload.
+	maxPodSets = 8
+
+	workloadAdmittedCondition = "Admitted"
+	workloadFinishedCondition = "Finished"
+)
+
+// WorkloadGVK is the GroupVersionKind of Kueue Workloads. Workloads are handled as unstructured objects so that
+// KubeRay doesn't depend on the Kueue API module.
+var WorkloadGVK = schema.GroupVersionKind{Group: "kueue.x-k8s.io", Version: "v1beta1", Kind: "Workload"}
+
+type KueueBatchScheduler struct {
+	cli client.Client
+}
+
+type KueueBatchSchedulerFactory struct{}
+
+func init() {
+	schedulerinterface.RegisterBatchSchedulerFactory(GetPluginName(), func() schedulerinterface.BatchSchedulerFactory {
+		return &KueueBatchSchedulerFactory{}
+	})
+}
+
+var _ schedulerinterface.SuspendedJobAdmitter = (*KueueBatchScheduler)(nil)
+
+func GetPluginName() string { return pluginName }
+
+func (k *KueueBatchScheduler) Name() string {
+	return GetPluginName()
+}
+
+// DoBatchSchedulingOnSubmission syncs the Workload of a RayJob before its RayCluster is created. A RayJob whose
+// Workload isn't admitted is suspended until Kueue admits it.
+//
+// RayClusters are not handled: a RayCluster created by a RayJob is admitted through the Workload of the RayJob.
+func (k *KueueBatchScheduler) DoBatchSchedulingOnSubmission(ctx context.Context, object metav1.Object) error {
+	rayJob, ok := object.(*rayv1.RayJob)
+	if !ok || !isManagedByKueue(rayJob) {
+		return nil
+	}
+
+	workload, err := k.syncWorkload(ctx, rayJob)
+	if err != nil {
+		return err
+	}
+	if isWorkloadConditionTrue(workload, workloadAdmittedCondition) {
+		return nil
+	}
+
+	ctrl.LoggerFrom(ctx).WithName(pluginName).Info("The Workload is not admitted, suspend the RayJob", "workload", workload.GetName())
+	rayJob.Spec.Suspend = true
+	return k.cli.Update(ctx, rayJob)
+}
+
+// AdmitSuspendedJob syncs the Workload of a suspended RayJob and resumes the RayJob once Kueue admits the Workload.
+func (k *KueueBatchScheduler) AdmitSuspendedJob(ctx context.Context, rayJob *rayv1.RayJob) (bool, error) {
+	if !isManagedByKueue(rayJob) {
+		return false, nil
+	}
+
+	workload, err := k.syncWorkload(ctx, rayJob)
+	if err != nil {
+		return false, err
+	}
+	if !isWorkloadConditionTrue(workload, workloadAdmittedCondition) {
+		return true, nil
+	}
+
+	ctrl.LoggerFrom(ctx).WithName(pluginName).Info("The Workload is admitted, resume the RayJob", "workload", workload.GetName())
+	rayJob.Spec.Suspend = false
+	return false, k.cli.Update(ctx, rayJob)
+}
+
+// ManagesRayJob returns whether the RayJob is queued by Kueue.
+func (k *KueueBatchScheduler) ManagesRayJob(rayJob *rayv1.RayJob) bool {
+	return isManagedByKueue(rayJob)
+}
+
+func (k *KueueBatchScheduler) AddMetadataToChildResource(_ context.Context, _ metav1.Object, _ metav1.Object, _ string) {
+}
+
+// CleanupOnCompletion marks the Workload of a RayJob as finished so that Kueue releases its quota.
+func (k *KueueBatchScheduler) CleanupOnCompletion(ctx context.Context, object metav1.Object) (bool, error) {
+	rayJob, ok := object.(*rayv1.RayJob)
+	if !ok || !isManagedByKueue(rayJob) {
+		return false, nil
+	}
+
+	workload := newWorkload()
+	if err := k.cli.Get(ctx, types.NamespacedName{Namespace: rayJob.Namespace, Name: getWorkloadName(rayJob)}, workload); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if isWorkloadConditionTrue(workload, workloadFinishedCondition) {
+		return false, nil
+	}
+
+	reason := "Succeeded"
+	if rayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusFailed {
+		reason = "Failed"
+	}
+	conditions, _, err := unstructured.NestedSlice(workload.Object, "status", "conditions")
+	if err != nil {
+		return false, err
+	}
+	conditions = append(conditions, map[string]any{
+		"type":               workloadFinishedCondition,
+		"status":             string(metav1.ConditionTrue),
+		"reason":             reason,
+		"message":            fmt.Sprintf("RayJob %s/%s reached JobDeploymentStatus %s", rayJob.Namespace, rayJob.Name, rayJob.Status.JobDeploymentStatus),
+		"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+	})
+	if err := setWorkloadConditions(workload, conditions); err != nil {
+		return false, err
+	}
+	if err := k.cli.Status().Update(ctx, workload); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// OnWorkerGroupReplicasChange is a no-op because the PodSets of an admitted Workload are immutable.
+func (k *KueueBatchScheduler) OnWorkerGroupReplicasChange(_ context.Context, _ *rayv1.RayCluster, _ string, _ int32) error {
+	return nil
+}
+
+func isManagedByKueue(rayJob *rayv1.RayJob) bool {
+	// Batch scheduling is not supported for RayJobs that use an existing RayCluster. RayJobs can only be suspended if
+	// their RayCluster is deleted when they finish, so RayJobs without ShutdownAfterJobFinishes aren't queued either.
+	// ValidateRayJobSpec rejects such RayJobs if they have the queue name label.
+	return rayJob.Labels[QueueNameLabelKey] != "" && rayJob.Spec.RayClusterSpec != nil && rayJob.Spec.ShutdownAfterJobFinishes
+}
+
+func getWorkloadName(rayJob *rayv1.RayJob) string {
+	return fmt.Sprintf("ray-%s-workload", rayJob.Name)
+}
+
+func newWorkload() *unstructured.Unstructured {
+	workload := &unstructured.Unstructured{}
+	workload.SetGroupVersionKind(WorkloadGVK)
+	return workload
+}
+
+// syncWorkload creates the Workload of the RayJob, or updates its PodSets if it isn't admitted yet.
+func (k *KueueBatchScheduler) syncWorkload(ctx context.Context, rayJob *rayv1.RayJob) (*unstructured.Unstructured, error) {
+	logger := ctrl.LoggerFrom(ctx).WithName(pluginName)
+
+	podSets, err := buildPodSets(rayJob)
+	if err != nil {
+		return nil, err
+	}
+
+	workload := newWorkload()
+	if err := k.cli.Get(ctx, types.NamespacedName{Namespace: rayJob.Namespace, Name: getWorkloadName(rayJob)}, workload); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		workload, err = createWorkload(rayJob, podSets)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.cli.Create(ctx, workload); err != nil {
+			return nil, err
+		}
+		logger.Info("Created Workload", "workload", workload.GetName())
+		return workload, nil
+	}
+
+	// The PodSets of an admitted Workload are immutable.
+	if isWorkloadConditionTrue(workload, workloadAdmittedCondition) {
+		return workload, nil
+	}
+	currentPodSets, _, err := unstructured.NestedSlice(workload.Object, "spec", "podSets")
+	if err != nil {
+		return nil, err
+	}
+	if podSetCounts(currentPodSets) == podSetCounts(podSets) {
+		return workload, nil
+	}
+	if err := unstructured.SetNestedSlice(workload.Object, podSets, "spec", "podSets"); err != nil {
+		return nil, err
+	}
+	if err := k.cli.Update(ctx, workload); err != nil {
+		return nil, err
+	}
+	logger.Info("Updated the PodSets of the Workload", "workload", workload.GetName())
+	return workload, nil
+}
+
+func createWorkload(rayJob *rayv1.RayJob, podSets []any) (*unstructured.Unstructured, error) {
+	workload := newWorkload()
+	workload.SetName(getWorkloadName(rayJob))
+	workload.SetNamespace(rayJob.Namespace)
+	workload.SetLabels(map[string]string{
+		utils.RayOriginatedFromCRNameLabelKey: rayJob.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayJobCRD),
+		utils.KubernetesCreatedByLabelKey:     utils.ComponentName,
+	})
+	workload.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(rayJob, rayv1.SchemeGroupVersion.WithKind("RayJob"))})
+
+	spec := map[string]any{
+		"queueName": rayJob.Labels[QueueNameLabelKey],
+		"podSets":   podSets,
+	}
+	if priorityClass := rayJob.Labels[PriorityClassLabelKey]; priorityClass != "" {
+		spec["priorityClassName"] = priorityClass
+		spec["priorityClassSource"] = "kueue.x-k8s.io/workloadpriorityclass"
+	} else if priorityClass := batchschedulerutils.GetPriorityClassName(rayJob); priorityClass != "" {
+		spec["priorityClassName"] = priorityClass
+		spec["priorityClassSource"] = "scheduling.k8s.io/priorityclass"
+	}
+	if err := unstructured.SetNestedField(workload.Object, spec, "spec"); err != nil {
+		return nil, err
+	}
+	return workload, nil
+}
+
+// buildPodSets returns a PodSet for the head Pod, one for each worker group, and one for the submitter Pod in
+// K8sJobMode. The count of a worker group's PodSet is its number of Pods, that is its replicas, or its minimum
+// replicas if autoscaling is enabled, multiplied by its number of hosts.
+func buildPodSets(rayJob *rayv1.RayJob) ([]any, error) {
+	clusterSpec := rayJob.Spec.RayClusterSpec
+
+	headTemplate := *clusterSpec.HeadGroupSpec.Template.DeepCopy()
+	if rayJob.Spec.SubmissionMode == rayv1.SidecarMode {
+		headTemplate.Spec.Containers = append(headTemplate.Spec.Containers, common.GetDefaultSubmitterContainer(clusterSpec))
+	}
+	podSet, err := newPodSet(headPodSetName, 1, headTemplate)
+	if err != nil {
+		return nil, err
+	}
+	podSets := []any{podSet}
+
+	for _, workerGroup := range clusterSpec.WorkerGroupSpecs {
+		count := utils.GetWorkerGroupDesiredReplicas(workerGroup)
+		if utils.IsAutoscalingEnabled(clusterSpec) {
+			count = 0
+			if workerGroup.Suspend == nil || !*workerGroup.Suspend {
+				count = ptrValue(workerGroup.MinReplicas) * workerGroup.NumOfHosts
+			}
+		}
+		if count == 0 {
+			continue
+		}
+		podSet, err := newPodSet(strings.ToLower(workerGroup.GroupName), count, workerGroup.Template)
+		if err != nil {
+			return nil, err
+		}
+		podSets = append(podSets, podSet)
+	}
+
+	if rayJob.Spec.SubmissionMode == rayv1.K8sJobMode {
+		podSet, err := newPodSet(submitterPodSetName, 1, common.GetSubmitterTemplate(&rayJob.Spec, clusterSpec))
+		if err != nil {
+			return nil, err
+		}
+		podSets = append(podSets, podSet)
+	}
+
+	if len(podSets) > maxPodSets {
+		return nil, fmt.Errorf("the RayJob needs %d PodSets, but a Kueue Workload supports at most %d", len(podSets), maxPodSets)
+	}
+	return podSets, nil
+}
+
+func newPodSet(name string, count int32, template corev1.PodTemplateSpec) (map[string]any, error) {
+	unstructuredTemplate, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&template)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"name":     name,
+		"count":    int64(count),
+		"template": unstructuredTemplate,
+	}, nil
+}
+
+// podSetCounts returns the names and counts of the PodSets, which are compared to decide whether to update them.
+// The templates aren't compared because the API server defaults some of their fields.
+func podSetCounts(podSets []any) string {
+	var b strings.Builder
+	for _, podSet := range podSets {
+		podSetMap, ok := podSet.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(podSetMap, "name")
+		count, _, _ := unstructured.NestedInt64(podSetMap, "count")
+		fmt.Fprintf(&b, "%s=%d,", name, count)
+	}
+	return b.String()
+}
+
+func isWorkloadConditionTrue(workload *unstructured.Unstructured, conditionType string) bool {
+	conditions, _, _ := unstructured.NestedSlice(workload.Object, "status", "conditions")
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]any)
+		if !ok {
+			continue
+		}
+		if conditionMap["type"] == conditionType {
+			return conditionMap["status"] == string(metav1.ConditionTrue)
+		}
+	}
+	return false
+}
+
+func setWorkloadConditions(workload *unstructured.Unstructured, conditions []any) error {
+	// The status of a Workload that Kueue hasn't processed yet may be null.
+	if _, ok := workload.Object["status"].(map[string]any); !ok {
+		workload.Object["status"] = map[string]any{}
+	}
+	return unstructured.SetNestedSlice(workload.Object, conditions, "status", "conditions")
+}
+
+func ptrValue(value *int32) int32 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func (kf *KueueBatchSchedulerFactory) New(_ context.Context, _ *rest.Config, cli client.Client) (schedulerinterface.BatchScheduler, error) {
+	return &KueueBatchScheduler{
+		cli: cli,
+	}, nil
+}
+
+func (kf *KueueBatchSchedulerFactory) AddToScheme(_ *runtime.Scheme) {
+}
+
+func (kf *KueueBatchSchedulerFactory) ConfigureReconciler(b *builder.Builder) *builder.Builder {
+	return b
+}
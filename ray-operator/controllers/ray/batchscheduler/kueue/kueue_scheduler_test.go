@@ -0,0 +1,313 @@
+package kueue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+func createTestRayJob() *rayv1.RayJob {
+	return &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rayjob-sample",
+			Namespace: "default",
+			Labels: map[string]string{
+				QueueNameLabelKey: "user-queue",
+			},
+		},
+		Spec: rayv1.RayJobSpec{
+			Entrypoint:               "python /home/ray/samples/sample_code.py",
+			SubmissionMode:           rayv1.K8sJobMode,
+			ShutdownAfterJobFinishes: true,
+			RayClusterSpec: &rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.46.0"}},
+						},
+					},
+				},
+				WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+					{
+						GroupName:   "Small-Group",
+						Replicas:    ptr.To[int32](2),
+						MinReplicas: ptr.To[int32](1),
+						MaxReplicas: ptr.To[int32](4),
+						NumOfHosts:  2,
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{Name: "ray-worker", Image: "rayproject/ray:2.46.0"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, rayv1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(newWorkload()).
+		Build()
+}
+
+func getTestWorkload(t *testing.T, cli client.Client, rayJob *rayv1.RayJob) *unstructured.Unstructured {
+	workload := newWorkload()
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Namespace: rayJob.Namespace, Name: getWorkloadName(rayJob)}, workload))
+	return workload
+}
+
+func setWorkloadCondition(t *testing.T, workload *unstructured.Unstructured, conditionType string, status metav1.ConditionStatus) {
+	require.NoError(t, setWorkloadConditions(workload, []any{
+		map[string]any{"type": conditionType, "status": string(status), "reason": "Test", "message": "test"},
+	}))
+}
+
+func TestBuildPodSets(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(rayJob *rayv1.RayJob)
+		expectedCounts string
+		expectError    bool
+	}{
+		{
+			name:           "worker group replicas multiplied by the number of hosts",
+			expectedCounts: "head=1,small-group=4,submitter=1,",
+		},
+		{
+			name: "minimum replicas if autoscaling is enabled",
+			mutate: func(rayJob *rayv1.RayJob) {
+				rayJob.Spec.RayClusterSpec.EnableInTreeAutoscaling = ptr.To(true)
+			},
+			expectedCounts: "head=1,small-group=2,submitter=1,",
+		},
+		{
+			name: "no submitter PodSet in HTTPMode",
+			mutate: func(rayJob *rayv1.RayJob) {
+				rayJob.Spec.SubmissionMode = rayv1.HTTPMode
+			},
+			expectedCounts: "head=1,small-group=4,",
+		},
+		{
+			name: "worker groups without Pods are skipped",
+			mutate: func(rayJob *rayv1.RayJob) {
+				rayJob.Spec.RayClusterSpec.WorkerGroupSpecs[0].Suspend = ptr.To(true)
+			},
+			expectedCounts: "head=1,submitter=1,",
+		},
+		{
+			name: "too many PodSets",
+			mutate: func(rayJob *rayv1.RayJob) {
+				workerGroup := rayJob.Spec.RayClusterSpec.WorkerGroupSpecs[0]
+				for range maxPodSets {
+					rayJob.Spec.RayClusterSpec.WorkerGroupSpecs = append(rayJob.Spec.RayClusterSpec.WorkerGroupSpecs, workerGroup)
+				}
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rayJob := createTestRayJob()
+			if tc.mutate != nil {
+				tc.mutate(rayJob)
+			}
+			podSets, err := buildPodSets(rayJob)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCounts, podSetCounts(podSets))
+		})
+	}
+}
+
+func TestBuildPodSetsSidecarMode(t *testing.T) {
+	rayJob := createTestRayJob()
+	rayJob.Spec.SubmissionMode = rayv1.SidecarMode
+
+	podSets, err := buildPodSets(rayJob)
+	require.NoError(t, err)
+	assert.Equal(t, "head=1,small-group=4,", podSetCounts(podSets))
+
+	// The submitter container runs in the head Pod, so it's accounted in the head PodSet.
+	containers, _, err := unstructured.NestedSlice(podSets[0].(map[string]any), "template", "spec", "containers")
+	require.NoError(t, err)
+	assert.Len(t, containers, 2)
+}
+
+func TestDoBatchSchedulingOnSubmission(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RayJob without the queue name label is ignored", func(t *testing.T) {
+		rayJob := createTestRayJob()
+		delete(rayJob.Labels, QueueNameLabelKey)
+		cli := newFakeClient(t, rayJob)
+		scheduler := &KueueBatchScheduler{cli: cli}
+
+		require.NoError(t, scheduler.DoBatchSchedulingOnSubmission(ctx, rayJob))
+		assert.False(t, rayJob.Spec.Suspend)
+
+		workloads := &unstructured.UnstructuredList{}
+		workloads.SetGroupVersionKind(WorkloadGVK)
+		require.NoError(t, cli.List(ctx, workloads))
+		assert.Empty(t, workloads.Items)
+	})
+
+	t.Run("RayJob that keeps its RayCluster after it finishes is ignored", func(t *testing.T) {
+		rayJob := createTestRayJob()
+		rayJob.Spec.ShutdownAfterJobFinishes = false
+		cli := newFakeClient(t, rayJob)
+		scheduler := &KueueBatchScheduler{cli: cli}
+
+		require.NoError(t, scheduler.DoBatchSchedulingOnSubmission(ctx, rayJob))
+		assert.False(t, rayJob.Spec.Suspend)
+		assert.False(t, scheduler.ManagesRayJob(rayJob))
+
+		workloads := &unstructured.UnstructuredList{}
+		workloads.SetGroupVersionKind(WorkloadGVK)
+		require.NoError(t, cli.List(ctx, workloads))
+		assert.Empty(t, workloads.Items)
+	})
+
+	t.Run("RayJob is suspended until its Workload is admitted", func(t *testing.T) {
+		rayJob := createTestRayJob()
+		rayJob.Labels[PriorityClassLabelKey] = "high"
+		cli := newFakeClient(t, rayJob)
+		scheduler := &KueueBatchScheduler{cli: cli}
+
+		require.NoError(t, scheduler.DoBatchSchedulingOnSubmission(ctx, rayJob))
+		assert.True(t, rayJob.Spec.Suspend)
+
+		workload := getTestWorkload(t, cli, rayJob)
+		queueName, _, _ := unstructured.NestedString(workload.Object, "spec", "queueName")
+		assert.Equal(t, "user-queue", queueName)
+		priorityClassName, _, _ := unstructured.NestedString(workload.Object, "spec", "priorityClassName")
+		assert.Equal(t, "high", priorityClassName)
+		podSets, _, _ := unstructured.NestedSlice(workload.Object, "spec", "podSets")
+		assert.Equal(t, "head=1,small-group=4,submitter=1,", podSetCounts(podSets))
+		require.Len(t, workload.GetOwnerReferences(), 1)
+		assert.Equal(t, rayJob.Name, workload.GetOwnerReferences()[0].Name)
+	})
+
+	t.Run("Workload priority falls back to the RayJob priorityClassName", func(t *testing.T) {
+		rayJob := createTestRayJob()
+		rayJob.Spec.PriorityClassName = "train"
+		cli := newFakeClient(t, rayJob)
+		scheduler := &KueueBatchScheduler{cli: cli}
+
+		require.NoError(t, scheduler.DoBatchSchedulingOnSubmission(ctx, rayJob))
+
+		workload := getTestWorkload(t, cli, rayJob)
+		priorityClassName, _, _ := unstructured.NestedString(workload.Object, "spec", "priorityClassName")
+		assert.Equal(t, "train", priorityClassName)
+		priorityClassSource, _, _ := unstructured.NestedString(workload.Object, "spec", "priorityClassSource")
+		assert.Equal(t, "scheduling.k8s.io/priorityclass", priorityClassSource)
+	})
+
+	t.Run("admitted RayJob isn't suspended", func(t *testing.T) {
+		rayJob := createTestRayJob()
+		workload, err := createWorkload(rayJob, nil)
+		require.NoError(t, err)
+		setWorkloadCondition(t, workload, workloadAdmittedCondition, metav1.ConditionTrue)
+		cli := newFakeClient(t, rayJob, workload)
+		scheduler := &KueueBatchScheduler{cli: cli}
+
+		require.NoError(t, scheduler.DoBatchSchedulingOnSubmission(ctx, rayJob))
+		assert.False(t, rayJob.Spec.Suspend)
+	})
+}
+
+func TestAdmitSuspendedJob(t *testing.T) {
+	ctx := context.Background()
+	rayJob := createTestRayJob()
+	rayJob.Spec.Suspend = true
+	cli := newFakeClient(t, rayJob)
+	scheduler := &KueueBatchScheduler{cli: cli}
+
+	requeue, err := scheduler.AdmitSuspendedJob(ctx, rayJob)
+	require.NoError(t, err)
+	assert.True(t, requeue)
+	assert.True(t, rayJob.Spec.Suspend)
+
+	// The PodSets of a Workload that isn't admitted follow the RayJob.
+	rayJob.Spec.RayClusterSpec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](3)
+	requeue, err = scheduler.AdmitSuspendedJob(ctx, rayJob)
+	require.NoError(t, err)
+	assert.True(t, requeue)
+	workload := getTestWorkload(t, cli, rayJob)
+	podSets, _, _ := unstructured.NestedSlice(workload.Object, "spec", "podSets")
+	assert.Equal(t, "head=1,small-group=6,submitter=1,", podSetCounts(podSets))
+
+	setWorkloadCondition(t, workload, workloadAdmittedCondition, metav1.ConditionTrue)
+	require.NoError(t, cli.Status().Update(ctx, workload))
+
+	requeue, err = scheduler.AdmitSuspendedJob(ctx, rayJob)
+	require.NoError(t, err)
+	assert.False(t, requeue)
+
+	updatedRayJob := &rayv1.RayJob{}
+	require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(rayJob), updatedRayJob))
+	assert.False(t, updatedRayJob.Spec.Suspend)
+
+	// The PodSets of an admitted Workload are immutable.
+	rayJob.Spec.RayClusterSpec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](1)
+	_, err = scheduler.AdmitSuspendedJob(ctx, rayJob)
+	require.NoError(t, err)
+	workload = getTestWorkload(t, cli, rayJob)
+	podSets, _, _ = unstructured.NestedSlice(workload.Object, "spec", "podSets")
+	assert.Equal(t, "head=1,small-group=6,submitter=1,", podSetCounts(podSets))
+}
+
+func TestCleanupOnCompletion(t *testing.T) {
+	ctx := context.Background()
+	rayJob := createTestRayJob()
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
+
+	t.Run("missing Workload", func(t *testing.T) {
+		scheduler := &KueueBatchScheduler{cli: newFakeClient(t, rayJob)}
+		didCleanup, err := scheduler.CleanupOnCompletion(ctx, rayJob)
+		require.NoError(t, err)
+		assert.False(t, didCleanup)
+	})
+
+	t.Run("Workload is marked as finished once", func(t *testing.T) {
+		workload, err := createWorkload(rayJob, nil)
+		require.NoError(t, err)
+		cli := newFakeClient(t, rayJob, workload)
+		scheduler := &KueueBatchScheduler{cli: cli}
+
+		didCleanup, err := scheduler.CleanupOnCompletion(ctx, rayJob)
+		require.NoError(t, err)
+		assert.True(t, didCleanup)
+
+		workload = getTestWorkload(t, cli, rayJob)
+		assert.True(t, isWorkloadConditionTrue(workload, workloadFinishedCondition))
+		conditions, _, _ := unstructured.NestedSlice(workload.Object, "status", "conditions")
+		assert.Equal(t, "Failed", conditions[0].(map[string]any)["reason"])
+
+		didCleanup, err = scheduler.CleanupOnCompletion(ctx, rayJob)
+		require.NoError(t, err)
+		assert.False(t, didCleanup)
+	})
+}
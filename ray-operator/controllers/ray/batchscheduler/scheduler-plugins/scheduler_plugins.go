@@ -37,6 +37,12 @@ type KubeScheduler struct {
 
 type KubeSchedulerFactory struct{}
 
+func init() {
+	schedulerinterface.RegisterBatchSchedulerFactory(GetPluginName(), func() schedulerinterface.BatchSchedulerFactory {
+		return &KubeSchedulerFactory{}
+	})
+}
+
 func GetPluginName() string {
 	return schedulerName
 }
@@ -113,11 +119,20 @@ func (k *KubeScheduler) isGangSchedulingEnabled(obj metav1.Object) bool {
 	return exist
 }
 
+// IsGangScheduled returns whether gang scheduling is enabled for the RayJob.
+func (k *KubeScheduler) IsGangScheduled(rayJob *rayv1.RayJob) bool {
+	return k.isGangSchedulingEnabled(rayJob)
+}
+
 func (k *KubeScheduler) CleanupOnCompletion(_ context.Context, _ metav1.Object) (bool, error) {
 	// KubeScheduler doesn't need cleanup
 	return false, nil
 }
 
+func (k *KubeScheduler) OnWorkerGroupReplicasChange(_ context.Context, _ *rayv1.RayCluster, _ string, _ int32) error {
+	return nil
+}
+
 func (kf *KubeSchedulerFactory) New(_ context.Context, _ *rest.Config, cli client.Client) (schedulerinterface.BatchScheduler, error) {
 	if err := v1alpha1.AddToScheme(cli.Scheme()); err != nil {
 		return nil, err
@@ -12,10 +12,13 @@ import (
 
 	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
-	kaischeduler "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/kai-scheduler"
-	schedulerplugins "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/scheduler-plugins"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/volcano"
-	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/yunikorn"
+
+	// The in-tree batch scheduler plugins register themselves in their init functions.
+	_ "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/kai-scheduler"
+	_ "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/kueue"
+	_ "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/scheduler-plugins"
+	_ "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/yunikorn"
 )
 
 type SchedulerManager struct {
@@ -49,23 +52,21 @@ func NewSchedulerManager(ctx context.Context, rayConfigs configapi.Configuration
 	return &manager, nil
 }
 
+// RegisteredBatchSchedulers returns the names of the batch scheduler plugins compiled into the operator: the in-tree
+// plugins, which are registered by the imports of this package, and the imported out-of-tree plugins.
+func RegisteredBatchSchedulers() []string {
+	return schedulerinterface.RegisteredBatchSchedulers()
+}
+
 func getSchedulerFactory(rayConfigs configapi.Configuration) (schedulerinterface.BatchSchedulerFactory, error) {
 	var factory schedulerinterface.BatchSchedulerFactory
 
 	// when a batch scheduler name is provided
-	// only support a white list of names, empty value is the default value
+	// only support the registered plugins, empty value is the default value
 	// it throws error if an unknown name is provided
 	if len(rayConfigs.BatchScheduler) > 0 {
-		switch rayConfigs.BatchScheduler {
-		case volcano.GetPluginName():
-			factory = &volcano.VolcanoBatchSchedulerFactory{}
-		case yunikorn.GetPluginName():
-			factory = &yunikorn.YuniKornSchedulerFactory{}
-		case kaischeduler.GetPluginName():
-			factory = &kaischeduler.KaiSchedulerFactory{}
-		case schedulerplugins.GetPluginName():
-			factory = &schedulerplugins.KubeSchedulerFactory{}
-		default:
+		var ok bool
+		if factory, ok = schedulerinterface.NewBatchSchedulerFactory(rayConfigs.BatchScheduler); !ok {
 			return nil, fmt.Errorf("the scheduler is not supported, name=%s", rayConfigs.BatchScheduler)
 		}
 	} else {
@@ -80,6 +81,16 @@ func getSchedulerFactory(rayConfigs configapi.Configuration) (schedulerinterface
 		factory = &volcano.VolcanoBatchSchedulerFactory{}
 	}
 
+	if rayConfigs.BatchSchedulerConfig != nil {
+		configurable, ok := factory.(schedulerinterface.ConfigurableBatchSchedulerFactory)
+		if !ok {
+			return nil, fmt.Errorf("the scheduler doesn't accept batchSchedulerConfig, name=%s", rayConfigs.BatchScheduler)
+		}
+		if err := configurable.Configure(*rayConfigs.BatchSchedulerConfig); err != nil {
+			return nil, fmt.Errorf("invalid batchSchedulerConfig for the scheduler %s: %w", rayConfigs.BatchScheduler, err)
+		}
+	}
+
 	return factory, nil
 }
 
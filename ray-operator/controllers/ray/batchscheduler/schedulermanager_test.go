@@ -1,10 +1,13 @@
 package batchscheduler
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
@@ -157,3 +160,54 @@ func TestGetSchedulerFactory(t *testing.T) {
 		})
 	}
 }
+
+// configurableSchedulerFactory is an out-of-tree plugin that accepts plugin-specific configuration.
+type configurableSchedulerFactory struct {
+	schedulerinterface.DefaultBatchSchedulerFactory
+	Queue string `json:"queue"`
+}
+
+func (f *configurableSchedulerFactory) Configure(config runtime.RawExtension) error {
+	return json.Unmarshal(config.Raw, f)
+}
+
+func TestGetSchedulerFactoryWithRegisteredPlugin(t *testing.T) {
+	schedulerinterface.RegisterBatchSchedulerFactory("test-gang-scheduler", func() schedulerinterface.BatchSchedulerFactory {
+		return &configurableSchedulerFactory{}
+	})
+	t.Cleanup(func() {
+		schedulerinterface.UnregisterBatchSchedulerFactory("test-gang-scheduler")
+	})
+	assert.Contains(t, RegisteredBatchSchedulers(), "test-gang-scheduler")
+	assert.Panics(t, func() {
+		schedulerinterface.RegisterBatchSchedulerFactory(volcano.GetPluginName(), func() schedulerinterface.BatchSchedulerFactory {
+			return &configurableSchedulerFactory{}
+		})
+	})
+
+	factory, err := getSchedulerFactory(v1alpha1.Configuration{
+		BatchScheduler:       "test-gang-scheduler",
+		BatchSchedulerConfig: &runtime.RawExtension{Raw: []byte(`{"queue":"gang"}`)},
+	})
+	require.NoError(t, err)
+	require.IsType(t, &configurableSchedulerFactory{}, factory)
+	assert.Equal(t, "gang", factory.(*configurableSchedulerFactory).Queue)
+
+	// Each SchedulerManager gets its own factory.
+	factory, err = getSchedulerFactory(v1alpha1.Configuration{BatchScheduler: "test-gang-scheduler"})
+	require.NoError(t, err)
+	assert.Empty(t, factory.(*configurableSchedulerFactory).Queue)
+
+	_, err = getSchedulerFactory(v1alpha1.Configuration{
+		BatchScheduler:       "test-gang-scheduler",
+		BatchSchedulerConfig: &runtime.RawExtension{Raw: []byte(`{"queue":`)},
+	})
+	require.Error(t, err)
+
+	// The in-tree plugins don't accept configuration.
+	_, err = getSchedulerFactory(v1alpha1.Configuration{
+		BatchScheduler:       volcano.GetPluginName(),
+		BatchSchedulerConfig: &runtime.RawExtension{Raw: []byte(`{}`)},
+	})
+	require.Error(t, err)
+}
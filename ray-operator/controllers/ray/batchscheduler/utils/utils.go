@@ -3,6 +3,9 @@ package utils
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
 // AddSchedulerNameToObject sets the schedulerName field on Pod and PodTemplateSpec resources.
@@ -17,3 +20,37 @@ func AddSchedulerNameToObject(obj metav1.Object, schedulerName string) {
 		obj.Spec.SchedulerName = schedulerName
 	}
 }
+
+// GetPriorityClassName returns the priority class name of a RayCluster or RayJob. The `priorityClassName` field
+// takes precedence over the `ray.io/priority-class-name` label.
+func GetPriorityClassName(obj metav1.Object) string {
+	switch obj := obj.(type) {
+	case *rayv1.RayCluster:
+		if obj.Spec.PriorityClassName != "" {
+			return obj.Spec.PriorityClassName
+		}
+	case *rayv1.RayJob:
+		if obj.Spec.PriorityClassName != "" {
+			return obj.Spec.PriorityClassName
+		}
+	}
+	return obj.GetLabels()[utils.RayPriorityClassName]
+}
+
+// AddPriorityClassNameToObject sets the priorityClassName field on Pod and PodTemplateSpec resources that don't set
+// one.
+func AddPriorityClassNameToObject(obj metav1.Object, priorityClassName string) {
+	if priorityClassName == "" {
+		return
+	}
+	switch obj := obj.(type) {
+	case *corev1.Pod:
+		if obj.Spec.PriorityClassName == "" {
+			obj.Spec.PriorityClassName = priorityClassName
+		}
+	case *corev1.PodTemplateSpec:
+		if obj.Spec.PriorityClassName == "" {
+			obj.Spec.PriorityClassName = priorityClassName
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
 func TestAddSchedulerNameToObject(t *testing.T) {
@@ -80,3 +81,79 @@ func TestAddSchedulerNameToObject(t *testing.T) {
 		}
 	})
 }
+
+func TestGetPriorityClassName(t *testing.T) {
+	tests := []struct {
+		obj      metav1.Object
+		name     string
+		expected string
+	}{
+		{
+			name: "RayCluster with priorityClassName",
+			obj: &rayv1.RayCluster{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{utils.RayPriorityClassName: "label-priority"}},
+				Spec:       rayv1.RayClusterSpec{PriorityClassName: "spec-priority"},
+			},
+			expected: "spec-priority",
+		},
+		{
+			name: "RayJob with priorityClassName",
+			obj: &rayv1.RayJob{
+				Spec: rayv1.RayJobSpec{PriorityClassName: "spec-priority"},
+			},
+			expected: "spec-priority",
+		},
+		{
+			name: "RayJob falls back to the priority class label",
+			obj: &rayv1.RayJob{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{utils.RayPriorityClassName: "label-priority"}},
+			},
+			expected: "label-priority",
+		},
+		{
+			name:     "no priority class",
+			obj:      &rayv1.RayCluster{},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetPriorityClassName(tt.obj); got != tt.expected {
+				t.Errorf("GetPriorityClassName() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAddPriorityClassNameToObject(t *testing.T) {
+	t.Run("Pod object should have priorityClassName set", func(t *testing.T) {
+		pod := &corev1.Pod{}
+
+		AddPriorityClassNameToObject(pod, "high-priority")
+
+		if pod.Spec.PriorityClassName != "high-priority" {
+			t.Errorf("expected priorityClassName to be %q, got %q", "high-priority", pod.Spec.PriorityClassName)
+		}
+	})
+
+	t.Run("PodTemplateSpec object should have priorityClassName set", func(t *testing.T) {
+		podTemplate := &corev1.PodTemplateSpec{}
+
+		AddPriorityClassNameToObject(podTemplate, "high-priority")
+
+		if podTemplate.Spec.PriorityClassName != "high-priority" {
+			t.Errorf("expected priorityClassName to be %q, got %q", "high-priority", podTemplate.Spec.PriorityClassName)
+		}
+	})
+
+	t.Run("existing priorityClassName should not be overridden", func(t *testing.T) {
+		pod := &corev1.Pod{Spec: corev1.PodSpec{PriorityClassName: "user-priority"}}
+
+		AddPriorityClassNameToObject(pod, "high-priority")
+
+		if pod.Spec.PriorityClassName != "user-priority" {
+			t.Errorf("expected priorityClassName to be %q, got %q", "user-priority", pod.Spec.PriorityClassName)
+		}
+	})
+}
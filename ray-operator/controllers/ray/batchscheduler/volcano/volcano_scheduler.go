@@ -22,6 +22,7 @@ import (
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
+	batchschedulerutils "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/utils"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
@@ -31,6 +32,10 @@ const (
 	QueueNameLabelKey                         = "volcano.sh/queue-name"
 	NetworkTopologyModeLabelKey               = "volcano.sh/network-topology-mode"
 	NetworkTopologyHighestTierAllowedLabelKey = "volcano.sh/network-topology-highest-tier-allowed"
+	// ResizePodGroupAnnotationKey opts a RayCluster or RayJob into a PodGroup that follows the desired replicas of its
+	// worker groups, including the replicas added by the Ray autoscaler. Without it, the PodGroup is sized from the
+	// minimum replicas and isn't resized when the worker groups scale.
+	ResizePodGroupAnnotationKey = "ray.io/volcano-resize-pod-group"
 )
 
 type VolcanoBatchScheduler struct {
@@ -39,6 +44,12 @@ type VolcanoBatchScheduler struct {
 
 type VolcanoBatchSchedulerFactory struct{}
 
+func init() {
+	schedulerinterface.RegisterBatchSchedulerFactory(GetPluginName(), func() schedulerinterface.BatchSchedulerFactory {
+		return &VolcanoBatchSchedulerFactory{}
+	})
+}
+
 func GetPluginName() string { return pluginName }
 
 func (v *VolcanoBatchScheduler) Name() string {
@@ -64,6 +75,9 @@ func (v *VolcanoBatchScheduler) handleRayCluster(ctx context.Context, raycluster
 	}
 
 	minMember, totalResource := v.calculatePodGroupParams(&raycluster.Spec)
+	if isPodGroupResizeEnabled(raycluster) {
+		minMember, totalResource = v.calculateScaledPodGroupParams(&raycluster.Spec)
+	}
 
 	_, err := v.syncPodGroup(ctx, raycluster, minMember, totalResource)
 	return err
@@ -206,6 +220,18 @@ func (v *VolcanoBatchScheduler) calculatePodGroupParams(rayClusterSpec *rayv1.Ra
 	return utils.CalculateMinReplicas(rayCluster) + 1, utils.CalculateMinResources(rayCluster)
 }
 
+// calculateScaledPodGroupParams calculates the PodGroup MinMember and MinResources from the desired replicas of the
+// worker groups, including the replicas added by the Ray autoscaler.
+func (v *VolcanoBatchScheduler) calculateScaledPodGroupParams(rayClusterSpec *rayv1.RayClusterSpec) (int32, corev1.ResourceList) {
+	rayCluster := &rayv1.RayCluster{Spec: *rayClusterSpec}
+	return utils.CalculateDesiredReplicas(rayCluster) + 1, utils.CalculateDesiredResources(rayCluster)
+}
+
+// isPodGroupResizeEnabled returns whether the PodGroup of the RayCluster or RayJob follows its desired replicas.
+func isPodGroupResizeEnabled(owner metav1.Object) bool {
+	return owner.GetAnnotations()[ResizePodGroupAnnotationKey] == "true"
+}
+
 func createPodGroup(owner metav1.Object, podGroupName string, size int32, totalResource corev1.ResourceList) (volcanoschedulingv1beta1.PodGroup, error) {
 	var ownerRef metav1.OwnerReference
 	switch obj := owner.(type) {
@@ -253,7 +279,7 @@ func createPodGroup(owner metav1.Object, podGroupName string, size int32, totalR
 	if queue, ok := owner.GetLabels()[QueueNameLabelKey]; ok {
 		podGroup.Spec.Queue = queue
 	}
-	if priorityClassName, ok := owner.GetLabels()[utils.RayPriorityClassName]; ok {
+	if priorityClassName := batchschedulerutils.GetPriorityClassName(owner); priorityClassName != "" {
 		podGroup.Spec.PriorityClassName = priorityClassName
 	}
 
@@ -265,6 +291,13 @@ func (v *VolcanoBatchScheduler) AddMetadataToChildResource(_ context.Context, pa
 	populateLabelsFromObject(parent, child, utils.RayPriorityClassName)
 	populateAnnotations(parent, child, groupName)
 	addSchedulerName(child, v.Name())
+	batchschedulerutils.AddPriorityClassNameToObject(child, batchschedulerutils.GetPriorityClassName(parent))
+}
+
+// IsGangScheduled returns whether the RayJob's RayCluster is scheduled as a gang. A PodGroup is created for every
+// RayJob that creates its RayCluster.
+func (v *VolcanoBatchScheduler) IsGangScheduled(rayJob *rayv1.RayJob) bool {
+	return rayJob.Spec.RayClusterSpec != nil
 }
 
 // CleanupOnCompletion recalculates and updates the PodGroup resources when a RayJob finishes.
@@ -325,6 +358,35 @@ func (v *VolcanoBatchScheduler) CleanupOnCompletion(ctx context.Context, object
 	return didUpdate, nil
 }
 
+// OnWorkerGroupReplicasChange resizes the PodGroup to the desired replicas of the RayCluster, so that the Pods
+// created by a scale-up are part of the gang instead of waiting outside of it. PodGroups are only resized if their
+// RayCluster or RayJob has the ResizePodGroupAnnotationKey annotation.
+//
+// The PodGroup of a RayCluster created by a RayJob belongs to the RayJob, and it's only resized while the RayJob
+// is running. CleanupOnCompletion shrinks it once the RayJob finishes.
+func (v *VolcanoBatchScheduler) OnWorkerGroupReplicasChange(ctx context.Context, rayCluster *rayv1.RayCluster, _ string, _ int32) error {
+	if rayCluster.Labels[utils.RayOriginatedFromCRDLabelKey] != utils.RayOriginatedFromCRDLabelValue(utils.RayJobCRD) {
+		if !isPodGroupResizeEnabled(rayCluster) {
+			return nil
+		}
+		return v.handleRayCluster(ctx, rayCluster)
+	}
+
+	rayJob := &rayv1.RayJob{}
+	rayJobKey := types.NamespacedName{Namespace: rayCluster.Namespace, Name: rayCluster.Labels[utils.RayOriginatedFromCRNameLabelKey]}
+	if err := v.cli.Get(ctx, rayJobKey, rayJob); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !isPodGroupResizeEnabled(rayJob) || rayv1.IsJobDeploymentTerminal(rayJob.Status.JobDeploymentStatus) {
+		return nil
+	}
+
+	minMember, clusterResource := v.calculateScaledPodGroupParams(&rayCluster.Spec)
+	totalResource := utils.SumResourceList([]corev1.ResourceList{clusterResource, getSubmitterResource(rayJob)})
+	_, err := v.syncPodGroup(ctx, rayJob, minMember, totalResource)
+	return err
+}
+
 func (vf *VolcanoBatchSchedulerFactory) New(_ context.Context, _ *rest.Config, cli client.Client) (schedulerinterface.BatchScheduler, error) {
 	if err := volcanoschedulingv1beta1.AddToScheme(cli.Scheme()); err != nil {
 		return nil, fmt.Errorf("failed to add volcano to scheme with error %w", err)
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -465,6 +466,26 @@ func TestAddMetadataToSubmitterPod(t *testing.T) {
 
 	// Check scheduler name
 	a.Equal(pluginName, submitterTemplate.Spec.SchedulerName)
+
+	// The priority class label is propagated to the Pod priority.
+	a.Equal("test-priority", submitterTemplate.Spec.PriorityClassName)
+}
+
+func TestCreatePodGroup_PriorityClassName(t *testing.T) {
+	a := assert.New(t)
+
+	// The priorityClassName field takes precedence over the label.
+	rayJob := createTestRayJob(1)
+	rayJob.Spec.PriorityClassName = "high-priority"
+	pg, err := createPodGroup(&rayJob, getAppPodGroupName(&rayJob), 3, corev1.ResourceList{})
+	require.NoError(t, err)
+	a.Equal("high-priority", pg.Spec.PriorityClassName)
+
+	cluster := createTestRayCluster(1)
+	cluster.Spec.PriorityClassName = "low-priority"
+	pg, err = createPodGroup(&cluster, getAppPodGroupName(&cluster), 3, corev1.ResourceList{})
+	require.NoError(t, err)
+	a.Equal("low-priority", pg.Spec.PriorityClassName)
 }
 
 func TestCalculatePodGroupParams(t *testing.T) {
@@ -503,6 +524,98 @@ func TestCalculatePodGroupParams(t *testing.T) {
 	})
 }
 
+func TestOnWorkerGroupReplicasChange(t *testing.T) {
+	ctx := context.Background()
+
+	newFakeClient := func(objects ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		require.NoError(t, rayv1.AddToScheme(scheme))
+		require.NoError(t, volcanoschedulingv1beta1.AddToScheme(scheme))
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	}
+
+	t.Run("RayCluster - PodGroup isn't resized without the opt-in annotation", func(t *testing.T) {
+		cluster := createTestRayCluster(1)
+		cluster.Spec.EnableInTreeAutoscaling = ptr.To(true)
+		fakeCli := newFakeClient()
+		scheduler := &VolcanoBatchScheduler{cli: fakeCli}
+
+		cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](3)
+		require.NoError(t, scheduler.OnWorkerGroupReplicasChange(ctx, &cluster, cluster.Spec.WorkerGroupSpecs[0].GroupName, 3))
+
+		var pg volcanoschedulingv1beta1.PodGroup
+		err := fakeCli.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: getAppPodGroupName(&cluster)}, &pg)
+		require.True(t, errors.IsNotFound(err))
+
+		// The PodGroup created on submission is sized from the minimum replicas.
+		require.NoError(t, scheduler.DoBatchSchedulingOnSubmission(ctx, &cluster))
+		require.NoError(t, fakeCli.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: getAppPodGroupName(&cluster)}, &pg))
+		minMember, _ := scheduler.calculatePodGroupParams(&cluster.Spec)
+		assert.Equal(t, minMember, pg.Spec.MinMember)
+	})
+
+	t.Run("RayCluster - PodGroup follows the replicas added by the autoscaler", func(t *testing.T) {
+		a := assert.New(t)
+		cluster := createTestRayCluster(1)
+		cluster.Annotations = map[string]string{ResizePodGroupAnnotationKey: "true"}
+		cluster.Spec.EnableInTreeAutoscaling = ptr.To(true)
+		fakeCli := newFakeClient()
+		scheduler := &VolcanoBatchScheduler{cli: fakeCli}
+
+		cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](3)
+		require.NoError(t, scheduler.OnWorkerGroupReplicasChange(ctx, &cluster, cluster.Spec.WorkerGroupSpecs[0].GroupName, 3))
+
+		var pg volcanoschedulingv1beta1.PodGroup
+		require.NoError(t, fakeCli.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: getAppPodGroupName(&cluster)}, &pg))
+		// 1 head + 3 workers
+		a.Equal(int32(4), pg.Spec.MinMember)
+		a.Equal("1024m", pg.Spec.MinResources.Cpu().String())
+		a.Equal("3", pg.Spec.MinResources.Name("nvidia.com/gpu", resource.BinarySI).String())
+	})
+
+	t.Run("RayJob - PodGroup of the RayJob is resized with the submitter resources", func(t *testing.T) {
+		a := assert.New(t)
+		rayJob := createTestRayJob(1)
+		rayJob.Annotations = map[string]string{ResizePodGroupAnnotationKey: "true"}
+		rayJob.Spec.SubmissionMode = rayv1.K8sJobMode
+		rayJob.Spec.RayClusterSpec.EnableInTreeAutoscaling = ptr.To(true)
+		fakeCli := newFakeClient(&rayJob)
+		scheduler := &VolcanoBatchScheduler{cli: fakeCli}
+		require.NoError(t, scheduler.DoBatchSchedulingOnSubmission(ctx, &rayJob))
+
+		cluster := &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rayjob-sample-raycluster",
+				Namespace: rayJob.Namespace,
+				Labels: map[string]string{
+					utils.RayOriginatedFromCRNameLabelKey: rayJob.Name,
+					utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayJobCRD),
+				},
+			},
+			Spec: *rayJob.Spec.RayClusterSpec.DeepCopy(),
+		}
+		cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](4)
+		require.NoError(t, scheduler.OnWorkerGroupReplicasChange(ctx, cluster, cluster.Spec.WorkerGroupSpecs[0].GroupName, 4))
+
+		var pg volcanoschedulingv1beta1.PodGroup
+		require.NoError(t, fakeCli.Get(ctx, client.ObjectKey{Namespace: rayJob.Namespace, Name: getAppPodGroupName(&rayJob)}, &pg))
+		// 1 head + 4 workers, the submitter is excluded from MinMember.
+		a.Equal(int32(5), pg.Spec.MinMember)
+		submitterResource := getSubmitterResource(&rayJob)
+		expectedCPU := resource.MustParse("1280m")
+		expectedCPU.Add(*submitterResource.Cpu())
+		a.Equal(expectedCPU.String(), pg.Spec.MinResources.Cpu().String())
+
+		// The PodGroup isn't resized once the RayJob finished.
+		rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusComplete
+		require.NoError(t, fakeCli.Update(ctx, &rayJob))
+		cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](1)
+		require.NoError(t, scheduler.OnWorkerGroupReplicasChange(ctx, cluster, cluster.Spec.WorkerGroupSpecs[0].GroupName, 1))
+		require.NoError(t, fakeCli.Get(ctx, client.ObjectKey{Namespace: rayJob.Namespace, Name: getAppPodGroupName(&rayJob)}, &pg))
+		a.Equal(int32(5), pg.Spec.MinMember)
+	})
+}
+
 func TestGetAppPodGroupName(t *testing.T) {
 	a := assert.New(t)
 
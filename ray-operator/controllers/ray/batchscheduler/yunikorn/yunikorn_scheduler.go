@@ -26,10 +26,18 @@ const (
 	YuniKornTaskGroupsAnnotationName    string = "yunikorn.apache.org/task-groups"
 )
 
-type YuniKornScheduler struct{}
+type YuniKornScheduler struct {
+	cli client.Client
+}
 
 type YuniKornSchedulerFactory struct{}
 
+func init() {
+	schedulerinterface.RegisterBatchSchedulerFactory(GetPluginName(), func() schedulerinterface.BatchSchedulerFactory {
+		return &YuniKornSchedulerFactory{}
+	})
+}
+
 func GetPluginName() string {
 	return SchedulerName
 }
@@ -113,12 +121,19 @@ func (y *YuniKornScheduler) isGangSchedulingEnabled(obj metav1.Object) bool {
 	return exist
 }
 
+// IsGangScheduled returns whether gang scheduling is enabled for the RayJob.
+func (y *YuniKornScheduler) IsGangScheduled(rayJob *rayv1.RayJob) bool {
+	return y.isGangSchedulingEnabled(rayJob)
+}
+
 func (y *YuniKornScheduler) AddMetadataToChildResource(ctx context.Context, parent metav1.Object, child metav1.Object, groupName string) {
 	logger := ctrl.LoggerFrom(ctx).WithName(SchedulerName)
 
 	populateLabelsFromObject(parent, child, RayApplicationIDLabelName, YuniKornPodApplicationIDLabelName)
 	populateLabelsFromObject(parent, child, RayApplicationQueueLabelName, YuniKornPodQueueLabelName)
 	batchschedulerutils.AddSchedulerNameToObject(child, y.Name())
+	// YuniKorn derives the priority of an application from the priority of its Pods.
+	batchschedulerutils.AddPriorityClassNameToObject(child, batchschedulerutils.GetPriorityClassName(parent))
 
 	if y.isGangSchedulingEnabled(parent) {
 		logger.Info("gang scheduling is enabled, propagating task groups annotation to child", "name", child.GetName(), "namespace", child.GetNamespace())
@@ -141,8 +156,48 @@ func (y *YuniKornScheduler) CleanupOnCompletion(_ context.Context, _ metav1.Obje
 	return false, nil
 }
 
-func (yf *YuniKornSchedulerFactory) New(_ context.Context, _ *rest.Config, _ client.Client) (schedulerinterface.BatchScheduler, error) {
-	return &YuniKornScheduler{}, nil
+// OnWorkerGroupReplicasChange sets the MinMember of the worker group's task group to the desired number of Pods in
+// the task groups annotation of the RayCluster, which is propagated to the Pods created afterwards.
+//
+// YuniKorn reads the task groups of an application once, from the annotations of its originator Pod, when the
+// application is created. This doesn't resize the gang of a running application: the updated task groups only take
+// effect for applications created later, for example after all the Pods of the RayCluster have been recreated.
+func (y *YuniKornScheduler) OnWorkerGroupReplicasChange(ctx context.Context, rayCluster *rayv1.RayCluster, groupName string, desiredPods int32) error {
+	if !y.isGangSchedulingEnabled(rayCluster) {
+		return nil
+	}
+
+	taskGroups := newTaskGroups()
+	if taskGroupsAnnotationValue := rayCluster.Annotations[YuniKornTaskGroupsAnnotationName]; taskGroupsAnnotationValue != "" {
+		if err := taskGroups.unmarshalFrom(taskGroupsAnnotationValue); err != nil {
+			return err
+		}
+	} else {
+		taskGroups = newTaskGroupsFromRayClusterSpec(&rayCluster.Spec)
+	}
+	if !taskGroups.setMinMember(groupName, desiredPods) {
+		return nil
+	}
+	taskGroupsAnnotationValue, err := taskGroups.marshal()
+	if err != nil {
+		return err
+	}
+
+	ctrl.LoggerFrom(ctx).WithName(SchedulerName).Info("updating the task group for applications created later", "group", groupName, "minMember", desiredPods)
+	original := rayCluster.DeepCopy()
+	annotations := rayCluster.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[YuniKornTaskGroupsAnnotationName] = taskGroupsAnnotationValue
+	rayCluster.SetAnnotations(annotations)
+	return y.cli.Patch(ctx, rayCluster, client.MergeFrom(original))
+}
+
+func (yf *YuniKornSchedulerFactory) New(_ context.Context, _ *rest.Config, cli client.Client) (schedulerinterface.BatchScheduler, error) {
+	return &YuniKornScheduler{
+		cli: cli,
+	}, nil
 }
 
 func (yf *YuniKornSchedulerFactory) AddToScheme(_ *runtime.Scheme) {
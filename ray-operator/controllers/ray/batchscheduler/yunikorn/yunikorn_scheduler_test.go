@@ -11,6 +11,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
@@ -517,6 +520,49 @@ func TestAddMetadataToChildResourceFromRayJob(t *testing.T) {
 	assert.JSONEq(t, `[{"minResource":{"cpu":"1","memory":"1Gi"},"name":"headgroup","minMember":1},{"minResource":{"cpu":"1","memory":"1Gi"},"name":"worker-group","minMember":1},{"minResource":{"cpu":"500m","memory":"200Mi"},"name":"submittergroup","minMember":1}]`, submitterPodTemplate.Annotations[YuniKornTaskGroupsAnnotationName])
 }
 
+func TestOnWorkerGroupReplicasChange(t *testing.T) {
+	rayCluster := createRayClusterWithLabels(
+		"ray-cluster-with-gang-scheduling",
+		"default",
+		map[string]string{
+			utils.RayGangSchedulingEnabled: "true",
+		},
+	)
+	addHeadPodSpec(rayCluster, corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("500m"),
+	})
+	addWorkerPodSpec(rayCluster, "worker-group-1", 1, 1, 5, corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("500m"),
+	})
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, rayv1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rayCluster).Build()
+	yk := &YuniKornScheduler{cli: fakeClient}
+	ctx := context.Background()
+
+	// Scale up the worker group from 1 to 3 Pods.
+	require.NoError(t, yk.OnWorkerGroupReplicasChange(ctx, rayCluster, "worker-group-1", 3))
+
+	updatedRayCluster := &rayv1.RayCluster{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(rayCluster), updatedRayCluster))
+	taskGroups := newTaskGroups()
+	require.NoError(t, taskGroups.unmarshalFrom(updatedRayCluster.Annotations[YuniKornTaskGroupsAnnotationName]))
+	assert.Equal(t, int32(1), taskGroups.getTaskGroup(utils.RayNodeHeadGroupLabelValue).MinMember)
+	assert.Equal(t, int32(3), taskGroups.getTaskGroup("worker-group-1").MinMember)
+
+	// The updated task groups are propagated to the Pods created afterwards.
+	pod := createPod("ray-worker", "default")
+	yk.AddMetadataToChildResource(ctx, rayCluster, pod, "worker-group-1")
+	assert.Equal(t, updatedRayCluster.Annotations[YuniKornTaskGroupsAnnotationName], pod.Annotations[YuniKornTaskGroupsAnnotationName])
+
+	// RayClusters without gang scheduling are not changed.
+	rayClusterWithoutGang := createRayClusterWithLabels("ray-cluster-without-gang-scheduling", "default", nil)
+	addWorkerPodSpec(rayClusterWithoutGang, "worker-group-1", 1, 1, 5, corev1.ResourceList{})
+	require.NoError(t, yk.OnWorkerGroupReplicasChange(ctx, rayClusterWithoutGang, "worker-group-1", 3))
+	assert.Empty(t, rayClusterWithoutGang.Annotations)
+}
+
 func createRayClusterWithLabels(name string, namespace string, labels map[string]string) *rayv1.RayCluster {
 	rayCluster := &rayv1.RayCluster{
 		ObjectMeta: metav1.ObjectMeta{
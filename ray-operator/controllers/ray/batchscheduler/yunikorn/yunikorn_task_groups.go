@@ -112,3 +112,14 @@ func (t *TaskGroups) getTaskGroup(name string) TaskGroup {
 	}
 	return TaskGroup{}
 }
+
+// setMinMember sets the MinMember of the task group with the given name, and returns whether it changed.
+func (t *TaskGroups) setMinMember(name string, minMember int32) bool {
+	for i := range t.Groups {
+		if t.Groups[i].Name == name && t.Groups[i].MinMember != minMember {
+			t.Groups[i].MinMember = minMember
+			return true
+		}
+	}
+	return false
+}
@@ -129,6 +129,52 @@ func RayClusterAllPodsAssociationOptions(instance *rayv1.RayCluster) Association
 	}
 }
 
+func RayClusterPodDisruptionBudgetsAssociationOptions(instance *rayv1.RayCluster) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{
+			utils.RayClusterLabelKey:          instance.Name,
+			utils.KubernetesCreatedByLabelKey: utils.ComponentName,
+		},
+	}
+}
+
+// RayClusterImagePrePullDaemonSetsAssociationOptions selects the DaemonSets that pre-pull the images of the RayCluster.
+func RayClusterImagePrePullDaemonSetsAssociationOptions(instance *rayv1.RayCluster) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{
+			utils.RayClusterLabelKey:          instance.Name,
+			utils.KubernetesCreatedByLabelKey: utils.ComponentName,
+		},
+	}
+}
+
+// RayClusterVolumeClaimsAssociationOptions selects the PersistentVolumeClaims that KubeRay created for the Pods of the
+// RayCluster.
+func RayClusterVolumeClaimsAssociationOptions(instance *rayv1.RayCluster) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{
+			utils.RayClusterLabelKey:          instance.Name,
+			utils.KubernetesCreatedByLabelKey: utils.ComponentName,
+		},
+	}
+}
+
+// RayClusterWorkerVolumeClaimsAssociationOptions selects the PersistentVolumeClaims that KubeRay created for the worker
+// Pods of the RayCluster.
+func RayClusterWorkerVolumeClaimsAssociationOptions(instance *rayv1.RayCluster) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{
+			utils.RayClusterLabelKey:          instance.Name,
+			utils.RayNodeTypeLabelKey:         string(rayv1.WorkerNode),
+			utils.KubernetesCreatedByLabelKey: utils.ComponentName,
+		},
+	}
+}
+
 func RayServiceRayClustersAssociationOptions(rayService *rayv1.RayService) AssociationOptions {
 	return AssociationOptions{
 		client.InNamespace(rayService.Namespace),
@@ -139,6 +185,16 @@ func RayServiceRayClustersAssociationOptions(rayService *rayv1.RayService) Assoc
 	}
 }
 
+func RayServiceRevisionsAssociationOptions(rayService *rayv1.RayService) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(rayService.Namespace),
+		client.MatchingLabels{
+			utils.RayOriginatedFromCRNameLabelKey: rayService.Name,
+			utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+		},
+	}
+}
+
 func RayServiceServeServiceNamespacedName(rayService *rayv1.RayService) types.NamespacedName {
 	if rayService.Spec.ServeService != nil && rayService.Spec.ServeService.Name != "" {
 		return types.NamespacedName{
@@ -0,0 +1,150 @@
+package common
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// GcsStorageBackend is the external storage that GCS persists its metadata to when GCS fault tolerance is enabled.
+// Each storage option supported by Ray implements this interface, so that the head Pod configuration and the
+// cleanup Job created by the GCS FT finalizer do not need to know which storage is in use.
+type GcsStorageBackend interface {
+	// Name returns a human-readable name of the storage backend, used in logs and events.
+	Name() string
+	// ConfigureHeadContainer sets the environment variables and rayStartParams that the head Pod needs to connect to the storage.
+	ConfigureHeadContainer(container *corev1.Container, rayStartParams map[string]string)
+	// ConfigureCleanupContainer turns the Ray container of the head Pod into one that deletes the RayCluster's storage
+	// namespace from the storage. The container's environment has already been configured by ConfigureHeadContainer.
+	ConfigureCleanupContainer(container *corev1.Container)
+}
+
+// GetGcsStorageBackendType returns the storage backend type of the GCS FT options, defaulting to Redis.
+func GetGcsStorageBackendType(options *rayv1.GcsFaultToleranceOptions) rayv1.GcsStorageBackendType {
+	if options == nil || options.StorageBackend == nil {
+		return rayv1.GcsStorageBackendRedis
+	}
+	return *options.StorageBackend
+}
+
+// NewGcsStorageBackend returns the storage backend for the RayCluster's GCS FT options. The options may be nil when
+// GCS FT is enabled through the legacy `ray.io/ft-enabled` annotation, in which case the Redis backend is configured
+// by the users directly through environment variables and rayStartParams.
+func NewGcsStorageBackend(cluster *rayv1.RayCluster) (GcsStorageBackend, error) {
+	options := cluster.Spec.GcsFaultToleranceOptions
+	switch backendType := GetGcsStorageBackendType(options); backendType {
+	case rayv1.GcsStorageBackendRedis:
+		return &redisStorageBackend{cluster: cluster, options: options}, nil
+	default:
+		return nil, fmt.Errorf("unsupported GCS storage backend %q", backendType)
+	}
+}
+
+// redisStorageBackend stores the GCS metadata in Redis, which is either provided by the users or managed by KubeRay.
+type redisStorageBackend struct {
+	cluster *rayv1.RayCluster
+	options *rayv1.GcsFaultToleranceOptions
+}
+
+func (b *redisStorageBackend) Name() string {
+	return "Redis"
+}
+
+func (b *redisStorageBackend) ConfigureHeadContainer(container *corev1.Container, rayStartParams map[string]string) {
+	if b.options == nil {
+		// If users directly set the `redis-password` in `rayStartParams` instead of referring
+		// to a K8s secret, we need to set the `REDIS_PASSWORD` env var so that the Redis cleanup
+		// job can connect to Redis using the password. This is not recommended.
+		if !utils.EnvVarExists(utils.REDIS_PASSWORD, container.Env) {
+			// setting the REDIS_PASSWORD env var from the params
+			redisPasswordEnv := corev1.EnvVar{Name: utils.REDIS_PASSWORD}
+			if value, ok := rayStartParams["redis-password"]; ok {
+				redisPasswordEnv.Value = value
+				container.Env = append(container.Env, redisPasswordEnv)
+			}
+		}
+		return
+	}
+
+	if b.options.ManagedRedis != nil {
+		// Connect to the Redis managed by KubeRay with the password in the generated Secret.
+		rayStartParams["redis-password"] = "$REDIS_PASSWORD"
+		container.Env = append(container.Env,
+			corev1.EnvVar{
+				Name:  utils.RAY_REDIS_ADDRESS,
+				Value: utils.GenerateManagedRedisAddress(b.cluster),
+			},
+			corev1.EnvVar{
+				Name: utils.REDIS_PASSWORD,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: utils.GenerateManagedRedisName(b.cluster.Name)},
+						Key:                  utils.ManagedRedisPasswordSecretKey,
+					},
+				},
+			},
+		)
+		return
+	}
+
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  utils.RAY_REDIS_ADDRESS,
+		Value: b.options.RedisAddress,
+	})
+	if b.options.RedisUsername != nil {
+		// Note that `redis-username` will be supported starting from Ray 2.41.
+		// If `GcsFaultToleranceOptions.RedisUsername` is set, it will be put into the
+		// `REDIS_USERNAME` environment variable later. Here, we use `$REDIS_USERNAME` in
+		// rayStartParams to refer to the environment variable.
+		rayStartParams["redis-username"] = "$REDIS_USERNAME"
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:      utils.REDIS_USERNAME,
+			Value:     b.options.RedisUsername.Value,
+			ValueFrom: b.options.RedisUsername.ValueFrom,
+		})
+	}
+	if b.options.RedisPassword != nil {
+		// If `GcsFaultToleranceOptions.RedisPassword` is set, it will be put into the
+		// `REDIS_PASSWORD` environment variable later. Here, we use `$REDIS_PASSWORD` in
+		// rayStartParams to refer to the environment variable.
+		rayStartParams["redis-password"] = "$REDIS_PASSWORD"
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:      utils.REDIS_PASSWORD,
+			Value:     b.options.RedisPassword.Value,
+			ValueFrom: b.options.RedisPassword.ValueFrom,
+		})
+	}
+}
+
+func (b *redisStorageBackend) ConfigureCleanupContainer(container *corev1.Container) {
+	container.Command = utils.GetContainerCommand([]string{})
+	container.Args = []string{
+		"echo \"To get more information about manually deleting the storage namespace in Redis and removing the RayCluster's finalizer, please check https://docs.ray.io/en/master/cluster/kubernetes/user-guides/kuberay-gcs-ft.html for more details.\" && " +
+			"python -c " +
+			"\"from ray._private.gcs_utils import cleanup_redis_storage; " +
+			"from urllib.parse import urlparse; " +
+			"import os; " +
+			"import sys; " +
+			"redis_address = os.getenv('RAY_REDIS_ADDRESS', '').split(',')[0]; " +
+			"redis_address = redis_address if '://' in redis_address else 'redis://' + redis_address; " +
+			"parsed = urlparse(redis_address); ",
+	}
+	if utils.EnvVarExists(utils.REDIS_USERNAME, container.Env) {
+		container.Args[0] += "sys.exit(1) if not cleanup_redis_storage(host=parsed.hostname, port=parsed.port, username=os.getenv('REDIS_USERNAME', parsed.username), password=os.getenv('REDIS_PASSWORD', parsed.password or ''), use_ssl=parsed.scheme=='rediss', storage_namespace=os.getenv('RAY_external_storage_namespace')) else None\""
+	} else {
+		container.Args[0] += "sys.exit(1) if not cleanup_redis_storage(host=parsed.hostname, port=parsed.port, password=os.getenv('REDIS_PASSWORD', parsed.password or ''), use_ssl=parsed.scheme=='rediss', storage_namespace=os.getenv('RAY_external_storage_namespace')) else None\""
+	}
+
+	// Set the environment variables to ensure that the cleanup Job has at least 60s.
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  "RAY_redis_db_connect_retries",
+		Value: "120",
+	})
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  "RAY_redis_db_connect_wait_milliseconds",
+		Value: "500",
+	})
+}
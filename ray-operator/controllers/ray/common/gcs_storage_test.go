@@ -0,0 +1,109 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestNewGcsStorageBackend(t *testing.T) {
+	tests := []struct {
+		options     *rayv1.GcsFaultToleranceOptions
+		name        string
+		backendName string
+		expectError bool
+	}{
+		{
+			name:        "legacy annotation without options defaults to Redis",
+			backendName: "Redis",
+		},
+		{
+			name:        "options without storage backend default to Redis",
+			options:     &rayv1.GcsFaultToleranceOptions{RedisAddress: "redis:6379"},
+			backendName: "Redis",
+		},
+		{
+			name: "explicit Redis storage backend",
+			options: &rayv1.GcsFaultToleranceOptions{
+				StorageBackend: ptr.To(rayv1.GcsStorageBackendRedis),
+				RedisAddress:   "redis:6379",
+			},
+			backendName: "Redis",
+		},
+		{
+			name: "unsupported storage backend",
+			options: &rayv1.GcsFaultToleranceOptions{
+				StorageBackend: ptr.To(rayv1.GcsStorageBackendType("Unknown")),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backend, err := NewGcsStorageBackend(&rayv1.RayCluster{Spec: rayv1.RayClusterSpec{GcsFaultToleranceOptions: tc.options}})
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.backendName, backend.Name())
+		})
+	}
+}
+
+func TestRedisStorageBackendConfigureCleanupContainer(t *testing.T) {
+	backend, err := NewGcsStorageBackend(&rayv1.RayCluster{
+		Spec: rayv1.RayClusterSpec{
+			GcsFaultToleranceOptions: &rayv1.GcsFaultToleranceOptions{
+				RedisAddress:  "redis:6379",
+				RedisUsername: &rayv1.RedisCredential{Value: "user"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	container := corev1.Container{}
+	rayStartParams := map[string]string{}
+	backend.ConfigureHeadContainer(&container, rayStartParams)
+	assert.Equal(t, "$REDIS_USERNAME", rayStartParams["redis-username"])
+
+	backend.ConfigureCleanupContainer(&container)
+	require.Len(t, container.Args, 1)
+	assert.Contains(t, container.Args[0], "cleanup_redis_storage(")
+	assert.Contains(t, container.Args[0], "username=os.getenv('REDIS_USERNAME', parsed.username)")
+	assert.True(t, utils.EnvVarExists(utils.RAY_REDIS_ADDRESS, container.Env))
+	assert.True(t, utils.EnvVarExists("RAY_redis_db_connect_retries", container.Env))
+}
+
+func TestRedisStorageBackendConfigureHeadContainerWithManagedRedis(t *testing.T) {
+	backend, err := NewGcsStorageBackend(&rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "raycluster", Namespace: "ray"},
+		Spec: rayv1.RayClusterSpec{
+			GcsFaultToleranceOptions: &rayv1.GcsFaultToleranceOptions{
+				ManagedRedis: &rayv1.ManagedRedisOptions{},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	container := corev1.Container{}
+	rayStartParams := map[string]string{}
+	backend.ConfigureHeadContainer(&container, rayStartParams)
+	assert.Equal(t, "$REDIS_PASSWORD", rayStartParams["redis-password"])
+
+	address, ok := utils.EnvVarByName(utils.RAY_REDIS_ADDRESS, container.Env)
+	require.True(t, ok)
+	assert.Equal(t, "raycluster-redis.ray.svc.cluster.local:6379", address.Value)
+	password, ok := utils.EnvVarByName(utils.REDIS_PASSWORD, container.Env)
+	require.True(t, ok)
+	assert.Equal(t, "raycluster-redis", password.ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, utils.ManagedRedisPasswordSecretKey, password.ValueFrom.SecretKeyRef.Key)
+}
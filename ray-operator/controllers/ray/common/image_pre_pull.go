@@ -0,0 +1,184 @@
+package common
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const (
+	imagePrePullBinVolumeName = "kuberay-bin"
+	imagePrePullBinPath       = "/kuberay-bin"
+)
+
+// BuildImagePrePullDaemonSets returns the DaemonSets that pre-pull the images of the head group and the worker groups
+// of the RayCluster. It returns nil if .Spec.ImagePrePull is not set.
+func BuildImagePrePullDaemonSets(cluster *rayv1.RayCluster) []*appsv1.DaemonSet {
+	if cluster.Spec.ImagePrePull == nil {
+		return nil
+	}
+	var daemonSets []*appsv1.DaemonSet
+	if daemonSet := buildImagePrePullDaemonSet(cluster, utils.RayNodeHeadGroupLabelValue, &cluster.Spec.HeadGroupSpec.Template); daemonSet != nil {
+		daemonSets = append(daemonSets, daemonSet)
+	}
+	for i := range cluster.Spec.WorkerGroupSpecs {
+		workerGroup := &cluster.Spec.WorkerGroupSpecs[i]
+		if daemonSet := buildImagePrePullDaemonSet(cluster, workerGroup.GroupName, &workerGroup.Template); daemonSet != nil {
+			daemonSets = append(daemonSets, daemonSet)
+		}
+	}
+	return daemonSets
+}
+
+// IsImagePrePullDaemonSetReady returns whether the DaemonSet has pulled the current images on all the nodes that it's
+// scheduled on. The images are pulled by the containers of the DaemonSet Pods, so a DaemonSet Pod is ready once they
+// are pulled. A DaemonSet that isn't scheduled on any node is ready, since there are no nodes to pull the images on,
+// such as when the nodes of the group are provisioned on demand.
+func IsImagePrePullDaemonSetReady(daemonSet *appsv1.DaemonSet) bool {
+	status := daemonSet.Status
+	return status.ObservedGeneration >= daemonSet.Generation &&
+		status.UpdatedNumberScheduled == status.DesiredNumberScheduled &&
+		status.NumberReady == status.DesiredNumberScheduled
+}
+
+// buildImagePrePullDaemonSet returns the DaemonSet that pre-pulls the images of a group. It returns nil if the Pod
+// template of the group has no images.
+func buildImagePrePullDaemonSet(cluster *rayv1.RayCluster, groupName string, template *corev1.PodTemplateSpec) *appsv1.DaemonSet {
+	images := imagePrePullImages(template)
+	if len(images) == 0 {
+		return nil
+	}
+	template = template.DeepCopy()
+	selector := map[string]string{
+		utils.RayImagePrePullLabelKey: cluster.Name,
+		utils.RayNodeGroupLabelKey:    groupName,
+	}
+
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+			corev1.ResourceMemory: resource.MustParse("32Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+			corev1.ResourceMemory: resource.MustParse("32Mi"),
+		},
+	}
+	if cluster.Spec.ImagePrePull.Resources != nil {
+		resources = *cluster.Spec.ImagePrePull.Resources.DeepCopy()
+	}
+	securityContext := &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+	}
+
+	// The images may not provide any command, such as distroless images, so the first init container copies the static
+	// busybox binary of the helper image into a volume that the other containers run it from. Each image except the Ray
+	// container's image is pulled by an init container that exits immediately. The Ray container's image is used by
+	// the long-running container, which keeps the DaemonSet Pod on the node.
+	helperImage := cluster.Spec.ImagePrePull.HelperImage
+	if helperImage == "" {
+		helperImage = utils.DefaultImagePrePullHelperImage
+	}
+	binVolumeMounts := []corev1.VolumeMount{{Name: imagePrePullBinVolumeName, MountPath: imagePrePullBinPath}}
+	busybox := imagePrePullBinPath + "/busybox"
+	initContainers := []corev1.Container{{
+		Name:            "install-busybox",
+		Image:           helperImage,
+		Command:         []string{"/bin/busybox", "cp", "/bin/busybox", busybox},
+		Resources:       resources,
+		SecurityContext: securityContext,
+		VolumeMounts:    binVolumeMounts,
+	}}
+	for i, image := range images[1:] {
+		initContainers = append(initContainers, corev1.Container{
+			Name:            fmt.Sprintf("pull-%d", i),
+			Image:           image.Image,
+			ImagePullPolicy: image.ImagePullPolicy,
+			Command:         []string{busybox, "true"},
+			Resources:       resources,
+			SecurityContext: securityContext,
+			VolumeMounts:    binVolumeMounts,
+		})
+	}
+
+	podSpec := corev1.PodSpec{
+		InitContainers: initContainers,
+		Containers: []corev1.Container{{
+			Name:            "pause",
+			Image:           images[0].Image,
+			ImagePullPolicy: images[0].ImagePullPolicy,
+			Command:         []string{busybox, "sleep", "infinity"},
+			Resources:       resources,
+			SecurityContext: securityContext,
+			VolumeMounts:    binVolumeMounts,
+		}},
+		Volumes: []corev1.Volume{{
+			Name:         imagePrePullBinVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}},
+		NodeSelector:                  template.Spec.NodeSelector,
+		Tolerations:                   template.Spec.Tolerations,
+		ImagePullSecrets:              template.Spec.ImagePullSecrets,
+		ServiceAccountName:            template.Spec.ServiceAccountName,
+		AutomountServiceAccountToken:  ptr.To(false),
+		SecurityContext:               template.Spec.SecurityContext,
+		TerminationGracePeriodSeconds: ptr.To[int64](0),
+	}
+	// Only the node affinity applies to the DaemonSet Pods. The Pod affinity of the group refers to Ray Pods.
+	if template.Spec.Affinity != nil && template.Spec.Affinity.NodeAffinity != nil {
+		podSpec.Affinity = &corev1.Affinity{NodeAffinity: template.Spec.Affinity.NodeAffinity}
+	}
+
+	labels := map[string]string{
+		utils.RayClusterLabelKey:                cluster.Name,
+		utils.RayNodeGroupLabelKey:              groupName,
+		utils.KubernetesApplicationNameLabelKey: utils.ApplicationName,
+		utils.KubernetesCreatedByLabelKey:       utils.ComponentName,
+	}
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utils.GenerateImagePrePullDaemonSetName(cluster.Name, groupName),
+			Namespace: cluster.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: selector},
+				Spec:       podSpec,
+			},
+			// Pull the new images on all the nodes at once when the images of the group change.
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+					MaxUnavailable: ptr.To(intstr.FromString("100%")),
+				},
+			},
+		},
+	}
+}
+
+// imagePrePullImages returns the images of the containers and init containers of the Pod template without
+// duplicates. The image of the Ray container comes first.
+func imagePrePullImages(template *corev1.PodTemplateSpec) []corev1.Container {
+	var images []corev1.Container
+	seen := map[string]bool{}
+	containers := append(append([]corev1.Container{}, template.Spec.Containers...), template.Spec.InitContainers...)
+	for _, container := range containers {
+		if container.Image == "" || seen[container.Image] {
+			continue
+		}
+		seen[container.Image] = true
+		images = append(images, corev1.Container{Image: container.Image, ImagePullPolicy: container.ImagePullPolicy})
+	}
+	return images
+}
@@ -0,0 +1,124 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestBuildImagePrePullDaemonSets(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "raycluster", Namespace: "ray"},
+		Spec: rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.46.0"}},
+					},
+				},
+			},
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{{
+				GroupName: "gpu-group",
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						InitContainers: []corev1.Container{{Name: "init", Image: "busybox:1.36"}},
+						Containers: []corev1.Container{
+							{Name: "ray-worker", Image: "rayproject/ray:2.46.0-gpu", ImagePullPolicy: corev1.PullAlways},
+							{Name: "sidecar", Image: "fluent/fluent-bit:3.0"},
+							{Name: "another-sidecar", Image: "fluent/fluent-bit:3.0"},
+						},
+						NodeSelector: map[string]string{"cloud.google.com/gke-accelerator": "nvidia-l4"},
+						Tolerations:  []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}},
+						Affinity: &corev1.Affinity{
+							NodeAffinity: &corev1.NodeAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{},
+							},
+							PodAntiAffinity: &corev1.PodAntiAffinity{},
+						},
+						ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+					},
+				},
+			}},
+		},
+	}
+	assert.Empty(t, BuildImagePrePullDaemonSets(cluster))
+
+	cluster.Spec.ImagePrePull = &rayv1.ImagePrePull{}
+	daemonSets := BuildImagePrePullDaemonSets(cluster)
+	require.Len(t, daemonSets, 2)
+
+	head := daemonSets[0]
+	assert.Equal(t, "raycluster-headgroup-image-pre-pull", head.Name)
+	assert.Equal(t, "ray", head.Namespace)
+	assert.Equal(t, "raycluster", head.Labels[utils.RayClusterLabelKey])
+	// The helper init container copies the busybox binary that the other containers run, so the images don't need to
+	// provide any command.
+	require.Len(t, head.Spec.Template.Spec.InitContainers, 1)
+	helper := head.Spec.Template.Spec.InitContainers[0]
+	assert.Equal(t, utils.DefaultImagePrePullHelperImage, helper.Image)
+	assert.Equal(t, []string{"/bin/busybox", "cp", "/bin/busybox", "/kuberay-bin/busybox"}, helper.Command)
+	require.Len(t, head.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, "rayproject/ray:2.46.0", head.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, []string{"/kuberay-bin/busybox", "sleep", "infinity"}, head.Spec.Template.Spec.Containers[0].Command)
+	assert.Equal(t, helper.VolumeMounts, head.Spec.Template.Spec.Containers[0].VolumeMounts)
+	require.Len(t, head.Spec.Template.Spec.Volumes, 1)
+	assert.NotNil(t, head.Spec.Template.Spec.Volumes[0].EmptyDir)
+
+	worker := daemonSets[1]
+	assert.Equal(t, "raycluster-gpu-group-image-pre-pull", worker.Name)
+	// The DaemonSet Pods must not be selected as Ray Pods.
+	assert.NotContains(t, worker.Spec.Template.Labels, utils.RayClusterLabelKey)
+	assert.Equal(t, worker.Spec.Selector.MatchLabels, worker.Spec.Template.Labels)
+	podSpec := worker.Spec.Template.Spec
+	require.Len(t, podSpec.Containers, 1)
+	assert.Equal(t, "rayproject/ray:2.46.0-gpu", podSpec.Containers[0].Image)
+	assert.Equal(t, corev1.PullAlways, podSpec.Containers[0].ImagePullPolicy)
+	var initImages []string
+	for _, container := range podSpec.InitContainers[1:] {
+		initImages = append(initImages, container.Image)
+		assert.Equal(t, []string{"/kuberay-bin/busybox", "true"}, container.Command)
+	}
+	assert.Equal(t, []string{"fluent/fluent-bit:3.0", "busybox:1.36"}, initImages)
+	assert.Equal(t, cluster.Spec.WorkerGroupSpecs[0].Template.Spec.NodeSelector, podSpec.NodeSelector)
+	assert.Equal(t, cluster.Spec.WorkerGroupSpecs[0].Template.Spec.Tolerations, podSpec.Tolerations)
+	assert.Equal(t, cluster.Spec.WorkerGroupSpecs[0].Template.Spec.ImagePullSecrets, podSpec.ImagePullSecrets)
+	require.NotNil(t, podSpec.Affinity)
+	assert.NotNil(t, podSpec.Affinity.NodeAffinity)
+	assert.Nil(t, podSpec.Affinity.PodAntiAffinity)
+
+	cluster.Spec.ImagePrePull.HelperImage = "registry.example.com/busybox:1.36"
+	daemonSets = BuildImagePrePullDaemonSets(cluster)
+	require.Len(t, daemonSets, 2)
+	assert.Equal(t, "registry.example.com/busybox:1.36", daemonSets[1].Spec.Template.Spec.InitContainers[0].Image)
+}
+
+func TestIsImagePrePullDaemonSetReady(t *testing.T) {
+	newDaemonSet := func(generation int64, status appsv1.DaemonSetStatus) *appsv1.DaemonSet {
+		return &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Generation: generation}, Status: status}
+	}
+
+	// The DaemonSet isn't scheduled on any node, so there are no images to pull.
+	assert.True(t, IsImagePrePullDaemonSetReady(newDaemonSet(1, appsv1.DaemonSetStatus{ObservedGeneration: 1})))
+	assert.True(t, IsImagePrePullDaemonSetReady(newDaemonSet(2, appsv1.DaemonSetStatus{
+		ObservedGeneration: 2, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberReady: 3,
+	})))
+	// The DaemonSet controller hasn't observed the new images yet.
+	assert.False(t, IsImagePrePullDaemonSetReady(newDaemonSet(2, appsv1.DaemonSetStatus{
+		ObservedGeneration: 1, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberReady: 3,
+	})))
+	// Some nodes still run the Pods with the old images.
+	assert.False(t, IsImagePrePullDaemonSetReady(newDaemonSet(2, appsv1.DaemonSetStatus{
+		ObservedGeneration: 2, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 1, NumberReady: 3,
+	})))
+	// Some nodes are still pulling the images.
+	assert.False(t, IsImagePrePullDaemonSetReady(newDaemonSet(2, appsv1.DaemonSetStatus{
+		ObservedGeneration: 2, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberReady: 2,
+	})))
+}
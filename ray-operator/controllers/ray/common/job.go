@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 
@@ -81,10 +82,17 @@ func GetMetadataJson(metadata map[string]string, rayVersion string) (string, err
 	return pkgutils.ConvertByteSliceToString(metadataBytes), nil
 }
 
-// BuildJobSubmitCommand builds the `ray job submit` command based on submission mode.
-func BuildJobSubmitCommand(rayJobInstance *rayv1.RayJob, submissionMode rayv1.JobSubmissionMode) ([]string, error) {
+// BuildJobSubmitCommand builds the `ray job submit` command based on submission mode. If httpsEnabled is true, the
+// command connects to the Ray dashboard over HTTPS and verifies it with the CA mounted from the TLS Secret.
+func BuildJobSubmitCommand(rayJobInstance *rayv1.RayJob, submissionMode rayv1.JobSubmissionMode, httpsEnabled bool) ([]string, error) {
 	var address string
 	port := utils.DefaultDashboardPort
+	scheme := "http://"
+	var verifyArgs []string
+	if httpsEnabled {
+		scheme = "https://"
+		verifyArgs = []string{"--verify", path.Join(utils.RayTLSMountPath, utils.RayTLSCACertKey)}
+	}
 
 	switch submissionMode {
 	case rayv1.SidecarMode:
@@ -92,13 +100,11 @@ func BuildJobSubmitCommand(rayJobInstance *rayv1.RayJob, submissionMode rayv1.Jo
 		// so it uses 127.0.0.1 to connect to the Ray dashboard.
 		rayHeadContainer := rayJobInstance.Spec.RayClusterSpec.HeadGroupSpec.Template.Spec.Containers[utils.RayContainerIndex]
 		port = int(utils.FindContainerPort(&rayHeadContainer, utils.DashboardPortName, utils.DefaultDashboardPort))
-		address = "http://127.0.0.1:" + strconv.Itoa(port)
+		address = scheme + "127.0.0.1:" + strconv.Itoa(port)
 	case rayv1.K8sJobMode:
 		// Submitter is a separate K8s Job; use cluster dashboard address.
-		address = rayJobInstance.Status.DashboardURL
-		if !strings.HasPrefix(address, "http://") {
-			address = "http://" + address
-		}
+		address = strings.TrimPrefix(strings.TrimPrefix(rayJobInstance.Status.DashboardURL, "http://"), "https://")
+		address = scheme + address
 	default:
 		return nil, fmt.Errorf("unsupported submission mode for job submit command: %s", submissionMode)
 	}
@@ -120,9 +126,9 @@ func BuildJobSubmitCommand(rayJobInstance *rayv1.RayJob, submissionMode rayv1.Jo
 	//   then ray job submit --address http://$RAY_ADDRESS --submission-id $RAY_JOB_SUBMISSION_ID --no-wait -- ... ;
 	//   fi ; ray job logs --address http://$RAY_ADDRESS --follow $RAY_JOB_SUBMISSION_ID
 	// In Sidecar mode, the sidecar container's restart policy is set to Never, so duplicated submission won't happen.
-	jobStatusCommand := []string{"ray", "job", "status", "--address", address, jobId, ">/dev/null", "2>&1"}
-	jobSubmitCommand := []string{"ray", "job", "submit", "--address", address}
-	jobFollowCommand := []string{"ray", "job", "logs", "--address", address, "--follow", jobId}
+	jobStatusCommand := append(append([]string{"ray", "job", "status", "--address", address}, verifyArgs...), jobId, ">/dev/null", "2>&1")
+	jobSubmitCommand := append([]string{"ray", "job", "submit", "--address", address}, verifyArgs...)
+	jobFollowCommand := append(append([]string{"ray", "job", "logs", "--address", address}, verifyArgs...), "--follow", jobId)
 
 	if submissionMode == rayv1.SidecarMode {
 		// Wait until Ray Dashboard GCS is healthy before proceeding.
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -94,7 +95,7 @@ func TestBuildJobSubmitCommandWithK8sJobMode(t *testing.T) {
 		";", "fi", ";",
 		"ray", "job", "logs", "--address", "http://127.0.0.1:8265", "--follow", "testJobId",
 	}
-	command, err := BuildJobSubmitCommand(testRayJob, rayv1.K8sJobMode)
+	command, err := BuildJobSubmitCommand(testRayJob, rayv1.K8sJobMode, false)
 	require.NoError(t, err)
 	assert.Equal(t, expected, command)
 }
@@ -133,7 +134,7 @@ func TestBuildJobSubmitCommandWithSidecarMode(t *testing.T) {
 		"echo no quote 'single quote' \"double quote\"",
 		";",
 	}
-	command, err := BuildJobSubmitCommand(testRayJob, rayv1.SidecarMode)
+	command, err := BuildJobSubmitCommand(testRayJob, rayv1.SidecarMode, false)
 	require.NoError(t, err)
 	assert.Equal(t, expected, command)
 }
@@ -174,7 +175,7 @@ func TestBuildJobSubmitCommandWithSidecarModeVersionSwitch(t *testing.T) {
 				},
 			}
 
-			command, err := BuildJobSubmitCommand(testRayJob, rayv1.SidecarMode)
+			command, err := BuildJobSubmitCommand(testRayJob, rayv1.SidecarMode, false)
 			require.NoError(t, err)
 			require.GreaterOrEqual(t, len(command), 2)
 			assert.Equal(t, "until", command[0])
@@ -199,7 +200,7 @@ func TestBuildJobSubmitCommandWithSidecarModeCustomDashboardPort(t *testing.T) {
 		},
 	}
 
-	command, err := BuildJobSubmitCommand(testRayJob, rayv1.SidecarMode)
+	command, err := BuildJobSubmitCommand(testRayJob, rayv1.SidecarMode, false)
 	require.NoError(t, err)
 	require.GreaterOrEqual(t, len(command), 2)
 	assert.Equal(t, "until", command[0])
@@ -210,7 +211,7 @@ func TestBuildJobSubmitCommandWithSidecarModeCustomDashboardPort(t *testing.T) {
 
 func TestBuildJobSubmitCommandWithK8sJobModeNoSidecarHealthWaitLoop(t *testing.T) {
 	testRayJob := rayJobTemplate()
-	command, err := BuildJobSubmitCommand(testRayJob, rayv1.K8sJobMode)
+	command, err := BuildJobSubmitCommand(testRayJob, rayv1.K8sJobMode, false)
 	require.NoError(t, err)
 	assert.NotContains(t, command, "until")
 	for _, token := range command {
@@ -220,6 +221,21 @@ func TestBuildJobSubmitCommandWithK8sJobModeNoSidecarHealthWaitLoop(t *testing.T
 	}
 }
 
+func TestBuildJobSubmitCommandWithHTTPS(t *testing.T) {
+	testRayJob := rayJobTemplate()
+	testRayJob.Status.DashboardURL = "http://raycluster-head-svc.default.svc.cluster.local:8265"
+	command, err := BuildJobSubmitCommand(testRayJob, rayv1.K8sJobMode, true)
+	require.NoError(t, err)
+
+	address := "https://raycluster-head-svc.default.svc.cluster.local:8265"
+	verifyArgs := []string{"--verify", "/etc/kuberay/tls/ca.crt"}
+	joined := strings.Join(command, " ")
+	assert.Contains(t, joined, strings.Join(append([]string{"ray", "job", "status", "--address", address}, verifyArgs...), " "))
+	assert.Contains(t, joined, strings.Join(append([]string{"ray", "job", "submit", "--address", address}, verifyArgs...), " "))
+	assert.Contains(t, joined, strings.Join(append([]string{"ray", "job", "logs", "--address", address}, verifyArgs...), " "))
+	assert.NotContains(t, joined, "http://")
+}
+
 func TestBuildJobSubmitCommandWithK8sJobModeAndYAML(t *testing.T) {
 	rayJobWithYAML := &rayv1.RayJob{
 		Spec: rayv1.RayJobSpec{
@@ -253,7 +269,7 @@ pip: ["python-multipart==0.0.6"]
 		";", "fi", ";",
 		"ray", "job", "logs", "--address", "http://127.0.0.1:8265", "--follow", "testJobId",
 	}
-	command, err := BuildJobSubmitCommand(rayJobWithYAML, rayv1.K8sJobMode)
+	command, err := BuildJobSubmitCommand(rayJobWithYAML, rayv1.K8sJobMode, false)
 	require.NoError(t, err)
 
 	// Ensure the slices are the same length.
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"slices"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// BuildNetworkPolicy returns the NetworkPolicy that isolates the Pods of the RayCluster. It allows the ingress traffic
+// between the Pods of the RayCluster, from the configured sources to the ports of the head service, and from the
+// operator namespace and the submitter Pods of the RayJob in K8sJobMode that created the RayCluster to the dashboard
+// port. The operator namespace can also reach the serving port of a RayCluster created by a RayService, since the
+// operator checks the health of the Serve proxy. The operator namespace is skipped if it's empty.
+func BuildNetworkPolicy(cluster *rayv1.RayCluster, operatorNamespace string) *networkingv1.NetworkPolicy {
+	selector := metav1.LabelSelector{MatchLabels: map[string]string{utils.RayClusterLabelKey: cluster.Name}}
+	ingress := []networkingv1.NetworkPolicyIngressRule{
+		{From: []networkingv1.NetworkPolicyPeer{{PodSelector: selector.DeepCopy()}}},
+	}
+
+	servicePorts := getServicePorts(*cluster)
+	if isolation := cluster.Spec.NetworkIsolation; isolation != nil && len(isolation.IngressFrom) > 0 {
+		ports := make([]int32, 0, len(servicePorts))
+		for _, port := range servicePorts {
+			ports = append(ports, port)
+		}
+		slices.Sort(ports)
+		ports = slices.Compact(ports)
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			From:  isolation.IngressFrom,
+			Ports: networkPolicyPorts(ports...),
+		})
+	}
+
+	dashboardPort, ok := servicePorts[utils.DashboardPortName]
+	if !ok {
+		dashboardPort = utils.DefaultDashboardPort
+	}
+	if operatorNamespace != "" {
+		operatorPorts := []int32{dashboardPort}
+		if cluster.Labels[utils.RayOriginatedFromCRDLabelKey] == utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD) &&
+			len(cluster.Spec.HeadGroupSpec.Template.Spec.Containers) > utils.RayContainerIndex {
+			rayContainer := cluster.Spec.HeadGroupSpec.Template.Spec.Containers[utils.RayContainerIndex]
+			operatorPorts = append(operatorPorts, utils.FindContainerPort(&rayContainer, utils.ServingPortName, utils.DefaultServingPort))
+		}
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			From: []networkingv1.NetworkPolicyPeer{{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{corev1.LabelMetadataName: operatorNamespace},
+				},
+			}},
+			Ports: networkPolicyPorts(operatorPorts...),
+		})
+	}
+	// The submitter Pods of a RayJob in K8sJobMode submit the job through the dashboard. They are created by the
+	// Kubernetes Job named after the RayJob, which labels them with its name.
+	if cluster.Labels[utils.RayOriginatedFromCRDLabelKey] == utils.RayOriginatedFromCRDLabelValue(utils.RayJobCRD) &&
+		cluster.Labels[utils.RayJobSubmissionModeLabelKey] == string(rayv1.K8sJobMode) {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			From: []networkingv1.NetworkPolicyPeer{{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{batchv1.JobNameLabel: cluster.Labels[utils.RayOriginatedFromCRNameLabelKey]},
+				},
+			}},
+			Ports: networkPolicyPorts(dashboardPort),
+		})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utils.GenerateNetworkPolicyName(cluster.Name),
+			Namespace: cluster.Namespace,
+			Labels: map[string]string{
+				utils.RayClusterLabelKey:                cluster.Name,
+				utils.KubernetesApplicationNameLabelKey: utils.ApplicationName,
+				utils.KubernetesCreatedByLabelKey:       utils.ComponentName,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: selector,
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     ingress,
+		},
+	}
+}
+
+func networkPolicyPorts(ports ...int32) []networkingv1.NetworkPolicyPort {
+	policyPorts := make([]networkingv1.NetworkPolicyPort, 0, len(ports))
+	for _, port := range ports {
+		policyPorts = append(policyPorts, networkingv1.NetworkPolicyPort{
+			Protocol: ptr.To(corev1.ProtocolTCP),
+			Port:     ptr.To(intstr.FromInt32(port)),
+		})
+	}
+	return policyPorts
+}
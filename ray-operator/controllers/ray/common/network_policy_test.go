@@ -0,0 +1,112 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestBuildNetworkPolicy(t *testing.T) {
+	newCluster := func(isolation *rayv1.NetworkIsolation) *rayv1.RayCluster {
+		return &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "raycluster", Namespace: "ray"},
+			Spec: rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name: "ray-head",
+								Ports: []corev1.ContainerPort{
+									{Name: utils.ClientPortName, ContainerPort: 10001},
+									{Name: utils.DashboardPortName, ContainerPort: 8266},
+								},
+							}},
+						},
+					},
+				},
+				NetworkIsolation: isolation,
+			},
+		}
+	}
+	policyPorts := func(policyPorts []networkingv1.NetworkPolicyPort) []int32 {
+		var ports []int32
+		for _, port := range policyPorts {
+			assert.Equal(t, corev1.ProtocolTCP, *port.Protocol)
+			ports = append(ports, port.Port.IntVal)
+		}
+		return ports
+	}
+
+	t.Run("intra-cluster and operator traffic", func(t *testing.T) {
+		policy := BuildNetworkPolicy(newCluster(&rayv1.NetworkIsolation{}), "ray-system")
+		assert.Equal(t, "raycluster-network-policy", policy.Name)
+		assert.Equal(t, "ray", policy.Namespace)
+		assert.Equal(t, "raycluster", policy.Labels[utils.RayClusterLabelKey])
+		assert.Equal(t, map[string]string{utils.RayClusterLabelKey: "raycluster"}, policy.Spec.PodSelector.MatchLabels)
+		assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, policy.Spec.PolicyTypes)
+
+		require.Len(t, policy.Spec.Ingress, 2)
+		assert.Equal(t, policy.Spec.PodSelector, *policy.Spec.Ingress[0].From[0].PodSelector)
+		assert.Empty(t, policy.Spec.Ingress[0].Ports)
+		assert.Equal(t, map[string]string{corev1.LabelMetadataName: "ray-system"}, policy.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels)
+		assert.Equal(t, []int32{8266}, policyPorts(policy.Spec.Ingress[1].Ports))
+	})
+
+	t.Run("configured ingress sources", func(t *testing.T) {
+		peer := networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}}
+		policy := BuildNetworkPolicy(newCluster(&rayv1.NetworkIsolation{IngressFrom: []networkingv1.NetworkPolicyPeer{peer}}), "")
+
+		require.Len(t, policy.Spec.Ingress, 2)
+		assert.Equal(t, []networkingv1.NetworkPolicyPeer{peer}, policy.Spec.Ingress[1].From)
+		assert.Equal(t, []int32{8080, 8266, 10001}, policyPorts(policy.Spec.Ingress[1].Ports))
+		assert.Equal(t, intstr.Int, policy.Spec.Ingress[1].Ports[0].Port.Type)
+	})
+
+	t.Run("submitter Pods of a RayJob in K8sJobMode", func(t *testing.T) {
+		cluster := newCluster(&rayv1.NetworkIsolation{})
+		cluster.Labels = map[string]string{
+			utils.RayOriginatedFromCRNameLabelKey: "rayjob",
+			utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayJobCRD),
+			utils.RayJobSubmissionModeLabelKey:    string(rayv1.K8sJobMode),
+		}
+		policy := BuildNetworkPolicy(cluster, "ray-system")
+
+		require.Len(t, policy.Spec.Ingress, 3)
+		assert.Equal(t, map[string]string{batchv1.JobNameLabel: "rayjob"}, policy.Spec.Ingress[2].From[0].PodSelector.MatchLabels)
+		assert.Equal(t, []int32{8266}, policyPorts(policy.Spec.Ingress[2].Ports))
+
+		// The submitter of a RayJob in HTTPMode is the operator.
+		cluster.Labels[utils.RayJobSubmissionModeLabelKey] = string(rayv1.HTTPMode)
+		policy = BuildNetworkPolicy(cluster, "ray-system")
+		assert.Len(t, policy.Spec.Ingress, 2)
+	})
+
+	t.Run("operator health checks of a RayService", func(t *testing.T) {
+		cluster := newCluster(&rayv1.NetworkIsolation{})
+		cluster.Labels = map[string]string{
+			utils.RayOriginatedFromCRNameLabelKey: "rayservice",
+			utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+		}
+		policy := BuildNetworkPolicy(cluster, "ray-system")
+
+		// The operator reaches the dashboard and the Serve proxy on the default serving port.
+		require.Len(t, policy.Spec.Ingress, 2)
+		assert.Equal(t, map[string]string{corev1.LabelMetadataName: "ray-system"}, policy.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels)
+		assert.Equal(t, []int32{8266, utils.DefaultServingPort}, policyPorts(policy.Spec.Ingress[1].Ports))
+
+		// A custom serving port is used instead.
+		rayContainer := &cluster.Spec.HeadGroupSpec.Template.Spec.Containers[utils.RayContainerIndex]
+		rayContainer.Ports = append(rayContainer.Ports, corev1.ContainerPort{Name: utils.ServingPortName, ContainerPort: 9000})
+		policy = BuildNetworkPolicy(cluster, "ray-system")
+		assert.Equal(t, []int32{8266, 9000}, policyPorts(policy.Spec.Ingress[1].Ports))
+	})
+}
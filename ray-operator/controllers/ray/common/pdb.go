@@ -0,0 +1,74 @@
+package common
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// isCreatedByRayService returns whether the RayCluster is created by a RayService.
+func isCreatedByRayService(cluster *rayv1.RayCluster) bool {
+	return cluster.Labels[utils.RayOriginatedFromCRDLabelKey] == utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD)
+}
+
+// workerGroupPodDisruptionBudgetSpec returns the PodDisruptionBudget config of a worker group. If the worker group does
+// not configure one and the RayCluster is created by a RayService, at most one Pod of the worker group can be evicted
+// at a time. It returns nil if no PodDisruptionBudget should be created.
+func workerGroupPodDisruptionBudgetSpec(cluster *rayv1.RayCluster, workerGroup *rayv1.WorkerGroupSpec) *rayv1.PodDisruptionBudgetSpec {
+	if pdb := workerGroup.PodDisruptionBudget; pdb != nil {
+		return pdb
+	}
+	if isCreatedByRayService(cluster) {
+		return &rayv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt32(1))}
+	}
+	return nil
+}
+
+// BuildPodDisruptionBudgets returns the PodDisruptionBudgets for the head group and the worker groups of the RayCluster.
+func BuildPodDisruptionBudgets(cluster *rayv1.RayCluster) []*policyv1.PodDisruptionBudget {
+	var pdbs []*policyv1.PodDisruptionBudget
+	// The head group has no default because the head Pod is a single Pod, and a PodDisruptionBudget that keeps it
+	// available would block node drains indefinitely.
+	if spec := cluster.Spec.HeadGroupSpec.PodDisruptionBudget; spec != nil {
+		pdbs = append(pdbs, buildPodDisruptionBudget(cluster, utils.RayNodeHeadGroupLabelValue, map[string]string{
+			utils.RayClusterLabelKey:  cluster.Name,
+			utils.RayNodeTypeLabelKey: string(rayv1.HeadNode),
+		}, spec))
+	}
+	for i := range cluster.Spec.WorkerGroupSpecs {
+		workerGroup := &cluster.Spec.WorkerGroupSpecs[i]
+		if spec := workerGroupPodDisruptionBudgetSpec(cluster, workerGroup); spec != nil {
+			pdbs = append(pdbs, buildPodDisruptionBudget(cluster, workerGroup.GroupName, map[string]string{
+				utils.RayClusterLabelKey:   cluster.Name,
+				utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+				utils.RayNodeGroupLabelKey: workerGroup.GroupName,
+			}, spec))
+		}
+	}
+	return pdbs
+}
+
+func buildPodDisruptionBudget(cluster *rayv1.RayCluster, groupName string, selector map[string]string, spec *rayv1.PodDisruptionBudgetSpec) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utils.GeneratePodDisruptionBudgetName(cluster.Name, groupName),
+			Namespace: cluster.Namespace,
+			Labels: map[string]string{
+				utils.RayClusterLabelKey:                cluster.Name,
+				utils.RayNodeGroupLabelKey:              groupName,
+				utils.KubernetesApplicationNameLabelKey: utils.ApplicationName,
+				utils.KubernetesCreatedByLabelKey:       utils.ComponentName,
+			},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector:                   &metav1.LabelSelector{MatchLabels: selector},
+			MinAvailable:               spec.MinAvailable,
+			MaxUnavailable:             spec.MaxUnavailable,
+			UnhealthyPodEvictionPolicy: spec.UnhealthyPodEvictionPolicy,
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestBuildPodDisruptionBudgets(t *testing.T) {
+	newCluster := func(labels map[string]string) *rayv1.RayCluster {
+		return &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "raycluster", Namespace: "ray", Labels: labels},
+			Spec: rayv1.RayClusterSpec{
+				WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+					{GroupName: "group-a"},
+					{GroupName: "group-b"},
+				},
+			},
+		}
+	}
+	rayServiceLabels := map[string]string{
+		utils.RayOriginatedFromCRDLabelKey: utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+	}
+
+	tests := []struct {
+		cluster      *rayv1.RayCluster
+		expectedPDBs map[string]rayv1.PodDisruptionBudgetSpec
+		name         string
+	}{
+		{
+			name:         "no PodDisruptionBudget by default",
+			cluster:      newCluster(nil),
+			expectedPDBs: map[string]rayv1.PodDisruptionBudgetSpec{},
+		},
+		{
+			// The head Pod has no default PodDisruptionBudget because it would block node drains.
+			name:    "default PodDisruptionBudgets for a RayCluster created by a RayService",
+			cluster: newCluster(rayServiceLabels),
+			expectedPDBs: map[string]rayv1.PodDisruptionBudgetSpec{
+				"raycluster-group-a-pdb": {MaxUnavailable: ptr.To(intstr.FromInt32(1))},
+				"raycluster-group-b-pdb": {MaxUnavailable: ptr.To(intstr.FromInt32(1))},
+			},
+		},
+		{
+			name: "configured PodDisruptionBudgets override the defaults",
+			cluster: func() *rayv1.RayCluster {
+				cluster := newCluster(rayServiceLabels)
+				cluster.Spec.HeadGroupSpec.PodDisruptionBudget = &rayv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt32(1))}
+				cluster.Spec.WorkerGroupSpecs[1].PodDisruptionBudget = &rayv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromString("80%"))}
+				return cluster
+			}(),
+			expectedPDBs: map[string]rayv1.PodDisruptionBudgetSpec{
+				"raycluster-headgroup-pdb": {MaxUnavailable: ptr.To(intstr.FromInt32(1))},
+				"raycluster-group-a-pdb":   {MaxUnavailable: ptr.To(intstr.FromInt32(1))},
+				"raycluster-group-b-pdb":   {MinAvailable: ptr.To(intstr.FromString("80%"))},
+			},
+		},
+		{
+			name: "only the configured group gets a PodDisruptionBudget for a standalone RayCluster",
+			cluster: func() *rayv1.RayCluster {
+				cluster := newCluster(nil)
+				cluster.Spec.WorkerGroupSpecs[0].PodDisruptionBudget = &rayv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt32(2))}
+				return cluster
+			}(),
+			expectedPDBs: map[string]rayv1.PodDisruptionBudgetSpec{
+				"raycluster-group-a-pdb": {MaxUnavailable: ptr.To(intstr.FromInt32(2))},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pdbs := BuildPodDisruptionBudgets(tc.cluster)
+			require.Len(t, pdbs, len(tc.expectedPDBs))
+			for _, pdb := range pdbs {
+				expected, ok := tc.expectedPDBs[pdb.Name]
+				require.True(t, ok, "unexpected PodDisruptionBudget %s", pdb.Name)
+				assert.Equal(t, tc.cluster.Namespace, pdb.Namespace)
+				assert.Equal(t, expected.MinAvailable, pdb.Spec.MinAvailable)
+				assert.Equal(t, expected.MaxUnavailable, pdb.Spec.MaxUnavailable)
+				assert.Equal(t, tc.cluster.Name, pdb.Labels[utils.RayClusterLabelKey])
+				assert.Equal(t, tc.cluster.Name, pdb.Spec.Selector.MatchLabels[utils.RayClusterLabelKey])
+			}
+		})
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"path"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -37,17 +39,8 @@ const (
 	// If set to true, kuberay auto injects an init container waiting for ray GCS.
 	// If false, you will need to inject your own init container to ensure ray GCS is up before the ray workers start.
 	EnableInitContainerInjectionEnvKey = "ENABLE_INIT_CONTAINER_INJECTION"
-	NeuronCoreContainerResourceName    = "aws.amazon.com/neuroncore"
-	NeuronCoreRayResourceName          = "neuron_cores"
-	TPUContainerResourceName           = "google.com/tpu"
-	TPURayResourceName                 = "TPU"
 )
 
-var customAcceleratorToRayResourceMap = map[string]string{
-	NeuronCoreContainerResourceName: NeuronCoreRayResourceName,
-	TPUContainerResourceName:        TPURayResourceName,
-}
-
 // Get the port required to connect to the Ray cluster by worker nodes and drivers
 // started within the cluster.
 // For Ray >= 1.11.0 this is the GCS server port. For Ray < 1.11.0 it is the Redis port.
@@ -72,9 +65,14 @@ func initTemplateAnnotations(instance rayv1.RayCluster, podTemplate *corev1.PodT
 	if isOverwriteRayContainerCmd(instance) {
 		podTemplate.Annotations[utils.RayOverwriteContainerCmdAnnotationKey] = "true"
 	}
+
+	// Record which auth token the Pod is created with, so that it can be recreated after the token is rotated.
+	if instance.Status.AuthToken != nil && instance.Status.AuthToken.LastRotationTime != nil {
+		podTemplate.Annotations[utils.RayAuthTokenRotationTimeAnnotationKey] = instance.Status.AuthToken.LastRotationTime.UTC().Format(time.RFC3339)
+	}
 }
 
-func configureGCSFaultTolerance(podTemplate *corev1.PodTemplateSpec, instance rayv1.RayCluster, rayNodeType rayv1.RayNodeType) {
+func configureGCSFaultTolerance(ctx context.Context, podTemplate *corev1.PodTemplateSpec, instance rayv1.RayCluster, rayNodeType rayv1.RayNodeType) {
 	// Configure environment variables, annotations, and rayStartParams for GCS fault tolerance.
 	// Note that both `podTemplate` and `instance` will be modified.
 	ftEnabled := utils.IsGCSFaultToleranceEnabled(&instance.Spec, instance.Annotations)
@@ -101,7 +99,7 @@ func configureGCSFaultTolerance(podTemplate *corev1.PodTemplateSpec, instance ra
 			container.Env = append(container.Env, gcsTimeout)
 		}
 
-		// Configure the Redis address, username and password for GCS FT.
+		// Configure the connection to the external storage for GCS FT.
 		if rayNodeType == rayv1.HeadNode {
 			// Configure the external storage namespace for GCS FT.
 			storageNS := string(instance.UID)
@@ -117,47 +115,13 @@ func configureGCSFaultTolerance(podTemplate *corev1.PodTemplateSpec, instance ra
 				container.Env = append(container.Env, storageNS)
 			}
 
-			if options != nil {
-				container.Env = append(container.Env, corev1.EnvVar{
-					Name:  utils.RAY_REDIS_ADDRESS,
-					Value: options.RedisAddress,
-				})
-				if options.RedisUsername != nil {
-					// Note that `redis-username` will be supported starting from Ray 2.41.
-					// If `GcsFaultToleranceOptions.RedisUsername` is set, it will be put into the
-					// `REDIS_USERNAME` environment variable later. Here, we use `$REDIS_USERNAME` in
-					// rayStartParams to refer to the environment variable.
-					instance.Spec.HeadGroupSpec.RayStartParams["redis-username"] = "$REDIS_USERNAME"
-					container.Env = append(container.Env, corev1.EnvVar{
-						Name:      utils.REDIS_USERNAME,
-						Value:     options.RedisUsername.Value,
-						ValueFrom: options.RedisUsername.ValueFrom,
-					})
-				}
-				if options.RedisPassword != nil {
-					// If `GcsFaultToleranceOptions.RedisPassword` is set, it will be put into the
-					// `REDIS_PASSWORD` environment variable later. Here, we use `$REDIS_PASSWORD` in
-					// rayStartParams to refer to the environment variable.
-					instance.Spec.HeadGroupSpec.RayStartParams["redis-password"] = "$REDIS_PASSWORD"
-					container.Env = append(container.Env, corev1.EnvVar{
-						Name:      utils.REDIS_PASSWORD,
-						Value:     options.RedisPassword.Value,
-						ValueFrom: options.RedisPassword.ValueFrom,
-					})
-				}
-			} else {
-				// If users directly set the `redis-password` in `rayStartParams` instead of referring
-				// to a K8s secret, we need to set the `REDIS_PASSWORD` env var so that the Redis cleanup
-				// job can connect to Redis using the password. This is not recommended.
-				if !utils.EnvVarExists(utils.REDIS_PASSWORD, container.Env) {
-					// setting the REDIS_PASSWORD env var from the params
-					redisPasswordEnv := corev1.EnvVar{Name: utils.REDIS_PASSWORD}
-					if value, ok := instance.Spec.HeadGroupSpec.RayStartParams["redis-password"]; ok {
-						redisPasswordEnv.Value = value
-						container.Env = append(container.Env, redisPasswordEnv)
-					}
-				}
+			// Unsupported storage backends are rejected by ValidateRayClusterSpec before the Pods are built.
+			backend, err := NewGcsStorageBackend(&instance)
+			if err != nil {
+				ctrl.LoggerFrom(ctx).Error(err, "failed to configure the GCS storage backend in the head container")
+				return
 			}
+			backend.ConfigureHeadContainer(container, instance.Spec.HeadGroupSpec.RayStartParams)
 		}
 	}
 }
@@ -176,6 +140,9 @@ func DefaultHeadPodTemplate(ctx context.Context, instance rayv1.RayCluster, head
 	// Pods created by RayCluster should be restricted to the namespace of the RayCluster.
 	// This ensures privilege of KubeRay users are contained within the namespace of the RayCluster.
 	podTemplate.ObjectMeta.Namespace = instance.Namespace
+	if podTemplate.Spec.PriorityClassName == "" {
+		podTemplate.Spec.PriorityClassName = instance.Spec.PriorityClassName
+	}
 
 	// Update rayStartParams with top-level Resources for head group.
 	updateRayStartParamsResources(ctx, headSpec.RayStartParams, headSpec.Resources)
@@ -208,6 +175,10 @@ func DefaultHeadPodTemplate(ctx context.Context, instance rayv1.RayCluster, head
 		if utils.IsAuthEnabled(&instance.Spec) {
 			SetContainerTokenAuthEnvVars(instance.Name, &autoscalerContainer, instance.Spec.AuthOptions)
 		}
+		// The autoscaler talks to the GCS, so it needs the same TLS configuration as the Ray container.
+		if utils.IsTLSEnabled(&instance.Spec) {
+			SetContainerTLSEnvVars(&autoscalerContainer)
+		}
 
 		// Merge the user overrides from autoscalerOptions into the autoscaler container config.
 		mergeAutoscalerOverrides(&autoscalerContainer, instance.Spec.AutoscalerOptions)
@@ -219,7 +190,7 @@ func DefaultHeadPodTemplate(ctx context.Context, instance rayv1.RayCluster, head
 		}
 	}
 
-	configureGCSFaultTolerance(&podTemplate, instance, rayv1.HeadNode)
+	configureGCSFaultTolerance(ctx, &podTemplate, instance, rayv1.HeadNode)
 
 	// If the metrics port does not exist in the Ray container, add a default one for Prometheus.
 	isMetricsPortExists := utils.FindContainerPort(&podTemplate.Spec.Containers[utils.RayContainerIndex], utils.MetricsPortName, -1) != -1
@@ -235,6 +206,10 @@ func DefaultHeadPodTemplate(ctx context.Context, instance rayv1.RayCluster, head
 		configureTokenAuth(instance.Name, &podTemplate, instance.Spec.AuthOptions)
 	}
 
+	if utils.IsTLSEnabled(&instance.Spec) {
+		configureTLS(&podTemplate, instance.Spec.TLSOptions)
+	}
+
 	return podTemplate
 }
 
@@ -334,6 +309,60 @@ func SetContainerTokenAuthEnvVars(clusterName string, container *corev1.Containe
 	}
 }
 
+// configureTLS mounts the TLS Secret into the Pod and sets the Ray TLS environment variables
+// for the Ray container and the wait-gcs-ready init container.
+func configureTLS(podTemplate *corev1.PodTemplateSpec, tlsOptions *rayv1.TLSOptions) {
+	AddRayTLSVolume(&podTemplate.Spec, tlsOptions)
+
+	SetContainerTLSEnvVars(&podTemplate.Spec.Containers[utils.RayContainerIndex])
+	for i, initContainer := range podTemplate.Spec.InitContainers {
+		if initContainer.Name != "wait-gcs-ready" {
+			continue
+		}
+		SetContainerTLSEnvVars(&podTemplate.Spec.InitContainers[i])
+	}
+}
+
+// AddRayTLSVolume adds the volume that holds the Secret referenced by TLSOptions to the Pod.
+func AddRayTLSVolume(podSpec *corev1.PodSpec, tlsOptions *rayv1.TLSOptions) {
+	if utils.VolumeExists(utils.RayTLSVolumeName, podSpec.Volumes) {
+		return
+	}
+
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: utils.RayTLSVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: tlsOptions.SecretName,
+			},
+		},
+	})
+}
+
+// SetContainerTLSEnvVars mounts the TLS volume into a container and points the Ray TLS
+// environment variables at the mounted files. Environment variables set by users are respected.
+func SetContainerTLSEnvVars(container *corev1.Container) {
+	if !utils.VolumeMountExists(utils.RayTLSVolumeName, container.VolumeMounts) {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      utils.RayTLSVolumeName,
+			MountPath: utils.RayTLSMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	tlsEnvVars := []corev1.EnvVar{
+		{Name: utils.RAY_USE_TLS, Value: "1"},
+		{Name: utils.RAY_TLS_SERVER_CERT, Value: path.Join(utils.RayTLSMountPath, corev1.TLSCertKey)},
+		{Name: utils.RAY_TLS_SERVER_KEY, Value: path.Join(utils.RayTLSMountPath, corev1.TLSPrivateKeyKey)},
+		{Name: utils.RAY_TLS_CA_CERT, Value: path.Join(utils.RayTLSMountPath, utils.RayTLSCACertKey)},
+	}
+	for _, env := range tlsEnvVars {
+		if !utils.EnvVarExists(env.Name, container.Env) {
+			container.Env = append(container.Env, env)
+		}
+	}
+}
+
 func getEnableInitContainerInjection() bool {
 	if s := os.Getenv(EnableInitContainerInjectionEnvKey); strings.ToLower(s) == "false" {
 		return false
@@ -348,6 +377,32 @@ func getEnableProbesInjection() bool {
 	return true
 }
 
+// addReplicaTopologyAffinity adds a required Pod affinity to the hosts of a multi-host replica, so that they are
+// scheduled onto nodes with the same value of the topology key. The first scheduled host of the replica can land in
+// any domain, and pins the other hosts to its domain.
+func addReplicaTopologyAffinity(podSpec *corev1.PodSpec, clusterName string, replicaGrpName string, topologyKey string) {
+	// The affinity may be shared with the worker group template.
+	podSpec.Affinity = podSpec.Affinity.DeepCopy()
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	if podSpec.Affinity.PodAffinity == nil {
+		podSpec.Affinity.PodAffinity = &corev1.PodAffinity{}
+	}
+	podSpec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+		podSpec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+		corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					utils.RayClusterLabelKey:      clusterName,
+					utils.RayWorkerReplicaNameKey: replicaGrpName,
+				},
+			},
+			TopologyKey: topologyKey,
+		},
+	)
+}
+
 // DefaultWorkerPodTemplate sets the config values
 func DefaultWorkerPodTemplate(ctx context.Context, instance rayv1.RayCluster, workerSpec rayv1.WorkerGroupSpec, podName string, fqdnRayIP string, headPort string, replicaGrpName string, replicaIndex int, numHostIndex int) corev1.PodTemplateSpec {
 	podTemplate := workerSpec.Template
@@ -355,6 +410,9 @@ func DefaultWorkerPodTemplate(ctx context.Context, instance rayv1.RayCluster, wo
 	// Pods created by RayCluster should be restricted to the namespace of the RayCluster.
 	// This ensures privilege of KubeRay users are contained within the namespace of the RayCluster.
 	podTemplate.ObjectMeta.Namespace = instance.Namespace
+	if podTemplate.Spec.PriorityClassName == "" {
+		podTemplate.Spec.PriorityClassName = instance.Spec.PriorityClassName
+	}
 
 	// The Ray worker should only start once the GCS server is ready.
 	// only inject init container only when ENABLE_INIT_CONTAINER_INJECTION is true
@@ -435,12 +493,15 @@ func DefaultWorkerPodTemplate(ctx context.Context, instance rayv1.RayCluster, wo
 			// These labels are specific to multi-host group setup and reconciliation.
 			podTemplate.Labels[utils.RayWorkerReplicaNameKey] = replicaGrpName
 			podTemplate.Labels[utils.RayHostIndexKey] = strconv.Itoa(numHostIndex)
+			if workerSpec.Topology != nil {
+				addReplicaTopologyAffinity(&podTemplate.Spec, instance.Name, replicaGrpName, workerSpec.Topology.RequiredTopologyKey)
+			}
 		}
 	}
 	workerSpec.RayStartParams = setMissingRayStartParams(ctx, workerSpec.RayStartParams, rayv1.WorkerNode, headPort, fqdnRayIP)
 
 	initTemplateAnnotations(instance, &podTemplate)
-	configureGCSFaultTolerance(&podTemplate, instance, rayv1.WorkerNode)
+	configureGCSFaultTolerance(ctx, &podTemplate, instance, rayv1.WorkerNode)
 
 	// If the metrics port does not exist in the Ray container, add a default one for Prometheus.
 	isMetricsPortExists := utils.FindContainerPort(&podTemplate.Spec.Containers[utils.RayContainerIndex], utils.MetricsPortName, -1) != -1
@@ -460,6 +521,10 @@ func DefaultWorkerPodTemplate(ctx context.Context, instance rayv1.RayCluster, wo
 		configureTokenAuth(instance.Name, &podTemplate, instance.Spec.AuthOptions)
 	}
 
+	if utils.IsTLSEnabled(&instance.Spec) {
+		configureTLS(&podTemplate, instance.Spec.TLSOptions)
+	}
+
 	return podTemplate
 }
 
@@ -574,7 +639,7 @@ func initLivenessAndReadinessProbe(rayContainer *corev1.Container, rayNodeType r
 }
 
 // BuildPod a pod config
-func BuildPod(ctx context.Context, podTemplateSpec corev1.PodTemplateSpec, rayNodeType rayv1.RayNodeType, rayStartParams map[string]string, headPort string, enableRayAutoscaler bool, creatorCRDType utils.CRDType, fqdnRayIP string, defaultContainerEnvs []corev1.EnvVar, rayVersion string) (aPod corev1.Pod) {
+func BuildPod(ctx context.Context, podTemplateSpec corev1.PodTemplateSpec, rayNodeType rayv1.RayNodeType, rayStartParams map[string]string, headPort string, enableRayAutoscaler bool, creatorCRDType utils.CRDType, fqdnRayIP string, defaultContainerEnvs []corev1.EnvVar, rayVersion string, accelerators utils.Accelerators) (aPod corev1.Pod) {
 	log := ctrl.LoggerFrom(ctx)
 
 	// For Worker Pod: Traffic readiness is determined by the readiness probe.
@@ -625,7 +690,7 @@ func BuildPod(ctx context.Context, podTemplateSpec corev1.PodTemplateSpec, rayNo
 	// Increase the open file descriptor limit of the `ray start` process and its child processes to 65536.
 	ulimitCmd := "ulimit -n 65536"
 	// Generate the `ray start` command.
-	rayStartCmd := generateRayStartCommand(ctx, rayNodeType, rayStartParams, pod.Spec.Containers[utils.RayContainerIndex].Resources)
+	rayStartCmd := generateRayStartCommand(ctx, rayNodeType, rayStartParams, pod.Spec.Containers[utils.RayContainerIndex].Resources, accelerators)
 
 	// Check if overwrites the generated container command or not.
 	isOverwriteRayContainerCmd := false
@@ -977,7 +1042,7 @@ func setMissingRayStartParams(ctx context.Context, rayStartParams map[string]str
 	return rayStartParams
 }
 
-func generateRayStartCommand(ctx context.Context, nodeType rayv1.RayNodeType, rayStartParams map[string]string, resource corev1.ResourceRequirements) string {
+func generateRayStartCommand(ctx context.Context, nodeType rayv1.RayNodeType, rayStartParams map[string]string, resource corev1.ResourceRequirements, accelerators utils.Accelerators) string {
 	log := ctrl.LoggerFrom(ctx)
 
 	log.Info("generateRayStartCommand", "nodeType", nodeType, "rayStartParams", rayStartParams, "Ray container resource", resource)
@@ -1002,7 +1067,7 @@ func generateRayStartCommand(ctx context.Context, nodeType rayv1.RayNodeType, ra
 	}
 
 	// Add GPU and custom accelerator resources to rayStartParams if not already present.
-	if err := addWellKnownAcceleratorResources(rayStartParams, resource.Limits); err != nil {
+	if err := addWellKnownAcceleratorResources(rayStartParams, resource.Limits, accelerators); err != nil {
 		log.Error(err, "failed to add accelerator resources to rayStartParams")
 	}
 
@@ -1019,7 +1084,13 @@ func generateRayStartCommand(ctx context.Context, nodeType rayv1.RayNodeType, ra
 	return rayStartCmd
 }
 
-func addWellKnownAcceleratorResources(rayStartParams map[string]string, resourceLimits corev1.ResourceList) error {
+// AddClaimedAcceleratorResources adds the GPUs and custom accelerators that the Ray container claims through
+// Dynamic Resource Allocation, counted as extended resources, to rayStartParams if they're not already present.
+func AddClaimedAcceleratorResources(rayStartParams map[string]string, claimedResources corev1.ResourceList, accelerators utils.Accelerators) error {
+	return addWellKnownAcceleratorResources(rayStartParams, claimedResources, accelerators)
+}
+
+func addWellKnownAcceleratorResources(rayStartParams map[string]string, resourceLimits corev1.ResourceList, accelerators utils.Accelerators) error {
 	if len(resourceLimits) == 0 {
 		return nil
 	}
@@ -1030,7 +1101,7 @@ func addWellKnownAcceleratorResources(rayStartParams map[string]string, resource
 	}
 
 	// Flag to track if any custom accelerator resource are present/added in rayStartParams resources.
-	isCustomAcceleratorResourceAdded := isCustomAcceleratorPresentInResources(resourcesMap)
+	isCustomAcceleratorResourceAdded := isCustomAcceleratorPresentInResources(resourcesMap, accelerators)
 
 	// Create a sorted slice of resource keys
 	// Needed for consistent looping and adding first found custom accelerator resource to ray start params
@@ -1038,40 +1109,43 @@ func addWellKnownAcceleratorResources(rayStartParams map[string]string, resource
 
 	for _, resourceKeyString := range sortedResourceKeys {
 		resourceValue := resourceLimits[corev1.ResourceName(resourceKeyString)]
+		accelerator, ok := accelerators.Get(corev1.ResourceName(resourceKeyString))
+		if !ok || resourceValue.IsZero() {
+			continue
+		}
 
 		// Scan for resource keys of gpus
-		if _, ok := rayStartParams["num-gpus"]; !ok {
-			if utils.IsGPUResourceKey(resourceKeyString) && !resourceValue.IsZero() {
+		if accelerator.RayResourceName == utils.GPURayResourceName {
+			if _, ok := rayStartParams["num-gpus"]; !ok {
 				rayStartParams["num-gpus"] = strconv.FormatInt(resourceValue.Value(), 10)
 			}
+			continue
 		}
 
 		// Add the first encountered custom accelerator resource from the resource limits to the rayStartParams if not already present
 		if !isCustomAcceleratorResourceAdded {
-			if rayResourceName, ok := customAcceleratorToRayResourceMap[resourceKeyString]; ok && !resourceValue.IsZero() {
-				if _, exists := resourcesMap[rayResourceName]; !exists {
-					resourcesMap[rayResourceName] = resourceValue.AsApproximateFloat64()
-
-					// Update the resources map in the rayStartParams
-					updatedResourcesStr, err := json.Marshal(resourcesMap)
-					if err != nil {
-						return fmt.Errorf("failed to marshal resources map to string: %w", err)
-					}
+			if _, exists := resourcesMap[accelerator.RayResourceName]; !exists {
+				resourcesMap[accelerator.RayResourceName] = resourceValue.AsApproximateFloat64()
 
-					rayStartParams["resources"] = fmt.Sprintf("'%s'", updatedResourcesStr)
+				// Update the resources map in the rayStartParams
+				updatedResourcesStr, err := json.Marshal(resourcesMap)
+				if err != nil {
+					return fmt.Errorf("failed to marshal resources map to string: %w", err)
 				}
-				isCustomAcceleratorResourceAdded = true
+
+				rayStartParams["resources"] = fmt.Sprintf("'%s'", updatedResourcesStr)
 			}
+			isCustomAcceleratorResourceAdded = true
 		}
 	}
 
 	return nil
 }
 
-func isCustomAcceleratorPresentInResources(resourcesMap map[string]float64) bool {
+func isCustomAcceleratorPresentInResources(resourcesMap map[string]float64, accelerators utils.Accelerators) bool {
 	// Check whether there exists any custom accelerator resources specified as part of rayStartParams
 	if len(resourcesMap) > 0 {
-		for _, customAcceleratorRayResource := range customAcceleratorToRayResourceMap {
+		for _, customAcceleratorRayResource := range accelerators.CustomRayResourceNames() {
 			if _, ok := resourcesMap[customAcceleratorRayResource]; ok {
 				return true
 			}
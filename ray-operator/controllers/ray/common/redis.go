@@ -0,0 +1,148 @@
+package common
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const managedRedisDataVolumeName = "redis-data"
+
+// managedRedisLabels returns the labels of the Redis Pod that KubeRay manages for the RayCluster.
+func managedRedisLabels(cluster *rayv1.RayCluster) map[string]string {
+	return map[string]string{
+		utils.RayManagedRedisLabelKey:           cluster.Name,
+		utils.KubernetesApplicationNameLabelKey: "redis",
+		utils.KubernetesCreatedByLabelKey:       utils.ComponentName,
+	}
+}
+
+// managedRedisObjectMeta returns the ObjectMeta of the StatefulSet, Service, and Secret of the managed Redis.
+func managedRedisObjectMeta(cluster *rayv1.RayCluster) metav1.ObjectMeta {
+	labels := managedRedisLabels(cluster)
+	labels[utils.RayClusterLabelKey] = cluster.Name
+	return metav1.ObjectMeta{
+		Name:      utils.GenerateManagedRedisName(cluster.Name),
+		Namespace: cluster.Namespace,
+		Labels:    labels,
+	}
+}
+
+// BuildManagedRedisSecret returns the Secret that stores the password of the managed Redis.
+func BuildManagedRedisSecret(cluster *rayv1.RayCluster, password string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: managedRedisObjectMeta(cluster),
+		StringData: map[string]string{
+			utils.ManagedRedisPasswordSecretKey: password,
+		},
+	}
+}
+
+// BuildManagedRedisService returns the Service that the head Pod uses to connect to the managed Redis.
+func BuildManagedRedisService(cluster *rayv1.RayCluster) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: managedRedisObjectMeta(cluster),
+		Spec: corev1.ServiceSpec{
+			Selector: managedRedisLabels(cluster),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "redis",
+					Port:       utils.ManagedRedisPort,
+					TargetPort: intstr.FromInt32(utils.ManagedRedisPort),
+				},
+			},
+		},
+	}
+}
+
+// BuildManagedRedisStatefulSet returns the single-replica Redis StatefulSet that stores the RayCluster's GCS metadata.
+// The Redis data is persisted in a PersistentVolumeClaim if `managedRedis.storageSize` is set.
+func BuildManagedRedisStatefulSet(cluster *rayv1.RayCluster) *appsv1.StatefulSet {
+	options := cluster.Spec.GcsFaultToleranceOptions.ManagedRedis
+	name := utils.GenerateManagedRedisName(cluster.Name)
+
+	image := options.Image
+	if image == "" {
+		image = utils.DefaultManagedRedisImage
+	}
+
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: managedRedisObjectMeta(cluster),
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    ptr.To[int32](1),
+			ServiceName: name,
+			Selector:    &metav1.LabelSelector{MatchLabels: managedRedisLabels(cluster)},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: managedRedisLabels(cluster)},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "redis",
+							Image: image,
+							// Kubernetes expands `$(REDIS_PASSWORD)` from the container's environment.
+							Args: []string{"--requirepass", "$(REDIS_PASSWORD)", "--appendonly", "yes", "--dir", "/data"},
+							Env: []corev1.EnvVar{
+								{
+									Name: utils.REDIS_PASSWORD,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: name},
+											Key:                  utils.ManagedRedisPasswordSecretKey,
+										},
+									},
+								},
+							},
+							Ports: []corev1.ContainerPort{
+								{Name: "redis", ContainerPort: utils.ManagedRedisPort},
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(utils.ManagedRedisPort)},
+								},
+								PeriodSeconds: 5,
+							},
+							Resources: options.Resources,
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: managedRedisDataVolumeName, MountPath: "/data"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if options.StorageSize == nil {
+		statefulSet.Spec.Template.Spec.Volumes = []corev1.Volume{
+			{
+				Name:         managedRedisDataVolumeName,
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			},
+		}
+		return statefulSet
+	}
+
+	statefulSet.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: managedRedisDataVolumeName},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				StorageClassName: options.StorageClassName,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: *options.StorageSize},
+				},
+			},
+		},
+	}
+	// Delete the PersistentVolumeClaim together with the StatefulSet, which is garbage collected with the RayCluster.
+	statefulSet.Spec.PersistentVolumeClaimRetentionPolicy = &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+		WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+		WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+	}
+	return statefulSet
+}
@@ -0,0 +1,67 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestBuildManagedRedisStatefulSet(t *testing.T) {
+	newCluster := func(options *rayv1.ManagedRedisOptions) *rayv1.RayCluster {
+		return &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "raycluster", Namespace: "ray"},
+			Spec: rayv1.RayClusterSpec{
+				GcsFaultToleranceOptions: &rayv1.GcsFaultToleranceOptions{ManagedRedis: options},
+			},
+		}
+	}
+
+	t.Run("defaults with an emptyDir volume", func(t *testing.T) {
+		statefulSet := BuildManagedRedisStatefulSet(newCluster(&rayv1.ManagedRedisOptions{}))
+		assert.Equal(t, "raycluster-redis", statefulSet.Name)
+		assert.Equal(t, "ray", statefulSet.Namespace)
+		assert.Equal(t, int32(1), *statefulSet.Spec.Replicas)
+		assert.Equal(t, utils.DefaultManagedRedisImage, statefulSet.Spec.Template.Spec.Containers[0].Image)
+		require.Len(t, statefulSet.Spec.Template.Spec.Volumes, 1)
+		assert.NotNil(t, statefulSet.Spec.Template.Spec.Volumes[0].EmptyDir)
+		assert.Empty(t, statefulSet.Spec.VolumeClaimTemplates)
+
+		// The Redis Pod must not be treated as a Ray Pod of the RayCluster.
+		assert.NotContains(t, statefulSet.Spec.Template.Labels, utils.RayClusterLabelKey)
+		assert.Equal(t, statefulSet.Spec.Selector.MatchLabels, statefulSet.Spec.Template.Labels)
+	})
+
+	t.Run("persistent storage", func(t *testing.T) {
+		statefulSet := BuildManagedRedisStatefulSet(newCluster(&rayv1.ManagedRedisOptions{
+			Image:            "redis:7.2",
+			StorageSize:      ptr.To(resource.MustParse("1Gi")),
+			StorageClassName: ptr.To("standard"),
+		}))
+		assert.Equal(t, "redis:7.2", statefulSet.Spec.Template.Spec.Containers[0].Image)
+		assert.Empty(t, statefulSet.Spec.Template.Spec.Volumes)
+		require.Len(t, statefulSet.Spec.VolumeClaimTemplates, 1)
+		pvc := statefulSet.Spec.VolumeClaimTemplates[0]
+		assert.Equal(t, resource.MustParse("1Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+		assert.Equal(t, "standard", *pvc.Spec.StorageClassName)
+		assert.Equal(t, appsv1.DeletePersistentVolumeClaimRetentionPolicyType, statefulSet.Spec.PersistentVolumeClaimRetentionPolicy.WhenDeleted)
+	})
+}
+
+func TestBuildManagedRedisService(t *testing.T) {
+	cluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "raycluster", Namespace: "ray"}}
+	service := BuildManagedRedisService(cluster)
+	assert.Equal(t, "raycluster-redis", service.Name)
+	assert.Equal(t, "raycluster", service.Labels[utils.RayClusterLabelKey])
+	assert.Equal(t, "raycluster", service.Spec.Selector[utils.RayManagedRedisLabelKey])
+	require.Len(t, service.Spec.Ports, 1)
+	assert.Equal(t, int32(utils.ManagedRedisPort), service.Spec.Ports[0].Port)
+}
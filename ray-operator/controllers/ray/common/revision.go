@@ -0,0 +1,87 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// BuildRayServiceRevision builds a ControllerRevision that records the RayCluster spec and the resolved Serve config of
+// the RayService, so that a rollback restores the Serve config even if the ConfigMap or Secret that it was read from
+// has changed since. If the Serve config is read from a Secret, the revision only references the Secret built by
+// BuildRayServiceRevisionSecret, so that the Serve config isn't stored in plain text. The revision name is derived from
+// the hash of the recorded spec, so identical specs map to the same revision. The revision is annotated with the same
+// `HashWithoutReplicasAndWorkersToDeleteKey` hash as the RayCluster created from it.
+func BuildRayServiceRevision(rayService *rayv1.RayService, serveConfig string, revision int64) (*appsv1.ControllerRevision, error) {
+	revisionSpec := rayv1.RayServiceSpec{
+		RayClusterSpec: *rayService.Spec.RayClusterSpec.DeepCopy(),
+		ServeConfigV2:  serveConfig,
+	}
+	revisionHash, err := utils.GenerateJsonHash(revisionSpec)
+	if err != nil {
+		return nil, err
+	}
+	name := utils.GenerateRayServiceRevisionName(rayService.Name, revisionHash)
+	if source := rayService.Spec.ServeConfigV2From; source != nil && source.SecretKeyRef != nil {
+		revisionSpec.ServeConfigV2 = ""
+		revisionSpec.ServeConfigV2From = &rayv1.ServeConfigV2Source{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Key:                  utils.ServeConfigV2SecretKey,
+			},
+		}
+	}
+	data, err := json.Marshal(revisionSpec)
+	if err != nil {
+		return nil, err
+	}
+	clusterHash, err := utils.GenerateHashWithoutReplicasAndWorkersToDelete(rayService.Spec.RayClusterSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: rayService.Namespace,
+			Labels: map[string]string{
+				utils.RayOriginatedFromCRNameLabelKey: rayService.Name,
+				utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+			},
+			Annotations: map[string]string{
+				utils.HashWithoutReplicasAndWorkersToDeleteKey: clusterHash,
+			},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: revision,
+	}, nil
+}
+
+// BuildRayServiceRevisionSecret builds the Secret that stores the Serve config of a revision whose Serve config is read
+// from a Secret. It has the same name as the revision.
+func BuildRayServiceRevisionSecret(revision *appsv1.ControllerRevision, serveConfig string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      revision.Name,
+			Namespace: revision.Namespace,
+			Labels:    revision.Labels,
+		},
+		Data: map[string][]byte{utils.ServeConfigV2SecretKey: []byte(serveConfig)},
+	}
+}
+
+// GetRayServiceSpecFromRevision returns the RayService spec recorded in a revision built by BuildRayServiceRevision.
+func GetRayServiceSpecFromRevision(revision *appsv1.ControllerRevision) (*rayv1.RayServiceSpec, error) {
+	spec := &rayv1.RayServiceSpec{}
+	if err := json.Unmarshal(revision.Data.Raw, spec); err != nil {
+		return nil, fmt.Errorf("failed to decode revision %s/%s: %w", revision.Namespace, revision.Name, err)
+	}
+	return spec, nil
+}
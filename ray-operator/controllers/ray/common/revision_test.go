@@ -0,0 +1,87 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestBuildRayServiceRevision(t *testing.T) {
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "ray"},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2From: &rayv1.ServeConfigV2Source{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+					Key:                  "serveConfigV2",
+				},
+			},
+			RayClusterSpec: rayv1.RayClusterSpec{
+				RayVersion: "2.54.0",
+				WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+					{GroupName: "worker-group", Replicas: ptr.To(int32(1))},
+				},
+			},
+			UpgradeStrategy: &rayv1.RayServiceUpgradeStrategy{Type: ptr.To(rayv1.RayServiceNewCluster)},
+		},
+	}
+
+	serveConfig := "applications:\n- name: app1\n"
+	revision, err := BuildRayServiceRevision(rayService, serveConfig, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), revision.Revision)
+	assert.Equal(t, rayService.Namespace, revision.Namespace)
+	assert.Equal(t, rayService.Name, revision.Labels[utils.RayOriginatedFromCRNameLabelKey])
+	assert.Equal(t, utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD), revision.Labels[utils.RayOriginatedFromCRDLabelKey])
+
+	clusterHash, err := utils.GenerateHashWithoutReplicasAndWorkersToDelete(rayService.Spec.RayClusterSpec)
+	require.NoError(t, err)
+	assert.Equal(t, clusterHash, revision.Annotations[utils.HashWithoutReplicasAndWorkersToDeleteKey])
+
+	// Only the RayCluster spec and the resolved Serve config are recorded, not the ConfigMap reference.
+	spec, err := GetRayServiceSpecFromRevision(revision)
+	require.NoError(t, err)
+	assert.Equal(t, rayService.Spec.RayClusterSpec, spec.RayClusterSpec)
+	assert.Equal(t, serveConfig, spec.ServeConfigV2)
+	assert.Nil(t, spec.ServeConfigV2From)
+	assert.Nil(t, spec.UpgradeStrategy)
+
+	// Fields that are not recorded do not change the revision name.
+	rayService.Spec.UpgradeStrategy = nil
+	sameRevision, err := BuildRayServiceRevision(rayService, serveConfig, 4)
+	require.NoError(t, err)
+	assert.Equal(t, revision.Name, sameRevision.Name)
+
+	// A change of the Serve config changes the revision name.
+	newRevision, err := BuildRayServiceRevision(rayService, "applications:\n- name: app2\n", 5)
+	require.NoError(t, err)
+	assert.NotEqual(t, revision.Name, newRevision.Name)
+
+	// The Serve config read from a Secret is stored in the Secret of the revision.
+	rayService.Spec.ServeConfigV2From = &rayv1.ServeConfigV2Source{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+			Key:                  "serveConfigV2",
+		},
+	}
+	secretRevision, err := BuildRayServiceRevision(rayService, serveConfig, 6)
+	require.NoError(t, err)
+	assert.Equal(t, revision.Name, secretRevision.Name)
+	assert.NotContains(t, string(secretRevision.Data.Raw), "app1")
+	spec, err = GetRayServiceSpecFromRevision(secretRevision)
+	require.NoError(t, err)
+	assert.Empty(t, spec.ServeConfigV2)
+	require.NotNil(t, spec.ServeConfigV2From.SecretKeyRef)
+	assert.Equal(t, secretRevision.Name, spec.ServeConfigV2From.SecretKeyRef.Name)
+
+	secret := BuildRayServiceRevisionSecret(secretRevision, serveConfig)
+	assert.Equal(t, secretRevision.Name, secret.Name)
+	assert.Equal(t, serveConfig, string(secret.Data[spec.ServeConfigV2From.SecretKeyRef.Key]))
+}
@@ -0,0 +1,79 @@
+package common
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// BuildHeadVolumeClaims returns the PersistentVolumeClaims of the head Pod, created from the volume claim templates
+// of the head group.
+func BuildHeadVolumeClaims(cluster *rayv1.RayCluster) []*corev1.PersistentVolumeClaim {
+	var claims []*corev1.PersistentVolumeClaim
+	for _, template := range cluster.Spec.HeadGroupSpec.VolumeClaimTemplates {
+		claims = append(claims, buildVolumeClaim(cluster, template, utils.GenerateHeadVolumeClaimName(template.Name, cluster.Name), map[string]string{
+			utils.RayNodeTypeLabelKey:  string(rayv1.HeadNode),
+			utils.RayNodeGroupLabelKey: utils.RayNodeHeadGroupLabelValue,
+		}))
+	}
+	return claims
+}
+
+// BuildWorkerVolumeClaims returns the PersistentVolumeClaims of the worker Pod with the given replica and host
+// indices, created from the volume claim templates of the worker group.
+func BuildWorkerVolumeClaims(cluster *rayv1.RayCluster, worker rayv1.WorkerGroupSpec, replicaIndex int, hostIndex int) []*corev1.PersistentVolumeClaim {
+	var claims []*corev1.PersistentVolumeClaim
+	for _, template := range worker.VolumeClaimTemplates {
+		name := utils.GenerateWorkerVolumeClaimName(template.Name, cluster.Name, worker.GroupName, worker.NumOfHosts, replicaIndex, hostIndex)
+		claims = append(claims, buildVolumeClaim(cluster, template, name, map[string]string{
+			utils.RayNodeTypeLabelKey:      string(rayv1.WorkerNode),
+			utils.RayNodeGroupLabelKey:     worker.GroupName,
+			utils.RayWorkerReplicaIndexKey: strconv.Itoa(replicaIndex),
+			utils.RayHostIndexKey:          strconv.Itoa(hostIndex),
+		}))
+	}
+	return claims
+}
+
+func buildVolumeClaim(cluster *rayv1.RayCluster, template rayv1.VolumeClaimTemplate, name string, labels map[string]string) *corev1.PersistentVolumeClaim {
+	labels[utils.RayClusterLabelKey] = cluster.Name
+	labels[utils.RayVolumeClaimTemplateLabelKey] = template.Name
+	labels[utils.KubernetesApplicationNameLabelKey] = utils.ApplicationName
+	labels[utils.KubernetesCreatedByLabelKey] = utils.ComponentName
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.Namespace,
+			Labels:    labels,
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+}
+
+// AddVolumeClaimVolumes adds a volume that mounts each of the PersistentVolumeClaims to the Pod. The volumes are named
+// after the volume claim templates of the claims, and replace the volumes of the same names in the Pod.
+func AddVolumeClaimVolumes(podSpec *corev1.PodSpec, claims []*corev1.PersistentVolumeClaim) {
+	for _, claim := range claims {
+		volume := corev1.Volume{
+			Name: claim.Labels[utils.RayVolumeClaimTemplateLabelKey],
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim.Name},
+			},
+		}
+		replaced := false
+		for i := range podSpec.Volumes {
+			if podSpec.Volumes[i].Name == volume.Name {
+				podSpec.Volumes[i] = volume
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			podSpec.Volumes = append(podSpec.Volumes, volume)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestBuildVolumeClaims(t *testing.T) {
+	spillTemplate := rayv1.VolumeClaimTemplate{
+		Name: "spill",
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("100Gi")},
+			},
+		},
+	}
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "raycluster", Namespace: "default"},
+		Spec: rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{VolumeClaimTemplates: []rayv1.VolumeClaimTemplate{spillTemplate}},
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{GroupName: "single-host", NumOfHosts: 1, VolumeClaimTemplates: []rayv1.VolumeClaimTemplate{spillTemplate}},
+				{GroupName: "multi-host", NumOfHosts: 4, VolumeClaimTemplates: []rayv1.VolumeClaimTemplate{spillTemplate}},
+			},
+		},
+	}
+
+	headClaims := BuildHeadVolumeClaims(cluster)
+	require.Len(t, headClaims, 1)
+	assert.Equal(t, "spill-raycluster-head", headClaims[0].Name)
+	assert.Equal(t, "default", headClaims[0].Namespace)
+	assert.Equal(t, string(rayv1.HeadNode), headClaims[0].Labels[utils.RayNodeTypeLabelKey])
+	assert.Equal(t, "spill", headClaims[0].Labels[utils.RayVolumeClaimTemplateLabelKey])
+	assert.Equal(t, spillTemplate.Spec, headClaims[0].Spec)
+
+	workerClaims := BuildWorkerVolumeClaims(cluster, cluster.Spec.WorkerGroupSpecs[0], 2, 0)
+	require.Len(t, workerClaims, 1)
+	assert.Equal(t, "spill-raycluster-single-host-2", workerClaims[0].Name)
+	assert.Equal(t, map[string]string{
+		utils.RayClusterLabelKey:                "raycluster",
+		utils.RayNodeTypeLabelKey:               string(rayv1.WorkerNode),
+		utils.RayNodeGroupLabelKey:              "single-host",
+		utils.RayWorkerReplicaIndexKey:          "2",
+		utils.RayHostIndexKey:                   "0",
+		utils.RayVolumeClaimTemplateLabelKey:    "spill",
+		utils.KubernetesApplicationNameLabelKey: utils.ApplicationName,
+		utils.KubernetesCreatedByLabelKey:       utils.ComponentName,
+	}, workerClaims[0].Labels)
+
+	workerClaims = BuildWorkerVolumeClaims(cluster, cluster.Spec.WorkerGroupSpecs[1], 1, 3)
+	require.Len(t, workerClaims, 1)
+	assert.Equal(t, "spill-raycluster-multi-host-1-3", workerClaims[0].Name)
+}
+
+func TestAddVolumeClaimVolumes(t *testing.T) {
+	podSpec := corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "spill", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			{Name: "logs", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		},
+	}
+	claims := []*corev1.PersistentVolumeClaim{
+		{ObjectMeta: metav1.ObjectMeta{Name: "spill-raycluster-head", Labels: map[string]string{utils.RayVolumeClaimTemplateLabelKey: "spill"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cache-raycluster-head", Labels: map[string]string{utils.RayVolumeClaimTemplateLabelKey: "cache"}}},
+	}
+
+	AddVolumeClaimVolumes(&podSpec, claims)
+	require.Len(t, podSpec.Volumes, 3)
+	assert.Equal(t, "spill", podSpec.Volumes[0].Name)
+	assert.Nil(t, podSpec.Volumes[0].EmptyDir)
+	assert.Equal(t, "spill-raycluster-head", podSpec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "logs", podSpec.Volumes[1].Name)
+	assert.Equal(t, "cache", podSpec.Volumes[2].Name)
+	assert.Equal(t, "cache-raycluster-head", podSpec.Volumes[2].PersistentVolumeClaim.ClaimName)
+}
@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
+)
+
+// DashboardCacheMetricsManager implements the prometheus.Collector interface to collect the metrics of the cache
+// that fetches the job info of RayJobs in the background.
+type DashboardCacheMetricsManager struct {
+	hits       *prometheus.Desc
+	misses     *prometheus.Desc
+	evictions  *prometheus.Desc
+	queueDepth *prometheus.Desc
+	stats      func() dashboardclient.RayDashboardCacheStats
+}
+
+// NewDashboardCacheMetricsManager creates a new DashboardCacheMetricsManager instance.
+func NewDashboardCacheMetricsManager(cache *dashboardclient.RayDashboardCache) *DashboardCacheMetricsManager {
+	return &DashboardCacheMetricsManager{
+		hits: prometheus.NewDesc(
+			"kuberay_job_info_cache_hits_total",
+			"The number of job info lookups served from the cache",
+			nil,
+			nil,
+		),
+		misses: prometheus.NewDesc(
+			"kuberay_job_info_cache_misses_total",
+			"The number of job info lookups that had to wait for the job info to be fetched in the background",
+			nil,
+			nil,
+		),
+		// Possible values for `reason`: capacity, expired
+		evictions: prometheus.NewDesc(
+			"kuberay_job_info_cache_evictions_total",
+			"The number of job infos evicted from the cache",
+			[]string{"reason"},
+			nil,
+		),
+		queueDepth: prometheus.NewDesc(
+			"kuberay_job_info_cache_queue_depth",
+			"The number of job info queries waiting for a worker",
+			nil,
+			nil,
+		),
+		stats: cache.Stats,
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (d *DashboardCacheMetricsManager) Describe(ch chan<- *prometheus.Desc) {
+	ch <- d.hits
+	ch <- d.misses
+	ch <- d.evictions
+	ch <- d.queueDepth
+}
+
+// Collect implements prometheus.Collector interface Collect method.
+func (d *DashboardCacheMetricsManager) Collect(ch chan<- prometheus.Metric) {
+	stats := d.stats()
+	ch <- prometheus.MustNewConstMetric(d.hits, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(d.misses, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(d.evictions, prometheus.CounterValue, float64(stats.CapacityEvictions), "capacity")
+	ch <- prometheus.MustNewConstMetric(d.evictions, prometheus.CounterValue, float64(stats.ExpiryEvictions), "expired")
+	ch <- prometheus.MustNewConstMetric(d.queueDepth, prometheus.GaugeValue, float64(stats.QueueDepth))
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
+	"github.com/ray-project/kuberay/ray-operator/test/support"
+)
+
+func TestDashboardCacheMetrics(t *testing.T) {
+	cache, err := dashboardclient.NewRayDashboardCache(dashboardclient.DefaultRayDashboardCacheOptions())
+	require.NoError(t, err)
+	manager := NewDashboardCacheMetricsManager(cache)
+	manager.stats = func() dashboardclient.RayDashboardCacheStats {
+		return dashboardclient.RayDashboardCacheStats{
+			Hits:              5,
+			Misses:            2,
+			CapacityEvictions: 1,
+			ExpiryEvictions:   3,
+			QueueDepth:        4,
+		}
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(manager)
+
+	body, statusCode := support.GetMetricsResponseAndCode(t, reg)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Contains(t, body, "kuberay_job_info_cache_hits_total 5")
+	assert.Contains(t, body, "kuberay_job_info_cache_misses_total 2")
+	assert.Contains(t, body, `kuberay_job_info_cache_evictions_total{reason="capacity"} 1`)
+	assert.Contains(t, body, `kuberay_job_info_cache_evictions_total{reason="expired"} 3`)
+	assert.Contains(t, body, "kuberay_job_info_cache_queue_depth 4")
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
+)
+
+// DashboardClientMetricsManager implements the prometheus.Collector interface to collect the state of the
+// circuit breakers of the Ray dashboard clients.
+type DashboardClientMetricsManager struct {
+	circuitBreakerState *prometheus.Desc
+	states              func() map[types.NamespacedName]dashboardclient.CircuitBreakerState
+}
+
+// NewDashboardClientMetricsManager creates a new DashboardClientMetricsManager instance that reports the state of
+// the circuit breakers shared by the dashboard clients.
+func NewDashboardClientMetricsManager(circuitBreakers *dashboardclient.CircuitBreakers) *DashboardClientMetricsManager {
+	return &DashboardClientMetricsManager{
+		// circuitBreakerState is reported only for the RayClusters whose dashboard requests have recently failed.
+		// The circuit breakers of the other RayClusters are closed.
+		// Possible values for `state`: Closed, Open, HalfOpen
+		circuitBreakerState: prometheus.NewDesc(
+			"kuberay_dashboard_client_circuit_breaker_state",
+			"The state of the circuit breaker of the client that KubeRay uses to call a RayCluster's dashboard",
+			[]string{"name", "namespace", "state"},
+			nil,
+		),
+		states: circuitBreakers.States,
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (d *DashboardClientMetricsManager) Describe(ch chan<- *prometheus.Desc) {
+	ch <- d.circuitBreakerState
+}
+
+// Collect implements prometheus.Collector interface Collect method.
+func (d *DashboardClientMetricsManager) Collect(ch chan<- prometheus.Metric) {
+	for key, state := range d.states() {
+		ch <- prometheus.MustNewConstMetric(
+			d.circuitBreakerState,
+			prometheus.GaugeValue,
+			1,
+			key.Name,
+			key.Namespace,
+			string(state),
+		)
+	}
+}
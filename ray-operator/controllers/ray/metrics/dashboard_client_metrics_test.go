@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
+	"github.com/ray-project/kuberay/ray-operator/test/support"
+)
+
+func TestDashboardClientCircuitBreakerState(t *testing.T) {
+	manager := NewDashboardClientMetricsManager(dashboardclient.NewCircuitBreakers())
+	manager.states = func() map[types.NamespacedName]dashboardclient.CircuitBreakerState {
+		return map[types.NamespacedName]dashboardclient.CircuitBreakerState{
+			{Name: "raycluster-1", Namespace: "default"}: dashboardclient.CircuitBreakerOpen,
+			{Name: "raycluster-2", Namespace: "default"}: dashboardclient.CircuitBreakerHalfOpen,
+		}
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(manager)
+
+	body, statusCode := support.GetMetricsResponseAndCode(t, reg)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Contains(t, body, `kuberay_dashboard_client_circuit_breaker_state{name="raycluster-1",namespace="default",state="Open"} 1`)
+	assert.Contains(t, body, `kuberay_dashboard_client_circuit_breaker_state{name="raycluster-2",namespace="default",state="HalfOpen"} 1`)
+}
@@ -10,16 +10,21 @@ import (
 	"os"
 	"reflect"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	resourcev1 "k8s.io/api/resource/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -33,6 +38,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -43,6 +49,7 @@ import (
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/expectations"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/metrics"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
 	"github.com/ray-project/kuberay/ray-operator/pkg/features"
 )
 
@@ -50,13 +57,26 @@ type reconcileFunc func(context.Context, *rayv1.RayCluster) error
 
 var DefaultRequeueDuration = 2 * time.Second
 
+// idleActivityRefreshInterval is the minimum interval between updates of the last activity time of a RayCluster
+// with an idle policy, so that a RayCluster in use doesn't update its status on every reconciliation.
+const idleActivityRefreshInterval = time.Minute
+
+// idleCheckInterval is the minimum interval between two checks of whether a RayCluster with an idle policy is in use,
+// so that the Ray dashboard isn't queried on every reconciliation.
+const idleCheckInterval = 30 * time.Second
+
+// resourceClaimTemplateIndexKey indexes RayClusters by the names of the ResourceClaimTemplates that their Ray
+// containers use.
+const resourceClaimTemplateIndexKey = "spec.resourceClaimTemplateNames"
+
 // NewReconciler returns a new reconcile.Reconciler
-func NewReconciler(mgr manager.Manager, options RayClusterReconcilerOptions) *RayClusterReconciler {
+func NewReconciler(ctx context.Context, mgr manager.Manager, options RayClusterReconcilerOptions, provider utils.ClientProvider) *RayClusterReconciler {
 	return &RayClusterReconciler{
 		Client:                     mgr.GetClient(),
 		Scheme:                     mgr.GetScheme(),
 		Recorder:                   mgr.GetEventRecorderFor("raycluster-controller"),
 		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(mgr.GetClient()),
+		dashboardClientFunc:        provider.GetDashboardClient(ctx, mgr),
 		options:                    options,
 	}
 }
@@ -67,7 +87,11 @@ type RayClusterReconciler struct {
 	Scheme                     *k8sruntime.Scheme
 	Recorder                   record.EventRecorder
 	rayClusterScaleExpectation expectations.RayClusterScaleExpectation
-	options                    RayClusterReconcilerOptions
+	dashboardClientFunc        func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error)
+	// idleCheckTimes maps the namespaced name of a RayCluster with an idle policy to the last time its activity was
+	// checked.
+	idleCheckTimes sync.Map
+	options        RayClusterReconcilerOptions
 }
 
 type RayClusterReconcilerOptions struct {
@@ -76,8 +100,20 @@ type RayClusterReconcilerOptions struct {
 	HeadSidecarContainers    []corev1.Container
 	WorkerSidecarContainers  []corev1.Container
 	DefaultContainerEnvs     []corev1.EnvVar
-	IsOpenShift              bool
-	UseIngressOnOpenShift    bool
+	// DeviceClassResourceNames maps DRA device classes to extended resource names. Defaults to
+	// utils.DefaultDeviceClassResourceNames if nil.
+	DeviceClassResourceNames map[string]corev1.ResourceName
+	// Accelerators maps extended resources of the Ray container to Ray resources. Defaults to
+	// utils.DefaultAccelerators if nil.
+	Accelerators utils.Accelerators
+	// DashboardCircuitBreakers are the circuit breakers of the dashboard clients. The circuit breaker of a RayCluster
+	// is removed when the RayCluster is deleted.
+	DashboardCircuitBreakers *dashboardclient.CircuitBreakers
+	// OperatorNamespace is the namespace that the operator runs in. NetworkPolicies allow it to reach the dashboard
+	// of a RayCluster. NetworkPolicies aren't created if it's empty, because they would block the operator.
+	OperatorNamespace     string
+	IsOpenShift           bool
+	UseIngressOnOpenShift bool
 }
 
 // Reconcile reads that state of the cluster for a RayCluster object and makes changes based on it
@@ -93,14 +129,21 @@ type RayClusterReconcilerOptions struct {
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;create;update
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;delete;patch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=extensions,resources=ingresses,verbs=get;list;watch;create;update;delete;patch
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=roles,verbs=get;list;watch;create;delete;update
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=rolebindings,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=resource.k8s.io,resources=resourceclaimtemplates,verbs=get;list;watch
 
 // [WARNING]: There MUST be a newline after kubebuilder markers.
 
@@ -120,6 +163,10 @@ func (r *RayClusterReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		// Clear all related expectations
 		r.rayClusterScaleExpectation.Delete(request.Name, request.Namespace)
 		cleanUpRayClusterMetrics(r.options.RayClusterMetricsManager, request.Name, request.Namespace)
+		if r.options.DashboardCircuitBreakers != nil {
+			r.options.DashboardCircuitBreakers.Remove(request.NamespacedName)
+		}
+		r.idleCheckTimes.Delete(request.NamespacedName)
 	} else {
 		logger.Error(err, "Read request instance error!")
 	}
@@ -271,19 +318,23 @@ func (r *RayClusterReconciler) rayClusterReconcile(ctx context.Context, instance
 				// the redisCleanupJob is still running
 				return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, nil
 			}
-			redisCleanupJob := r.buildRedisCleanupJob(ctx, *instance)
+			backend, err := common.NewGcsStorageBackend(instance)
+			if err != nil {
+				return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, err
+			}
+			redisCleanupJob := r.buildGcsStorageCleanupJob(ctx, *instance, backend)
 			if err := r.Create(ctx, &redisCleanupJob); err != nil {
 				if errors.IsAlreadyExists(err) {
 					logger.Info("Redis cleanup Job already exists. Requeue the RayCluster CR.")
 					return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, nil
 				}
 				r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToCreateRedisCleanupJob),
-					"Failed to create Redis cleanup Job %s/%s, %v", redisCleanupJob.Namespace, redisCleanupJob.Name, err)
+					"Failed to create %s cleanup Job %s/%s, %v", backend.Name(), redisCleanupJob.Namespace, redisCleanupJob.Name, err)
 				return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, err
 			}
-			logger.Info("Created Redis cleanup Job", "name", redisCleanupJob.Name)
+			logger.Info("Created GCS storage cleanup Job", "name", redisCleanupJob.Name, "storageBackend", backend.Name())
 			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.CreatedRedisCleanupJob),
-				"Created Redis cleanup Job %s/%s", redisCleanupJob.Namespace, redisCleanupJob.Name)
+				"Created %s cleanup Job %s/%s", backend.Name(), redisCleanupJob.Namespace, redisCleanupJob.Name)
 			return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, nil
 		}
 	}
@@ -293,16 +344,39 @@ func (r *RayClusterReconciler) rayClusterReconcile(ctx context.Context, instance
 		return ctrl.Result{}, nil
 	}
 
+	nextIdleCheck, deleted, idlePolicyErr := r.reconcileIdlePolicy(ctx, instance)
+	if idlePolicyErr != nil {
+		return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, idlePolicyErr
+	} else if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	// The worker group fallbacks and the scaling schedules are reconciled once the RayCluster spec is no longer updated,
+	// because they override the replica settings of the in-memory RayCluster, which must not be written back to the spec.
+	nextFallbackTransition, fallbackErr := r.reconcileWorkerGroupFallbacks(ctx, instance)
+	if fallbackErr != nil {
+		return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, fallbackErr
+	}
+	nextScalingScheduleTransition, scalingScheduleErr := r.reconcileScalingSchedules(ctx, instance)
+	if scalingScheduleErr != nil {
+		return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, scalingScheduleErr
+	}
+
 	reconcileFuncs := []reconcileFunc{
 		r.reconcileAutoscalerServiceAccount,
 		r.reconcileAutoscalerRole,
 		r.reconcileAutoscalerRoleBinding,
 		r.reconcileIngress,
 		r.reconcileAuthSecret,
+		r.reconcileManagedRedis,
 		r.reconcileHeadService,
 		r.reconcileHeadlessService,
 		r.reconcileServeService,
+		r.reconcilePodDisruptionBudgets,
+		r.reconcileNetworkPolicy,
+		r.reconcileImagePrePull,
 		r.reconcilePods,
+		r.reconcileVolumeClaims,
 	}
 
 	for _, fn := range reconcileFuncs {
@@ -351,8 +425,21 @@ func (r *RayClusterReconciler) rayClusterReconcile(ctx context.Context, instance
 		)
 		requeueAfterSeconds = utils.RAYCLUSTER_DEFAULT_REQUEUE_SECONDS
 	}
-	logger.Info("Unconditional requeue after", "seconds", requeueAfterSeconds)
-	return ctrl.Result{RequeueAfter: time.Duration(requeueAfterSeconds) * time.Second}, nil
+	requeueAfter := time.Duration(requeueAfterSeconds) * time.Second
+	// Requeue earlier if a scaling schedule window starts or ends before the unconditional requeue.
+	if !nextScalingScheduleTransition.IsZero() {
+		requeueAfter = min(requeueAfter, max(time.Until(nextScalingScheduleTransition), 0))
+	}
+	// Requeue earlier if a worker group fallback may start or end before the unconditional requeue.
+	if !nextFallbackTransition.IsZero() {
+		requeueAfter = min(requeueAfter, max(time.Until(nextFallbackTransition), 0))
+	}
+	// Requeue earlier if the activity of a RayCluster with an idle policy is due to be checked again.
+	if !nextIdleCheck.IsZero() {
+		requeueAfter = min(requeueAfter, max(time.Until(nextIdleCheck), 0))
+	}
+	logger.Info("Unconditional requeue after", "seconds", requeueAfter.Seconds())
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *RayClusterReconciler) reconcileAuthSecret(ctx context.Context, instance *rayv1.RayCluster) error {
@@ -383,9 +470,471 @@ func (r *RayClusterReconciler) reconcileAuthSecret(ctx context.Context, instance
 		return err
 	}
 
+	return r.reconcileAuthTokenRotation(ctx, instance, secret)
+}
+
+// reconcileAuthTokenRotation rotates the auth token in the Secret generated by KubeRay once the rotation period has
+// elapsed, removes the previous token once its grace period has ended and no Pod uses it anymore, and records the
+// rotation in the RayCluster status.
+// The Ray Pods are recreated with the new token in `reconcilePods`.
+func (r *RayClusterReconciler) reconcileAuthTokenRotation(ctx context.Context, instance *rayv1.RayCluster, secret *corev1.Secret) error {
+	logger := ctrl.LoggerFrom(ctx)
+	now := time.Now()
+	authOptions := instance.Spec.AuthOptions
+
+	lastRotationTime := parseAuthTokenAnnotationTime(secret, utils.RayAuthTokenRotationTimeAnnotationKey)
+	previousTokenExpirationTime := parseAuthTokenAnnotationTime(secret, utils.RayPreviousAuthTokenExpirationTimeAnnotationKey)
+
+	var nextRotationTime *time.Time
+	if authOptions.TokenRotationPeriodSeconds != nil {
+		rotationBase := secret.CreationTimestamp.Time
+		if lastRotationTime != nil {
+			rotationBase = *lastRotationTime
+		}
+		next := rotationBase.Add(time.Duration(*authOptions.TokenRotationPeriodSeconds) * time.Second)
+		nextRotationTime = &next
+	}
+
+	switch {
+	case nextRotationTime != nil && !now.Before(*nextRotationTime):
+		token, err := generateRandomToken(32)
+		if err != nil {
+			return err
+		}
+		gracePeriod := time.Duration(ptr.Deref(authOptions.TokenRotationGracePeriodSeconds, utils.DefaultAuthTokenRotationGracePeriodSeconds)) * time.Second
+		rotationTime := now.Truncate(time.Second)
+		expirationTime := rotationTime.Add(gracePeriod)
+
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Data[utils.RAY_PREVIOUS_AUTH_TOKEN_SECRET_KEY] = secret.Data[utils.RAY_AUTH_TOKEN_SECRET_KEY]
+		secret.Data[utils.RAY_AUTH_TOKEN_SECRET_KEY] = []byte(token)
+		secret.Annotations[utils.RayAuthTokenRotationTimeAnnotationKey] = rotationTime.UTC().Format(time.RFC3339)
+		secret.Annotations[utils.RayPreviousAuthTokenExpirationTimeAnnotationKey] = expirationTime.UTC().Format(time.RFC3339)
+		if err := r.Update(ctx, secret); err != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToRotateAuthToken),
+				"Failed to rotate the auth token in Secret %s/%s: %v", secret.Namespace, secret.Name, err)
+			return err
+		}
+		logger.Info("Rotated the auth token", "secret", secret.Name, "previousTokenExpirationTime", expirationTime)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.RotatedAuthToken),
+			"Rotated the auth token in Secret %s/%s, the previous token is kept until %s", secret.Namespace, secret.Name, expirationTime.UTC().Format(time.RFC3339))
+
+		lastRotationTime = &rotationTime
+		previousTokenExpirationTime = &expirationTime
+		next := rotationTime.Add(time.Duration(*authOptions.TokenRotationPeriodSeconds) * time.Second)
+		nextRotationTime = &next
+	case previousTokenExpirationTime != nil && !now.Before(*previousTokenExpirationTime):
+		// KubeRay falls back to the previous token to reach the Pods that haven't been recreated yet.
+		if lastRotationTime != nil {
+			hasStalePods, err := r.hasPodsWithPreviousAuthToken(ctx, instance, *lastRotationTime)
+			if err != nil {
+				return err
+			}
+			if hasStalePods {
+				logger.Info("Keeping the previous auth token until no Pod uses it", "secret", secret.Name)
+				break
+			}
+		}
+		delete(secret.Data, utils.RAY_PREVIOUS_AUTH_TOKEN_SECRET_KEY)
+		delete(secret.Annotations, utils.RayPreviousAuthTokenExpirationTimeAnnotationKey)
+		if err := r.Update(ctx, secret); err != nil {
+			return err
+		}
+		logger.Info("Removed the previous auth token", "secret", secret.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.ExpiredPreviousAuthToken),
+			"Removed the previous auth token from Secret %s/%s after its grace period", secret.Namespace, secret.Name)
+		previousTokenExpirationTime = nil
+	}
+
+	if lastRotationTime == nil && nextRotationTime == nil {
+		instance.Status.AuthToken = nil
+		return nil
+	}
+	instance.Status.AuthToken = &rayv1.AuthTokenStatus{
+		LastRotationTime:            toMetaTime(lastRotationTime),
+		NextRotationTime:            toMetaTime(nextRotationTime),
+		PreviousTokenExpirationTime: toMetaTime(previousTokenExpirationTime),
+	}
 	return nil
 }
 
+// parseAuthTokenAnnotationTime returns the time recorded in the given annotation of the auth Secret, or nil if
+// the annotation is missing or malformed.
+func parseAuthTokenAnnotationTime(secret *corev1.Secret, key string) *time.Time {
+	value, ok := secret.Annotations[key]
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func toMetaTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	return &metav1.Time{Time: *t}
+}
+
+// reconcileScalingSchedules overrides the replica settings of the worker groups with those of their active scaling
+// schedule windows. The overrides are only applied to the in-memory RayCluster, so that the Pod reconciliation and the
+// status use them, and the RayCluster spec is never changed. The active windows and the replica bounds they set, which
+// the autoscaler must respect, are reported in the worker group statuses. Worker groups involved in a fallback can't
+// have scaling schedules. It returns the next time at which a window starts or ends.
+func (r *RayClusterReconciler) reconcileScalingSchedules(ctx context.Context, instance *rayv1.RayCluster) (time.Time, error) {
+	oldStatusMap := make(map[string]rayv1.WorkerGroupStatus, len(instance.Status.WorkerGroupStatuses))
+	for _, status := range instance.Status.WorkerGroupStatuses {
+		oldStatusMap[status.GroupName] = status
+	}
+
+	now := time.Now()
+	var nextTransition time.Time
+	updateNextTransition := func(t time.Time) {
+		if !t.IsZero() && (nextTransition.IsZero() || t.Before(nextTransition)) {
+			nextTransition = t
+		}
+	}
+	var startedEvents, endedEvents []string
+	for i := range instance.Spec.WorkerGroupSpecs {
+		worker := &instance.Spec.WorkerGroupSpecs[i]
+		active, startTime, err := utils.GetActiveScalingSchedule(*worker, now)
+		if err != nil {
+			return time.Time{}, err
+		}
+		nextStartTime, err := utils.GetNextScalingScheduleStartTime(*worker, now)
+		if err != nil {
+			return time.Time{}, err
+		}
+		updateNextTransition(nextStartTime)
+
+		var current *rayv1.ActiveScalingSchedule
+		if active != nil {
+			current = utils.NewActiveScalingSchedule(*worker, *active, startTime)
+			updateNextTransition(current.EndTime.Time)
+		}
+		previous := oldStatusMap[worker.GroupName].ActiveScalingSchedule
+		if previous != nil && (current == nil || current.Name != previous.Name || !current.StartTime.Equal(&previous.StartTime)) {
+			endedEvents = append(endedEvents, fmt.Sprintf("The window of scaling schedule %s of worker group %s ended",
+				previous.Name, worker.GroupName))
+		}
+		if current == nil {
+			continue
+		}
+		if previous == nil || current.Name != previous.Name || !current.StartTime.Equal(&previous.StartTime) {
+			startedEvents = append(startedEvents, fmt.Sprintf("Applied the window of scaling schedule %s to worker group %s until %s",
+				current.Name, worker.GroupName, current.EndTime.UTC().Format(time.RFC3339)))
+		}
+		utils.ApplyScalingSchedule(worker, *active)
+	}
+
+	for _, message := range endedEvents {
+		r.Recorder.Event(instance, corev1.EventTypeNormal, string(utils.EndedScalingSchedule), message)
+	}
+	for _, message := range startedEvents {
+		r.Recorder.Event(instance, corev1.EventTypeNormal, string(utils.StartedScalingSchedule), message)
+	}
+	return nextTransition, nil
+}
+
+// reconcileWorkerGroupFallbacks records the preemptions of worker Pods, and moves the replicas of a worker group to its
+// fallback group while its Pods stay unschedulable or are repeatedly preempted. Like the scaling schedules, the
+// fallbacks are only applied to the in-memory RayCluster and the RayCluster spec is never changed, so the replica
+// settings of both worker groups are used again once the fallback ends. The active fallbacks are reported in the
+// worker group statuses. It returns the next time at which a fallback may start or ends.
+func (r *RayClusterReconciler) reconcileWorkerGroupFallbacks(ctx context.Context, instance *rayv1.RayCluster) (time.Time, error) {
+	workerPods := corev1.PodList{}
+	if err := r.List(ctx, &workerPods, common.RayClusterWorkerPodsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return time.Time{}, err
+	}
+	groupPods := make(map[string][]*corev1.Pod)
+	for i := range workerPods.Items {
+		pod := &workerPods.Items[i]
+		groupName := pod.Labels[utils.RayNodeGroupLabelKey]
+		groupPods[groupName] = append(groupPods[groupName], pod)
+	}
+
+	now := time.Now()
+	var nextTransition time.Time
+	updateNextTransition := func(t time.Time) {
+		if !t.IsZero() && (nextTransition.IsZero() || t.Before(nextTransition)) {
+			nextTransition = t
+		}
+	}
+
+	// 1. Record the Pods that have been preempted and aren't counted yet.
+	statuses := make([]rayv1.WorkerGroupStatus, 0, len(instance.Spec.WorkerGroupSpecs))
+	oldStatusMap := make(map[string]rayv1.WorkerGroupStatus, len(instance.Status.WorkerGroupStatuses))
+	for _, status := range instance.Status.WorkerGroupStatuses {
+		oldStatusMap[status.GroupName] = status
+	}
+	var preemptedEvents []string
+	groupIndices := make(map[string]int, len(instance.Spec.WorkerGroupSpecs))
+	for i, worker := range instance.Spec.WorkerGroupSpecs {
+		groupIndices[worker.GroupName] = i
+		status, ok := oldStatusMap[worker.GroupName]
+		if !ok {
+			status = rayv1.WorkerGroupStatus{GroupName: worker.GroupName}
+		}
+		status = *status.DeepCopy()
+		// A preempted Pod is counted once, so only the UIDs of the preempted Pods that still exist are kept.
+		countedPods := make(map[string]bool, len(status.PreemptedPodUIDs))
+		for _, uid := range status.PreemptedPodUIDs {
+			countedPods[uid] = true
+		}
+		status.PreemptedPodUIDs = nil
+		for _, pod := range groupPods[worker.GroupName] {
+			cond := utils.GetPodPreemptionCondition(pod)
+			if cond == nil {
+				continue
+			}
+			status.PreemptedPodUIDs = append(status.PreemptedPodUIDs, string(pod.UID))
+			if countedPods[string(pod.UID)] {
+				continue
+			}
+			status.Preemptions++
+			if status.LastPreemptionTime == nil || cond.LastTransitionTime.After(status.LastPreemptionTime.Time) {
+				status.LastPreemptionTime = cond.LastTransitionTime.DeepCopy()
+			}
+			status.RecentPreemptionTimes = append(status.RecentPreemptionTimes, cond.LastTransitionTime)
+			preemptedEvents = append(preemptedEvents, fmt.Sprintf("Worker Pod %s/%s of group %s was preempted: %s: %s",
+				pod.Namespace, pod.Name, worker.GroupName, cond.Reason, cond.Message))
+		}
+		// Only the preemptions within the preemption window count toward the fallback.
+		var recentPreemptionTimes []metav1.Time
+		if worker.Fallback != nil {
+			window := utils.GetFallbackPreemptionWindow(*worker.Fallback)
+			for _, t := range status.RecentPreemptionTimes {
+				if now.Sub(t.Time) < window {
+					recentPreemptionTimes = append(recentPreemptionTimes, t)
+				}
+			}
+			slices.SortFunc(recentPreemptionTimes, func(a, b metav1.Time) int { return a.Compare(b.Time) })
+			if maxPreemptions := int(utils.GetFallbackMaxPreemptions(*worker.Fallback)); len(recentPreemptionTimes) > maxPreemptions {
+				recentPreemptionTimes = recentPreemptionTimes[len(recentPreemptionTimes)-maxPreemptions:]
+			}
+		}
+		status.RecentPreemptionTimes = recentPreemptionTimes
+		statuses = append(statuses, status)
+	}
+
+	// 2. End the fallbacks that have expired, or whose worker groups or fallback groups have been changed.
+	var startedEvents, endedEvents []string
+	for i, worker := range instance.Spec.WorkerGroupSpecs {
+		current := statuses[i].ActiveFallback
+		if current == nil {
+			continue
+		}
+		if _, ok := groupIndices[current.GroupName]; ok && worker.Fallback != nil && worker.Fallback.GroupName == current.GroupName && now.Before(current.EndTime.Time) {
+			updateNextTransition(current.EndTime.Time)
+			continue
+		}
+		statuses[i].ActiveFallback = nil
+		endedEvents = append(endedEvents, fmt.Sprintf("Worker group %s no longer falls back to worker group %s",
+			worker.GroupName, current.GroupName))
+	}
+
+	// 3. Start the fallbacks of the worker groups whose Pods stay unschedulable or are repeatedly preempted.
+	for i, worker := range instance.Spec.WorkerGroupSpecs {
+		if worker.Fallback == nil || statuses[i].ActiveFallback != nil || utils.GetWorkerGroupDesiredReplicas(worker) == 0 {
+			continue
+		}
+		if _, ok := groupIndices[worker.Fallback.GroupName]; !ok {
+			continue
+		}
+		var reason string
+		if maxPreemptions := utils.GetFallbackMaxPreemptions(*worker.Fallback); len(statuses[i].RecentPreemptionTimes) >= int(maxPreemptions) {
+			reason = fmt.Sprintf("%d Pods were preempted within %s", maxPreemptions, utils.GetFallbackPreemptionWindow(*worker.Fallback))
+		}
+		timeout := utils.GetFallbackUnschedulableTimeout(*worker.Fallback)
+		for _, pod := range groupPods[worker.GroupName] {
+			if reason != "" {
+				break
+			}
+			unschedulableTime := utils.GetPodUnschedulableTime(pod)
+			if unschedulableTime.IsZero() || pod.DeletionTimestamp != nil {
+				continue
+			}
+			if deadline := unschedulableTime.Add(timeout); now.Before(deadline) {
+				updateNextTransition(deadline)
+				continue
+			}
+			reason = fmt.Sprintf("Pod %s was unschedulable for more than %s", pod.Name, timeout)
+		}
+		if reason == "" {
+			continue
+		}
+		current := utils.NewActiveFallback(worker, reason, now)
+		statuses[i].ActiveFallback = current
+		statuses[i].RecentPreemptionTimes = nil
+		updateNextTransition(current.EndTime.Time)
+		startedEvents = append(startedEvents, fmt.Sprintf("Worker group %s fell back to worker group %s until %s: %s",
+			worker.GroupName, current.GroupName, current.EndTime.UTC().Format(time.RFC3339), reason))
+	}
+
+	// 4. Move the replicas of the worker groups that fell back to their fallback groups.
+	for i := range instance.Spec.WorkerGroupSpecs {
+		if current := statuses[i].ActiveFallback; current != nil {
+			utils.ApplyFallback(&instance.Spec.WorkerGroupSpecs[i], &instance.Spec.WorkerGroupSpecs[groupIndices[current.GroupName]])
+		}
+	}
+
+	instance.Status.WorkerGroupStatuses = statuses
+	for _, message := range preemptedEvents {
+		r.Recorder.Event(instance, corev1.EventTypeWarning, string(utils.PreemptedWorkerPod), message)
+	}
+	for _, message := range endedEvents {
+		r.Recorder.Event(instance, corev1.EventTypeNormal, string(utils.EndedWorkerGroupFallback), message)
+	}
+	for _, message := range startedEvents {
+		r.Recorder.Event(instance, corev1.EventTypeWarning, string(utils.StartedWorkerGroupFallback), message)
+	}
+	return nextTransition, nil
+}
+
+// reconcileIdlePolicy records the last time the RayCluster was in use, and suspends or deletes the RayCluster once it
+// has been idle for longer than its idle policy allows. A Warning event is always emitted before the action is taken.
+// The activity of the RayCluster is checked at most once per idleCheckInterval. It returns the time of the next check,
+// which is zero if the RayCluster has no idle policy, and true if the RayCluster has been deleted.
+func (r *RayClusterReconciler) reconcileIdlePolicy(ctx context.Context, instance *rayv1.RayCluster) (time.Time, bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	key := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+	idlePolicy := instance.Spec.IdlePolicy
+	// A suspended RayCluster starts a new idle period once it's resumed.
+	if idlePolicy == nil || (instance.Spec.Suspend != nil && *instance.Spec.Suspend) {
+		instance.Status.Idle = nil
+		r.idleCheckTimes.Delete(key)
+		return time.Time{}, false, nil
+	}
+
+	now := time.Now().Truncate(time.Second)
+	if instance.Status.Idle == nil || instance.Status.Idle.LastActivityTime == nil {
+		instance.Status.Idle = &rayv1.IdleStatus{LastActivityTime: &metav1.Time{Time: now}}
+		r.idleCheckTimes.Store(key, now)
+		return now.Add(idleCheckInterval), false, nil
+	}
+	if lastCheckTime, ok := r.idleCheckTimes.Load(key); ok {
+		if nextCheck := lastCheckTime.(time.Time).Add(idleCheckInterval); now.Before(nextCheck) {
+			return nextCheck, false, nil
+		}
+	}
+	r.idleCheckTimes.Store(key, now)
+	nextCheck := now.Add(idleCheckInterval)
+
+	active, err := r.isRayClusterActive(ctx, instance)
+	if err != nil {
+		// The RayCluster isn't considered idle while its activity is unknown, for example while the head Pod starts.
+		logger.Info("Unable to check whether the RayCluster is idle", "error", err)
+		return nextCheck, false, nil
+	}
+	if active {
+		if now.Sub(instance.Status.Idle.LastActivityTime.Time) >= idleActivityRefreshInterval || instance.Status.Idle.WarningTime != nil {
+			instance.Status.Idle = &rayv1.IdleStatus{LastActivityTime: &metav1.Time{Time: now}}
+		}
+		return nextCheck, false, nil
+	}
+
+	action := ptr.Deref(idlePolicy.Action, rayv1.IdleActionSuspend)
+	lastActivityTime := instance.Status.Idle.LastActivityTime.Time
+	actionTime := lastActivityTime.Add(time.Duration(idlePolicy.IdleTimeoutSeconds) * time.Second)
+	warningPeriod := time.Duration(ptr.Deref(idlePolicy.WarningPeriodSeconds, utils.DefaultIdleWarningPeriodSeconds)) * time.Second
+	if instance.Status.Idle.WarningTime == nil {
+		if now.Before(actionTime.Add(-warningPeriod)) {
+			return nextCheck, false, nil
+		}
+		logger.Info("The RayCluster is idle", "lastActivityTime", lastActivityTime, "action", action, "actionTime", actionTime)
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.IdleRayCluster),
+			"RayCluster %s/%s has been idle since %s, the idle policy action %s will be taken at %s unless it's used",
+			instance.Namespace, instance.Name, lastActivityTime.UTC().Format(time.RFC3339), action, actionTime.UTC().Format(time.RFC3339))
+		instance.Status.Idle.WarningTime = &metav1.Time{Time: now}
+		return nextCheck, false, nil
+	}
+	if now.Before(actionTime) {
+		return nextCheck, false, nil
+	}
+
+	if action == rayv1.IdleActionDelete {
+		if err := r.Delete(ctx, instance); err != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToReclaimIdleRayCluster),
+				"Failed to delete idle RayCluster %s/%s: %v", instance.Namespace, instance.Name, err)
+			return time.Time{}, false, err
+		}
+		logger.Info("Deleted the idle RayCluster", "lastActivityTime", lastActivityTime)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedIdleRayCluster),
+			"Deleted RayCluster %s/%s after being idle since %s", instance.Namespace, instance.Name, lastActivityTime.UTC().Format(time.RFC3339))
+		r.idleCheckTimes.Delete(key)
+		return time.Time{}, true, nil
+	}
+
+	// The RayCluster is suspended through the regular suspend operation, which sets the RayClusterSuspending and
+	// RayClusterSuspended conditions.
+	instance.Spec.Suspend = ptr.To(true)
+	if err := r.Update(ctx, instance); err != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToReclaimIdleRayCluster),
+			"Failed to suspend idle RayCluster %s/%s: %v", instance.Namespace, instance.Name, err)
+		return time.Time{}, false, err
+	}
+	logger.Info("Suspended the idle RayCluster", "lastActivityTime", lastActivityTime)
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.SuspendedIdleRayCluster),
+		"Suspended RayCluster %s/%s after being idle since %s", instance.Namespace, instance.Name, lastActivityTime.UTC().Format(time.RFC3339))
+	instance.Status.Idle = nil
+	r.idleCheckTimes.Delete(key)
+	return time.Time{}, false, nil
+}
+
+// isRayClusterActive returns whether the RayCluster has pending or running Ray jobs, alive actors, or logical
+// resources in use, as reported by the Ray dashboard.
+func (r *RayClusterReconciler) isRayClusterActive(ctx context.Context, instance *rayv1.RayCluster) (bool, error) {
+	dashboardURL, err := utils.FetchHeadServiceURL(ctx, r.Client, instance, utils.DashboardPortName)
+	if err != nil {
+		return false, err
+	}
+	rayDashboardClient, err := r.dashboardClientFunc(instance, dashboardURL)
+	if err != nil {
+		return false, err
+	}
+
+	jobs, err := rayDashboardClient.ListJobs(ctx)
+	if err != nil {
+		return false, err
+	}
+	if jobs != nil {
+		for _, job := range *jobs {
+			if !rayv1.IsJobTerminal(job.JobStatus) {
+				return true, nil
+			}
+		}
+	}
+
+	hasAliveActors, err := rayDashboardClient.HasAliveActors(ctx)
+	if err != nil || hasAliveActors {
+		return hasAliveActors, err
+	}
+
+	resourceUsage, err := rayDashboardClient.GetClusterResourceUsage(ctx)
+	if err != nil {
+		return false, err
+	}
+	for resource, usage := range resourceUsage {
+		// Memory and object store memory are used by idle Ray processes, and the resources that identify nodes
+		// and accelerator types, e.g. "node:10.0.0.1", are not consumed by tasks.
+		if resource == "memory" || resource == "object_store_memory" || strings.Contains(resource, ":") {
+			continue
+		}
+		if len(usage) > 0 && usage[0] > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // createAuthSecret generates a new secret with a random token.
 func (r *RayClusterReconciler) createAuthSecret(ctx context.Context, rayCluster *rayv1.RayCluster, secretName string) error {
 	token, err := generateRandomToken(32)
@@ -616,6 +1165,498 @@ func (r *RayClusterReconciler) reconcileHeadlessService(ctx context.Context, ins
 	return nil
 }
 
+// reconcileManagedRedis creates the Redis StatefulSet, Service, and password Secret that KubeRay manages for the
+// RayCluster's GCS fault tolerance. They are owned by the RayCluster, so they are garbage collected after the
+// GCS FT finalizer has cleaned up the storage namespace and the RayCluster is deleted.
+func (r *RayClusterReconciler) reconcileManagedRedis(ctx context.Context, instance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+	if !utils.IsManagedRedisEnabled(&instance.Spec) {
+		return nil
+	}
+	logger.Info("Reconciling managed Redis")
+	name := utils.GenerateManagedRedisName(instance.Name)
+	key := types.NamespacedName{Name: name, Namespace: instance.Namespace}
+
+	if err := r.Get(ctx, key, &corev1.Secret{}); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		password, err := generateRandomToken(32)
+		if err != nil {
+			return err
+		}
+		if err := r.createManagedRedisObject(ctx, instance, common.BuildManagedRedisSecret(instance, password)); err != nil {
+			return err
+		}
+	}
+
+	if err := r.Get(ctx, key, &corev1.Service{}); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if err := r.createManagedRedisObject(ctx, instance, common.BuildManagedRedisService(instance)); err != nil {
+			return err
+		}
+	}
+
+	desiredStatefulSet := common.BuildManagedRedisStatefulSet(instance)
+	existingStatefulSet := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, key, existingStatefulSet); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		return r.createManagedRedisObject(ctx, instance, desiredStatefulSet)
+	}
+
+	// Only the image and the resources of the Redis container can be updated. The volume claim
+	// templates of a StatefulSet are immutable.
+	desiredContainer := desiredStatefulSet.Spec.Template.Spec.Containers[0]
+	existingContainer := &existingStatefulSet.Spec.Template.Spec.Containers[0]
+	if existingContainer.Image == desiredContainer.Image && reflect.DeepEqual(existingContainer.Resources, desiredContainer.Resources) {
+		return nil
+	}
+	existingContainer.Image = desiredContainer.Image
+	existingContainer.Resources = desiredContainer.Resources
+	if err := r.Update(ctx, existingStatefulSet); err != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToUpdateManagedRedis),
+			"Failed updating managed Redis StatefulSet %s/%s, %v", existingStatefulSet.Namespace, existingStatefulSet.Name, err)
+		return err
+	}
+	logger.Info("Updated managed Redis StatefulSet", "name", existingStatefulSet.Name)
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.UpdatedManagedRedis),
+		"Updated managed Redis StatefulSet %s/%s", existingStatefulSet.Namespace, existingStatefulSet.Name)
+	return nil
+}
+
+// createManagedRedisObject sets the RayCluster as the controller of the managed Redis object and creates it.
+func (r *RayClusterReconciler) createManagedRedisObject(ctx context.Context, instance *rayv1.RayCluster, obj client.Object) error {
+	logger := ctrl.LoggerFrom(ctx)
+	kind := reflect.TypeOf(obj).Elem().Name()
+	if err := controllerutil.SetControllerReference(instance, obj, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, obj); err != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToCreateManagedRedis),
+			"Failed creating managed Redis %s %s/%s, %v", kind, obj.GetNamespace(), obj.GetName(), err)
+		return err
+	}
+	logger.Info("Created managed Redis object", "kind", kind, "name", obj.GetName())
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.CreatedManagedRedis),
+		"Created managed Redis %s %s/%s", kind, obj.GetNamespace(), obj.GetName())
+	return nil
+}
+
+// reconcilePodDisruptionBudgets creates or updates a PodDisruptionBudget for each head and worker group that
+// configures one, and deletes the PodDisruptionBudgets of groups that no longer do.
+func (r *RayClusterReconciler) reconcilePodDisruptionBudgets(ctx context.Context, instance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	pdbList := policyv1.PodDisruptionBudgetList{}
+	if err := r.List(ctx, &pdbList, common.RayClusterPodDisruptionBudgetsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return err
+	}
+	existingPDBs := make(map[string]*policyv1.PodDisruptionBudget, len(pdbList.Items))
+	for i := range pdbList.Items {
+		if metav1.IsControlledBy(&pdbList.Items[i], instance) {
+			existingPDBs[pdbList.Items[i].Name] = &pdbList.Items[i]
+		}
+	}
+
+	for _, pdb := range common.BuildPodDisruptionBudgets(instance) {
+		existingPDB, ok := existingPDBs[pdb.Name]
+		delete(existingPDBs, pdb.Name)
+		if !ok {
+			if err := controllerutil.SetControllerReference(instance, pdb, r.Scheme); err != nil {
+				return err
+			}
+			if err := r.Create(ctx, pdb); err != nil {
+				r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToCreatePodDisruptionBudget),
+					"Failed creating PodDisruptionBudget %s/%s, %v", pdb.Namespace, pdb.Name, err)
+				return err
+			}
+			logger.Info("Created PodDisruptionBudget", "name", pdb.Name)
+			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.CreatedPodDisruptionBudget),
+				"Created PodDisruptionBudget %s/%s", pdb.Namespace, pdb.Name)
+			continue
+		}
+
+		if reflect.DeepEqual(existingPDB.Spec, pdb.Spec) {
+			continue
+		}
+		existingPDB.Spec = pdb.Spec
+		if err := r.Update(ctx, existingPDB); err != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToUpdatePodDisruptionBudget),
+				"Failed updating PodDisruptionBudget %s/%s, %v", existingPDB.Namespace, existingPDB.Name, err)
+			return err
+		}
+		logger.Info("Updated PodDisruptionBudget", "name", existingPDB.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.UpdatedPodDisruptionBudget),
+			"Updated PodDisruptionBudget %s/%s", existingPDB.Namespace, existingPDB.Name)
+	}
+
+	for _, pdb := range existingPDBs {
+		if err := r.Delete(ctx, pdb); client.IgnoreNotFound(err) != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeletePodDisruptionBudget),
+				"Failed deleting PodDisruptionBudget %s/%s, %v", pdb.Namespace, pdb.Name, err)
+			return err
+		}
+		logger.Info("Deleted PodDisruptionBudget", "name", pdb.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedPodDisruptionBudget),
+			"Deleted PodDisruptionBudget %s/%s", pdb.Namespace, pdb.Name)
+	}
+	return nil
+}
+
+// reconcileNetworkPolicy creates or updates the NetworkPolicy of the RayCluster if network isolation is enabled, and
+// deletes it otherwise. The NetworkPolicy is garbage collected with the RayCluster.
+func (r *RayClusterReconciler) reconcileNetworkPolicy(ctx context.Context, instance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	existingPolicy := &networkingv1.NetworkPolicy{}
+	key := types.NamespacedName{Namespace: instance.Namespace, Name: utils.GenerateNetworkPolicyName(instance.Name)}
+	if err := r.Get(ctx, key, existingPolicy); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		existingPolicy = nil
+	} else if !metav1.IsControlledBy(existingPolicy, instance) {
+		return fmt.Errorf("NetworkPolicy %s/%s already exists and is not controlled by the RayCluster", key.Namespace, key.Name)
+	}
+
+	if instance.Spec.NetworkIsolation == nil {
+		if existingPolicy == nil {
+			return nil
+		}
+		if err := r.Delete(ctx, existingPolicy); client.IgnoreNotFound(err) != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteNetworkPolicy),
+				"Failed deleting NetworkPolicy %s/%s, %v", existingPolicy.Namespace, existingPolicy.Name, err)
+			return err
+		}
+		logger.Info("Deleted NetworkPolicy", "name", existingPolicy.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedNetworkPolicy),
+			"Deleted NetworkPolicy %s/%s", existingPolicy.Namespace, existingPolicy.Name)
+		return nil
+	}
+
+	if r.options.OperatorNamespace == "" {
+		eventType := utils.FailedToCreateNetworkPolicy
+		if existingPolicy != nil {
+			eventType = utils.FailedToUpdateNetworkPolicy
+		}
+		logger.Info("Skipping the NetworkPolicy because the operator namespace is unknown", "name", key.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(eventType),
+			"Skipped NetworkPolicy %s/%s because the operator namespace is unknown, which would block the operator. Set operatorNamespace in the operator configuration.",
+			key.Namespace, key.Name)
+		return nil
+	}
+
+	policy := common.BuildNetworkPolicy(instance, r.options.OperatorNamespace)
+	if existingPolicy == nil {
+		if err := controllerutil.SetControllerReference(instance, policy, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, policy); err != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToCreateNetworkPolicy),
+				"Failed creating NetworkPolicy %s/%s, %v", policy.Namespace, policy.Name, err)
+			return err
+		}
+		logger.Info("Created NetworkPolicy", "name", policy.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.CreatedNetworkPolicy),
+			"Created NetworkPolicy %s/%s", policy.Namespace, policy.Name)
+		return nil
+	}
+
+	if reflect.DeepEqual(existingPolicy.Spec, policy.Spec) {
+		return nil
+	}
+	existingPolicy.Spec = policy.Spec
+	if err := r.Update(ctx, existingPolicy); err != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToUpdateNetworkPolicy),
+			"Failed updating NetworkPolicy %s/%s, %v", existingPolicy.Namespace, existingPolicy.Name, err)
+		return err
+	}
+	logger.Info("Updated NetworkPolicy", "name", existingPolicy.Name)
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.UpdatedNetworkPolicy),
+		"Updated NetworkPolicy %s/%s", existingPolicy.Namespace, existingPolicy.Name)
+	return nil
+}
+
+// reconcileImagePrePull creates, updates, and deletes the DaemonSets that pre-pull the images of the head group and
+// the worker groups. A DaemonSet is updated when the hash of its desired spec changes.
+func (r *RayClusterReconciler) reconcileImagePrePull(ctx context.Context, instance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	daemonSetList := appsv1.DaemonSetList{}
+	if err := r.List(ctx, &daemonSetList, common.RayClusterImagePrePullDaemonSetsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return err
+	}
+	existingDaemonSets := make(map[string]*appsv1.DaemonSet, len(daemonSetList.Items))
+	for i := range daemonSetList.Items {
+		if metav1.IsControlledBy(&daemonSetList.Items[i], instance) {
+			existingDaemonSets[daemonSetList.Items[i].Name] = &daemonSetList.Items[i]
+		}
+	}
+
+	for _, daemonSet := range common.BuildImagePrePullDaemonSets(instance) {
+		hash, err := utils.GenerateJsonHash(daemonSet.Spec)
+		if err != nil {
+			return err
+		}
+		daemonSet.Annotations = map[string]string{utils.ImagePrePullHashKey: hash}
+
+		existingDaemonSet, ok := existingDaemonSets[daemonSet.Name]
+		delete(existingDaemonSets, daemonSet.Name)
+		if !ok {
+			if err := controllerutil.SetControllerReference(instance, daemonSet, r.Scheme); err != nil {
+				return err
+			}
+			if err := r.Create(ctx, daemonSet); err != nil {
+				r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToCreateDaemonSet),
+					"Failed creating DaemonSet %s/%s, %v", daemonSet.Namespace, daemonSet.Name, err)
+				return err
+			}
+			logger.Info("Created DaemonSet", "name", daemonSet.Name)
+			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.CreatedDaemonSet),
+				"Created DaemonSet %s/%s", daemonSet.Namespace, daemonSet.Name)
+			continue
+		}
+
+		if existingDaemonSet.Annotations[utils.ImagePrePullHashKey] == hash {
+			continue
+		}
+		existingDaemonSet.Spec = daemonSet.Spec
+		if existingDaemonSet.Annotations == nil {
+			existingDaemonSet.Annotations = map[string]string{}
+		}
+		existingDaemonSet.Annotations[utils.ImagePrePullHashKey] = hash
+		if err := r.Update(ctx, existingDaemonSet); err != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToUpdateDaemonSet),
+				"Failed updating DaemonSet %s/%s, %v", existingDaemonSet.Namespace, existingDaemonSet.Name, err)
+			return err
+		}
+		logger.Info("Updated DaemonSet", "name", existingDaemonSet.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.UpdatedDaemonSet),
+			"Updated DaemonSet %s/%s", existingDaemonSet.Namespace, existingDaemonSet.Name)
+	}
+
+	for _, daemonSet := range existingDaemonSets {
+		if err := r.Delete(ctx, daemonSet); client.IgnoreNotFound(err) != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteDaemonSet),
+				"Failed deleting DaemonSet %s/%s, %v", daemonSet.Namespace, daemonSet.Name, err)
+			return err
+		}
+		logger.Info("Deleted DaemonSet", "name", daemonSet.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedDaemonSet),
+			"Deleted DaemonSet %s/%s", daemonSet.Namespace, daemonSet.Name)
+	}
+	return nil
+}
+
+// calculateImagePrePullCondition returns the ImagesPrePulled condition of the RayCluster. It returns nil if
+// .Spec.ImagePrePull is not set.
+func (r *RayClusterReconciler) calculateImagePrePullCondition(ctx context.Context, instance *rayv1.RayCluster) (*metav1.Condition, error) {
+	if instance.Spec.ImagePrePull == nil {
+		return nil, nil
+	}
+	daemonSetList := appsv1.DaemonSetList{}
+	if err := r.List(ctx, &daemonSetList, common.RayClusterImagePrePullDaemonSetsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return nil, err
+	}
+	existingDaemonSets := make(map[string]*appsv1.DaemonSet, len(daemonSetList.Items))
+	for i := range daemonSetList.Items {
+		existingDaemonSets[daemonSetList.Items[i].Name] = &daemonSetList.Items[i]
+	}
+
+	var unscheduledDaemonSets []string
+	for _, daemonSet := range common.BuildImagePrePullDaemonSets(instance) {
+		existingDaemonSet, ok := existingDaemonSets[daemonSet.Name]
+		if !ok {
+			return &metav1.Condition{
+				Type:    string(rayv1.RayClusterImagesPrePulled),
+				Status:  metav1.ConditionFalse,
+				Reason:  rayv1.ImagesPrePulling,
+				Message: fmt.Sprintf("DaemonSet %s is not created yet", daemonSet.Name),
+			}, nil
+		}
+		if !common.IsImagePrePullDaemonSetReady(existingDaemonSet) {
+			return &metav1.Condition{
+				Type:   string(rayv1.RayClusterImagesPrePulled),
+				Status: metav1.ConditionFalse,
+				Reason: rayv1.ImagesPrePulling,
+				Message: fmt.Sprintf("DaemonSet %s has pulled the images on %d/%d nodes", existingDaemonSet.Name,
+					existingDaemonSet.Status.NumberReady, existingDaemonSet.Status.DesiredNumberScheduled),
+			}, nil
+		}
+		if existingDaemonSet.Status.DesiredNumberScheduled == 0 {
+			unscheduledDaemonSets = append(unscheduledDaemonSets, existingDaemonSet.Name)
+		}
+	}
+	message := "The images are pulled on all the nodes that the Ray Pods can be scheduled on"
+	// The DaemonSets that aren't scheduled on any node don't block the condition, but they are reported, since the
+	// images of their groups are pulled when the Ray Pods start.
+	if len(unscheduledDaemonSets) > 0 {
+		message += fmt.Sprintf("; DaemonSets %s are not scheduled on any node", strings.Join(unscheduledDaemonSets, ", "))
+	}
+	return &metav1.Condition{
+		Type:    string(rayv1.RayClusterImagesPrePulled),
+		Status:  metav1.ConditionTrue,
+		Reason:  rayv1.AllImagesPrePulled,
+		Message: message,
+	}, nil
+}
+
+// createVolumeClaims creates the PersistentVolumeClaims of a Pod that don't exist yet. The RayCluster owns them if they
+// are deleted with it. Existing claims are kept as they are, so that a Pod that replaces another one mounts its data.
+func (r *RayClusterReconciler) createVolumeClaims(ctx context.Context, instance *rayv1.RayCluster, claims []*corev1.PersistentVolumeClaim) error {
+	logger := ctrl.LoggerFrom(ctx)
+	whenDeleted := rayv1.VolumeClaimRetentionPolicyRetain
+	if policy := instance.Spec.VolumeClaimRetentionPolicy; policy != nil && policy.WhenDeleted != "" {
+		whenDeleted = policy.WhenDeleted
+	}
+
+	for _, claim := range claims {
+		existingClaim := &corev1.PersistentVolumeClaim{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: claim.Namespace, Name: claim.Name}, existingClaim)
+		if err == nil {
+			// A Pod that mounts a claim being deleted can't start once the claim is gone, so wait until it is deleted.
+			if existingClaim.DeletionTimestamp != nil {
+				return fmt.Errorf("PersistentVolumeClaim %s/%s is being deleted", claim.Namespace, claim.Name)
+			}
+			continue
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if whenDeleted == rayv1.VolumeClaimRetentionPolicyDelete {
+			if err := controllerutil.SetControllerReference(instance, claim, r.Scheme); err != nil {
+				return err
+			}
+		}
+		if err := r.Create(ctx, claim); err != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToCreatePersistentVolumeClaim),
+				"Failed creating PersistentVolumeClaim %s/%s, %v", claim.Namespace, claim.Name, err)
+			return err
+		}
+		logger.Info("Created PersistentVolumeClaim", "name", claim.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.CreatedPersistentVolumeClaim),
+			"Created PersistentVolumeClaim %s/%s", claim.Namespace, claim.Name)
+	}
+	return nil
+}
+
+// reconcileVolumeClaims deletes the PersistentVolumeClaims of the worker groups that are no longer needed if the
+// RayCluster's retention policy deletes them when scaled. A claim is no longer needed if no Pod mounts it and its
+// replica index is beyond the desired replicas of its worker group, or its worker group or volume claim template was
+// removed. The claims of suspended RayClusters and worker groups are retained.
+func (r *RayClusterReconciler) reconcileVolumeClaims(ctx context.Context, instance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+	if err := r.reconcileVolumeClaimOwnerReferences(ctx, instance); err != nil {
+		return err
+	}
+	policy := instance.Spec.VolumeClaimRetentionPolicy
+	if policy == nil || policy.WhenScaled != rayv1.VolumeClaimRetentionPolicyDelete || ptr.Deref(instance.Spec.Suspend, false) {
+		return nil
+	}
+
+	claims := corev1.PersistentVolumeClaimList{}
+	if err := r.List(ctx, &claims, common.RayClusterWorkerVolumeClaimsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return err
+	}
+	if len(claims.Items) == 0 {
+		return nil
+	}
+	pods := corev1.PodList{}
+	if err := r.List(ctx, &pods, common.RayClusterAllPodsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return err
+	}
+	mountedClaims := map[string]bool{}
+	for _, pod := range pods.Items {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				mountedClaims[volume.PersistentVolumeClaim.ClaimName] = true
+			}
+		}
+	}
+	workerGroups := map[string]rayv1.WorkerGroupSpec{}
+	for _, worker := range instance.Spec.WorkerGroupSpecs {
+		workerGroups[worker.GroupName] = worker
+	}
+
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if mountedClaims[claim.Name] || claim.DeletionTimestamp != nil {
+			continue
+		}
+		if worker, ok := workerGroups[claim.Labels[utils.RayNodeGroupLabelKey]]; ok {
+			if ptr.Deref(worker.Suspend, false) {
+				continue
+			}
+			hasTemplate := slices.ContainsFunc(worker.VolumeClaimTemplates, func(template rayv1.VolumeClaimTemplate) bool {
+				return template.Name == claim.Labels[utils.RayVolumeClaimTemplateLabelKey]
+			})
+			replicaIndex, err := strconv.Atoi(claim.Labels[utils.RayWorkerReplicaIndexKey])
+			if err != nil {
+				continue
+			}
+			desiredReplicas := int(utils.GetWorkerGroupDesiredReplicas(worker) / max(worker.NumOfHosts, 1))
+			if hasTemplate && replicaIndex < desiredReplicas {
+				continue
+			}
+		}
+
+		if err := r.Delete(ctx, claim); client.IgnoreNotFound(err) != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeletePersistentVolumeClaim),
+				"Failed deleting PersistentVolumeClaim %s/%s, %v", claim.Namespace, claim.Name, err)
+			return err
+		}
+		logger.Info("Deleted PersistentVolumeClaim", "name", claim.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedPersistentVolumeClaim),
+			"Deleted PersistentVolumeClaim %s/%s", claim.Namespace, claim.Name)
+	}
+	return nil
+}
+
+// reconcileVolumeClaimOwnerReferences makes the RayCluster own the existing PersistentVolumeClaims of its Pods if its
+// retention policy deletes them with it, and releases them otherwise, so that changes to the policy apply to the claims
+// that were already created.
+func (r *RayClusterReconciler) reconcileVolumeClaimOwnerReferences(ctx context.Context, instance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+	owned := false
+	if policy := instance.Spec.VolumeClaimRetentionPolicy; policy != nil {
+		owned = policy.WhenDeleted == rayv1.VolumeClaimRetentionPolicyDelete
+	}
+
+	claims := corev1.PersistentVolumeClaimList{}
+	if err := r.List(ctx, &claims, common.RayClusterVolumeClaimsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return err
+	}
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if claim.DeletionTimestamp != nil {
+			continue
+		}
+		controllerRef := metav1.GetControllerOf(claim)
+		isOwner := controllerRef != nil && controllerRef.UID == instance.UID
+		switch {
+		case owned && controllerRef == nil:
+			if err := controllerutil.SetControllerReference(instance, claim, r.Scheme); err != nil {
+				return err
+			}
+		case !owned && isOwner:
+			if err := controllerutil.RemoveControllerReference(instance, claim, r.Scheme); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+		if err := r.Update(ctx, claim); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		logger.Info("Updated the owner references of PersistentVolumeClaim", "name", claim.Name, "ownedByRayCluster", owned)
+	}
+	return nil
+}
+
 func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv1.RayCluster) error {
 	logger := ctrl.LoggerFrom(ctx)
 
@@ -669,6 +1710,11 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 		return nil
 	}
 
+	// Recreate the Pods that still use a rotated auth token.
+	if deleted, err := r.rollOutAuthTokenRotation(ctx, instance); err != nil || deleted {
+		return err
+	}
+
 	// check if all the pods exist
 	headPods := corev1.PodList{}
 	if err := r.List(ctx, &headPods, common.RayClusterHeadPodsAssociationOptions(instance).ToListOptions()...); err != nil {
@@ -747,7 +1793,9 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 		return fmt.Errorf("%d head pods found %v. Please delete extra head pods", len(headPods.Items), headPodNames)
 	}
 
-	// Reconcile worker pods now
+	// Reconcile worker pods now. A worker group whose batch scheduler gang can't be resized isn't scaled, but the
+	// other worker groups are still reconciled.
+	var resizeErrs []error
 	for _, worker := range instance.Spec.WorkerGroupSpecs {
 		if !r.rayClusterScaleExpectation.IsSatisfied(ctx, instance.Namespace, instance.Name, worker.GroupName) {
 			logger.Info("reconcilePods", "worker group", worker.GroupName, "Expectation", "NotSatisfiedGroupExpectations, reconcile the group later")
@@ -777,6 +1825,10 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 		isRayMultiHostIndexing := worker.NumOfHosts > 1 && features.Enabled(features.RayMultiHostIndexing)
 		if isRayMultiHostIndexing {
 			if err := r.reconcileMultiHostWorkerGroup(ctx, instance, &worker, workerPods.Items); err != nil {
+				if errstd.Is(err, utils.ErrFailedResizeBatchSchedulerGang) {
+					resizeErrs = append(resizeErrs, err)
+					continue
+				}
 				return err
 			}
 			// Skip to the next worker as we've already handled multi-host reconciliation.
@@ -850,6 +1902,13 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 
 		logger.Info("reconcilePods", "workerReplicas", numExpectedWorkerPods, "NumOfHosts", worker.NumOfHosts, "runningPods", len(runningPods.Items), "diff", diff)
 
+		if diff != 0 {
+			if err := r.resizeBatchSchedulerGang(ctx, instance, worker); err != nil {
+				resizeErrs = append(resizeErrs, err)
+				continue
+			}
+		}
+
 		// Support replica indices for single-host, multi-slice environments.
 		validReplicaIndices := make(map[int]bool)
 		if features.Enabled(features.RayMultiHostIndexing) {
@@ -931,7 +1990,7 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			}
 		}
 	}
-	return nil
+	return errstd.Join(resizeErrs...)
 }
 
 // deletePods is a helper function to handle the deletion of a list of Pods, setting scale expectations
@@ -955,11 +2014,31 @@ func (r *RayClusterReconciler) deletePods(ctx context.Context, instance *rayv1.R
 	return nil
 }
 
+// resizeBatchSchedulerGang lets the batch scheduler resize the gang of the RayCluster before the Pods of a worker
+// group are created or deleted. The worker group isn't scaled if the batch scheduler fails to resize the gang.
+func (r *RayClusterReconciler) resizeBatchSchedulerGang(ctx context.Context, instance *rayv1.RayCluster, worker rayv1.WorkerGroupSpec) error {
+	if r.options.BatchSchedulerManager == nil {
+		return nil
+	}
+	scheduler, err := r.options.BatchSchedulerManager.GetScheduler()
+	if err != nil {
+		return err
+	}
+	desiredPods := utils.GetWorkerGroupDesiredReplicas(worker)
+	if err := scheduler.OnWorkerGroupReplicasChange(ctx, instance, worker.GroupName, desiredPods); err != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToResizeBatchSchedulerGang),
+			"Failed to resize the %s gang of worker group %s to %d Pods: %v", scheduler.Name(), worker.GroupName, desiredPods, err)
+		return errstd.Join(utils.ErrFailedResizeBatchSchedulerGang, err)
+	}
+	return nil
+}
+
 // reconcileMultiHostWorkerGroup handles reconciliation and Pod deletion for worker groups with NumOfHosts > 1 when
 // the RayMultihostIndexing feature is enabled. This function is responsible for:
 // 1. Deleting incomplete or unhealthy multi-host groups atomically.
-// 2. Explicit deletes of entire multi-host groups for the autoscaler.
-// 3. Scale up/down of multi-host groups.
+// 2. Recreating multi-host groups whose hosts are split across topology domains.
+// 3. Explicit deletes of entire multi-host groups for the autoscaler.
+// 4. Scale up/down of multi-host groups.
 func (r *RayClusterReconciler) reconcileMultiHostWorkerGroup(ctx context.Context, instance *rayv1.RayCluster, worker *rayv1.WorkerGroupSpec, workerPods []corev1.Pod) error {
 	logger := ctrl.LoggerFrom(ctx)
 
@@ -1006,7 +2085,31 @@ func (r *RayClusterReconciler) reconcileMultiHostWorkerGroup(ctx context.Context
 		}
 	}
 
-	// 4. Handle explicit deletions from the autoscaler.
+	// 4. Recreate replica groups whose hosts are scheduled onto more than one topology domain.
+	if worker.Topology != nil {
+		for replicaName, podList := range replicaMap {
+			if slices.ContainsFunc(podList, func(pod corev1.Pod) bool { _, ok := deletedPods[pod.Name]; return ok }) {
+				continue
+			}
+			domains, err := r.getTopologyDomains(ctx, podList, worker.Topology.RequiredTopologyKey)
+			if err != nil {
+				return err
+			}
+			if len(domains) <= 1 {
+				continue
+			}
+			reason := fmt.Sprintf("hosts of the replica are split across the %s topology domains %v", worker.Topology.RequiredTopologyKey, domains)
+			logger.Info("Deleting replica group split across topology domains.", "group", worker.GroupName, "replica", replicaName, "topologyKey", worker.Topology.RequiredTopologyKey, "domains", domains)
+			if err := r.deletePods(ctx, instance, podList, worker.GroupName, reason); err != nil {
+				return err
+			}
+			for _, p := range podList {
+				deletedPods[p.Name] = struct{}{}
+			}
+		}
+	}
+
+	// 5. Handle explicit deletions from the autoscaler.
 	if len(worker.ScaleStrategy.WorkersToDelete) > 0 {
 		podsToDeleteFromStrategy := make(map[string]corev1.Pod)
 		for _, podName := range worker.ScaleStrategy.WorkersToDelete {
@@ -1037,7 +2140,7 @@ func (r *RayClusterReconciler) reconcileMultiHostWorkerGroup(ctx context.Context
 		worker.ScaleStrategy.WorkersToDelete = []string{}
 	}
 
-	// 5. Calculate Pod diff for scaling up or down by NumOfHosts.
+	// 6. Calculate Pod diff for scaling up or down by NumOfHosts.
 
 	validReplicaGroups := make(map[string]struct{})
 	for replicaName, podList := range replicaMap {
@@ -1062,6 +2165,11 @@ func (r *RayClusterReconciler) reconcileMultiHostWorkerGroup(ctx context.Context
 	}
 	numExpectedReplicas := numExpectedWorkerPods / int(worker.NumOfHosts)
 	replicasToCreate := numExpectedReplicas - numRunningReplicas
+	if replicasToCreate != 0 {
+		if err := r.resizeBatchSchedulerGang(ctx, instance, *worker); err != nil {
+			return err
+		}
+	}
 
 	// Track full replica groups to determine next replica index to assign to.
 	validReplicaIndices := make(map[int]bool)
@@ -1124,10 +2232,98 @@ func (r *RayClusterReconciler) reconcileMultiHostWorkerGroup(ctx context.Context
 	return nil
 }
 
+// getTopologyDomains returns the sorted values of the topology key on the nodes that the Pods are scheduled onto.
+// Pods that aren't scheduled yet, or whose nodes no longer exist, are skipped. Only the metadata of the nodes is read,
+// so that the cache doesn't hold the full Node objects of the Kubernetes cluster.
+func (r *RayClusterReconciler) getTopologyDomains(ctx context.Context, pods []corev1.Pod, topologyKey string) ([]string, error) {
+	domains := make(map[string]struct{})
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		node := &metav1.PartialObjectMetadata{}
+		node.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Node"))
+		if err := r.Get(ctx, client.ObjectKey{Name: pod.Spec.NodeName}, node); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		domains[node.Labels[topologyKey]] = struct{}{}
+	}
+	return slices.Sorted(maps.Keys(domains)), nil
+}
+
 func shouldSkipHeadPodRestart(instance *rayv1.RayCluster) bool {
 	return instance.Annotations[utils.DisableProvisionedHeadRestartAnnotationKey] == "true"
 }
 
+// rollOutAuthTokenRotation recreates the Pods that were created before the latest auth token rotation. Ray accepts a
+// single token, so the Pods can't be recreated one group at a time without splitting the cluster. Instead, all the stale
+// Pods are deleted in one step, the head Pod first. This interrupts the workloads running on the RayCluster. It returns
+// whether any Pod was deleted.
+func (r *RayClusterReconciler) rollOutAuthTokenRotation(ctx context.Context, instance *rayv1.RayCluster) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	authToken := instance.Status.AuthToken
+	if authToken == nil || authToken.LastRotationTime == nil {
+		return false, nil
+	}
+
+	groupFilters := []common.AssociationOptions{common.RayClusterHeadPodsAssociationOptions(instance)}
+	for _, worker := range instance.Spec.WorkerGroupSpecs {
+		groupFilters = append(groupFilters, common.RayClusterGroupPodsAssociationOptions(instance, worker.GroupName))
+	}
+
+	var stalePods []corev1.Pod
+	for _, filters := range groupFilters {
+		pods := corev1.PodList{}
+		if err := r.List(ctx, &pods, filters.ToListOptions()...); err != nil {
+			return false, err
+		}
+		for _, pod := range pods.Items {
+			if pod.DeletionTimestamp.IsZero() && usesPreviousAuthToken(&pod, authToken.LastRotationTime.Time) {
+				stalePods = append(stalePods, pod)
+			}
+		}
+	}
+	if len(stalePods) == 0 {
+		return false, nil
+	}
+
+	logger.Info("Auth token has been rotated, deleting the Pods created with the previous token", "Number of Pods", len(stalePods))
+	for _, pod := range stalePods {
+		if err := r.Delete(ctx, &pod); client.IgnoreNotFound(err) != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeletePod),
+				"Failed deleting Pod %s/%s due to auth token rotation, %v", pod.Namespace, pod.Name, err)
+			return false, err
+		}
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedPod),
+			"Deleted Pod %s/%s due to auth token rotation", pod.Namespace, pod.Name)
+	}
+	r.rayClusterScaleExpectation.Delete(instance.Name, instance.Namespace)
+	return true, nil
+}
+
+// hasPodsWithPreviousAuthToken returns whether any Pod of the RayCluster, including a terminating one, was created
+// before the auth token rotation at rotationTime and may still use the previous token.
+func (r *RayClusterReconciler) hasPodsWithPreviousAuthToken(ctx context.Context, instance *rayv1.RayCluster, rotationTime time.Time) (bool, error) {
+	pods := corev1.PodList{}
+	if err := r.List(ctx, &pods, common.RayClusterAllPodsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return false, err
+	}
+	for _, pod := range pods.Items {
+		if usesPreviousAuthToken(&pod, rotationTime) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// usesPreviousAuthToken returns whether the Pod was created before the auth token rotation at rotationTime.
+func usesPreviousAuthToken(pod *corev1.Pod, rotationTime time.Time) bool {
+	return pod.Annotations[utils.RayAuthTokenRotationTimeAnnotationKey] != rotationTime.UTC().Format(time.RFC3339)
+}
+
 // shouldRecreatePodsForUpgrade checks if any pods need to be recreated based on RayClusterSpec changes
 func (r *RayClusterReconciler) shouldRecreatePodsForUpgrade(ctx context.Context, instance *rayv1.RayCluster) bool {
 	logger := ctrl.LoggerFrom(ctx)
@@ -1326,6 +2522,12 @@ func (r *RayClusterReconciler) createHeadPod(ctx context.Context, instance rayv1
 		}
 	}
 
+	// Create the PersistentVolumeClaims of the head Pod before the Pod that mounts them.
+	volumeClaims := common.BuildHeadVolumeClaims(&instance)
+	if err := r.createVolumeClaims(ctx, &instance, volumeClaims); err != nil {
+		return err
+	}
+	common.AddVolumeClaimVolumes(&pod.Spec, volumeClaims)
 	if err := r.Create(ctx, &pod); err != nil {
 		r.Recorder.Eventf(&instance, corev1.EventTypeWarning, string(utils.FailedToCreateHeadPod), "Failed to create head Pod %s/%s, %v", pod.Namespace, pod.Name, err)
 		return err
@@ -1372,6 +2574,12 @@ func (r *RayClusterReconciler) createWorkerPodWithIndex(ctx context.Context, ins
 			return err
 		}
 	}
+	// Create the PersistentVolumeClaims of the worker Pod before the Pod that mounts them.
+	volumeClaims := common.BuildWorkerVolumeClaims(&instance, worker, replicaIndex, hostIndex)
+	if err := r.createVolumeClaims(ctx, &instance, volumeClaims); err != nil {
+		return err
+	}
+	common.AddVolumeClaimVolumes(&pod.Spec, volumeClaims)
 
 	replica := pod
 	if err := r.Create(ctx, &replica); err != nil {
@@ -1399,7 +2607,8 @@ func (r *RayClusterReconciler) buildHeadPod(ctx context.Context, instance rayv1.
 	}
 	logger.Info("head pod labels", "labels", podConf.Labels)
 	creatorCRDType := getCreatorCRDType(instance)
-	pod := common.BuildPod(ctx, podConf, rayv1.HeadNode, instance.Spec.HeadGroupSpec.RayStartParams, headPort, autoscalingEnabled, creatorCRDType, fqdnRayIP, r.options.DefaultContainerEnvs, instance.Spec.RayVersion)
+	rayStartParams := r.withClaimedAcceleratorResources(ctx, instance.Namespace, podConf.Spec, instance.Spec.HeadGroupSpec.RayStartParams)
+	pod := common.BuildPod(ctx, podConf, rayv1.HeadNode, rayStartParams, headPort, autoscalingEnabled, creatorCRDType, fqdnRayIP, r.options.DefaultContainerEnvs, instance.Spec.RayVersion, r.options.Accelerators)
 	// Set raycluster instance as the owner and controller
 	if err := controllerutil.SetControllerReference(&instance, &pod, r.Scheme); err != nil {
 		logger.Error(err, "Failed to set controller reference for raycluster pod")
@@ -1408,6 +2617,83 @@ func (r *RayClusterReconciler) buildHeadPod(ctx context.Context, instance rayv1.
 	return pod
 }
 
+// withClaimedAcceleratorResources returns rayStartParams with the GPUs and custom accelerators that the Ray container
+// claims through ResourceClaimTemplates added, if they're not already present. rayStartParams isn't modified, so the
+// claimed accelerators are only added to the Pods, and the RayCluster spec is never changed.
+func (r *RayClusterReconciler) withClaimedAcceleratorResources(ctx context.Context, namespace string, podSpec corev1.PodSpec, rayStartParams map[string]string) map[string]string {
+	logger := ctrl.LoggerFrom(ctx)
+	if len(utils.GetResourceClaimTemplateNames(podSpec)) == 0 {
+		return rayStartParams
+	}
+	templates, err := r.getResourceClaimTemplates(ctx, namespace, podSpec)
+	if err != nil {
+		logger.Error(err, "Failed to get the ResourceClaimTemplates of the Ray container, the claimed accelerators are not added to rayStartParams")
+		return rayStartParams
+	}
+
+	rayStartParams = maps.Clone(rayStartParams)
+	if rayStartParams == nil {
+		rayStartParams = map[string]string{}
+	}
+	claimedResources := utils.CalculateClaimedResources(podSpec, templates, r.deviceClassResourceNames())
+	if err := common.AddClaimedAcceleratorResources(rayStartParams, claimedResources, r.options.Accelerators); err != nil {
+		logger.Error(err, "Failed to add the claimed accelerators to rayStartParams")
+	}
+	return rayStartParams
+}
+
+// getResourceClaimTemplates returns the ResourceClaimTemplates used by the Ray containers of the Pods, keyed by name.
+// Templates that don't exist are skipped, since the Pods that use them can't be created anyway.
+func (r *RayClusterReconciler) getResourceClaimTemplates(ctx context.Context, namespace string, podSpecs ...corev1.PodSpec) (map[string]*resourcev1.ResourceClaimTemplate, error) {
+	templates := map[string]*resourcev1.ResourceClaimTemplate{}
+	for _, podSpec := range podSpecs {
+		for _, name := range utils.GetResourceClaimTemplateNames(podSpec) {
+			if _, ok := templates[name]; ok {
+				continue
+			}
+			template := &resourcev1.ResourceClaimTemplate{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, template); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			templates[name] = template
+		}
+	}
+	return templates, nil
+}
+
+// deviceClassResourceNames returns the mapping of DRA device classes to extended resource names.
+func (r *RayClusterReconciler) deviceClassResourceNames() map[string]corev1.ResourceName {
+	if r.options.DeviceClassResourceNames == nil {
+		return utils.DefaultDeviceClassResourceNames
+	}
+	return r.options.DeviceClassResourceNames
+}
+
+// indexResourceClaimTemplates indexes a RayCluster by the names of the ResourceClaimTemplates that its Ray containers use.
+func indexResourceClaimTemplates(obj client.Object) []string {
+	return utils.GetRayClusterResourceClaimTemplateNames(obj.(*rayv1.RayCluster))
+}
+
+// rayClustersForResourceClaimTemplate maps a ResourceClaimTemplate to the RayClusters in the same namespace whose Ray
+// containers use it, so that the claimed accelerators are updated when it changes.
+func (r *RayClusterReconciler) rayClustersForResourceClaimTemplate(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := ctrl.LoggerFrom(ctx)
+	rayClusters := &rayv1.RayClusterList{}
+	if err := r.List(ctx, rayClusters, client.InNamespace(obj.GetNamespace()), client.MatchingFields{resourceClaimTemplateIndexKey: obj.GetName()}); err != nil {
+		logger.Error(err, "Failed to list RayClusters", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(rayClusters.Items))
+	for i := range rayClusters.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rayClusters.Items[i])})
+	}
+	return requests
+}
+
 func getCreatorCRDType(instance rayv1.RayCluster) utils.CRDType {
 	return utils.GetCRDType(instance.Labels[utils.RayOriginatedFromCRDLabelKey])
 }
@@ -1426,7 +2712,8 @@ func (r *RayClusterReconciler) buildWorkerPod(ctx context.Context, instance rayv
 		podTemplateSpec.Spec.Containers = append(podTemplateSpec.Spec.Containers, r.options.WorkerSidecarContainers...)
 	}
 	creatorCRDType := getCreatorCRDType(instance)
-	pod := common.BuildPod(ctx, podTemplateSpec, rayv1.WorkerNode, worker.RayStartParams, headPort, autoscalingEnabled, creatorCRDType, fqdnRayIP, r.options.DefaultContainerEnvs, instance.Spec.RayVersion)
+	rayStartParams := r.withClaimedAcceleratorResources(ctx, instance.Namespace, podTemplateSpec.Spec, worker.RayStartParams)
+	pod := common.BuildPod(ctx, podTemplateSpec, rayv1.WorkerNode, rayStartParams, headPort, autoscalingEnabled, creatorCRDType, fqdnRayIP, r.options.DefaultContainerEnvs, instance.Spec.RayVersion, r.options.Accelerators)
 	// Set raycluster instance as the owner and controller
 	if err := controllerutil.SetControllerReference(&instance, &pod, r.Scheme); err != nil {
 		logger.Error(err, "Failed to set controller reference for raycluster pod")
@@ -1435,49 +2722,25 @@ func (r *RayClusterReconciler) buildWorkerPod(ctx context.Context, instance rayv
 	return pod
 }
 
-func (r *RayClusterReconciler) buildRedisCleanupJob(ctx context.Context, instance rayv1.RayCluster) batchv1.Job {
+// buildGcsStorageCleanupJob builds the Job that deletes the RayCluster's storage namespace from the GCS FT storage backend.
+// The Job keeps the `redis-cleanup` name suffix and node type label so that Jobs created by older KubeRay versions are still found.
+func (r *RayClusterReconciler) buildGcsStorageCleanupJob(ctx context.Context, instance rayv1.RayCluster, backend common.GcsStorageBackend) batchv1.Job {
 	logger := ctrl.LoggerFrom(ctx)
 
 	// Build the head pod
 	pod := r.buildHeadPod(ctx, instance)
 	pod.Labels[utils.RayNodeTypeLabelKey] = string(rayv1.RedisCleanupNode)
 
-	// Only keep the Ray container in the Redis cleanup Job.
+	// Only keep the Ray container in the cleanup Job.
 	pod.Spec.Containers = []corev1.Container{pod.Spec.Containers[utils.RayContainerIndex]}
-	pod.Spec.Containers[utils.RayContainerIndex].Command = utils.GetContainerCommand([]string{})
-	pod.Spec.Containers[utils.RayContainerIndex].Args = []string{
-		"echo \"To get more information about manually deleting the storage namespace in Redis and removing the RayCluster's finalizer, please check https://docs.ray.io/en/master/cluster/kubernetes/user-guides/kuberay-gcs-ft.html for more details.\" && " +
-			"python -c " +
-			"\"from ray._private.gcs_utils import cleanup_redis_storage; " +
-			"from urllib.parse import urlparse; " +
-			"import os; " +
-			"import sys; " +
-			"redis_address = os.getenv('RAY_REDIS_ADDRESS', '').split(',')[0]; " +
-			"redis_address = redis_address if '://' in redis_address else 'redis://' + redis_address; " +
-			"parsed = urlparse(redis_address); ",
-	}
-	if utils.EnvVarExists(utils.REDIS_USERNAME, pod.Spec.Containers[utils.RayContainerIndex].Env) {
-		pod.Spec.Containers[utils.RayContainerIndex].Args[0] += "sys.exit(1) if not cleanup_redis_storage(host=parsed.hostname, port=parsed.port, username=os.getenv('REDIS_USERNAME', parsed.username), password=os.getenv('REDIS_PASSWORD', parsed.password or ''), use_ssl=parsed.scheme=='rediss', storage_namespace=os.getenv('RAY_external_storage_namespace')) else None\""
-	} else {
-		pod.Spec.Containers[utils.RayContainerIndex].Args[0] += "sys.exit(1) if not cleanup_redis_storage(host=parsed.hostname, port=parsed.port, password=os.getenv('REDIS_PASSWORD', parsed.password or ''), use_ssl=parsed.scheme=='rediss', storage_namespace=os.getenv('RAY_external_storage_namespace')) else None\""
-	}
+	backend.ConfigureCleanupContainer(&pod.Spec.Containers[utils.RayContainerIndex])
 
 	// Disable liveness and readiness probes because the Job will not launch processes like Raylet and GCS.
 	pod.Spec.Containers[utils.RayContainerIndex].LivenessProbe = nil
 	pod.Spec.Containers[utils.RayContainerIndex].ReadinessProbe = nil
 
-	// Set the environment variables to ensure that the cleanup Job has at least 60s.
-	pod.Spec.Containers[utils.RayContainerIndex].Env = append(pod.Spec.Containers[utils.RayContainerIndex].Env, corev1.EnvVar{
-		Name:  "RAY_redis_db_connect_retries",
-		Value: "120",
-	})
-	pod.Spec.Containers[utils.RayContainerIndex].Env = append(pod.Spec.Containers[utils.RayContainerIndex].Env, corev1.EnvVar{
-		Name:  "RAY_redis_db_connect_wait_milliseconds",
-		Value: "500",
-	})
-
 	// The container's resource consumption remains constant. Hard-coding the resources is acceptable.
-	// Avoid using the GPU for the Redis cleanup Job.
+	// Avoid using the GPU for the cleanup Job.
 	pod.Spec.Containers[utils.RayContainerIndex].Resources = corev1.ResourceRequirements{
 		Limits: corev1.ResourceList{
 			corev1.ResourceCPU:    resource.MustParse("200m"),
@@ -1530,11 +2793,26 @@ func (r *RayClusterReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcu
 		))).
 		Owns(&corev1.Pod{}).
 		Owns(&corev1.Service{}).
-		Owns(&corev1.Secret{})
+		Owns(&corev1.Secret{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&appsv1.DaemonSet{})
 	if r.options.BatchSchedulerManager != nil {
 		r.options.BatchSchedulerManager.ConfigureReconciler(b)
 	}
 
+	// ResourceClaimTemplates are only watched if the resource.k8s.io/v1 API is served, which requires Kubernetes 1.34+.
+	resourceClaimTemplateGVK := resourcev1.SchemeGroupVersion.WithKind("ResourceClaimTemplate")
+	if _, err := mgr.GetRESTMapper().RESTMapping(resourceClaimTemplateGVK.GroupKind(), resourceClaimTemplateGVK.Version); err == nil {
+		if err := mgr.GetFieldIndexer().IndexField(context.Background(), &rayv1.RayCluster{}, resourceClaimTemplateIndexKey, indexResourceClaimTemplates); err != nil {
+			return err
+		}
+		b = b.Watches(&resourcev1.ResourceClaimTemplate{}, handler.EnqueueRequestsFromMapFunc(r.rayClustersForResourceClaimTemplate))
+	} else if !meta.IsNoMatchError(err) {
+		return err
+	}
+
 	return b.
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: reconcileConcurrency,
@@ -1589,12 +2867,19 @@ func (r *RayClusterReconciler) calculateStatus(ctx context.Context, instance *ra
 	newInstance.Status.DesiredWorkerReplicas = utils.CalculateDesiredReplicas(newInstance)
 	newInstance.Status.MinWorkerReplicas = utils.CalculateMinReplicas(newInstance)
 	newInstance.Status.MaxWorkerReplicas = utils.CalculateMaxReplicas(newInstance)
+	newInstance.Status.WorkerGroupStatuses = utils.CalculateWorkerGroupStatuses(newInstance, runtimePods, instance.Status.WorkerGroupStatuses, metav1.Now())
 
 	totalResources := utils.CalculateDesiredResources(newInstance)
+	if claimedResources, err := r.calculateDesiredClaimedResources(ctx, newInstance); err != nil {
+		logger.Error(err, "Failed to calculate the devices claimed by the RayCluster")
+	} else {
+		totalResources = utils.SumResourceList([]corev1.ResourceList{totalResources, claimedResources})
+	}
 	newInstance.Status.DesiredCPU = totalResources[corev1.ResourceCPU]
 	newInstance.Status.DesiredMemory = totalResources[corev1.ResourceMemory]
-	newInstance.Status.DesiredGPU = sumGPUs(totalResources)
-	newInstance.Status.DesiredTPU = totalResources[corev1.ResourceName("google.com/tpu")]
+	newInstance.Status.DesiredGPU = sumAccelerators(totalResources, utils.AcceleratorTypeGPU, r.options.Accelerators)
+	newInstance.Status.DesiredTPU = sumAccelerators(totalResources, utils.AcceleratorTypeTPU, r.options.Accelerators)
+	newInstance.Status.DesiredAccelerators = sumAcceleratorsByRayResource(totalResources, r.options.Accelerators)
 
 	if reconcileErr == nil && len(runtimePods.Items) == int(newInstance.Status.DesiredWorkerReplicas)+1 { // workers + 1 head
 		if utils.CheckAllPodsRunning(ctx, runtimePods) {
@@ -1622,6 +2907,16 @@ func (r *RayClusterReconciler) calculateStatus(ctx context.Context, instance *ra
 			meta.SetStatusCondition(&newInstance.Status.Conditions, headPodReadyCondition)
 		}
 
+		imagePrePullCondition, err := r.calculateImagePrePullCondition(ctx, newInstance)
+		if err != nil {
+			return nil, err
+		}
+		if imagePrePullCondition != nil {
+			meta.SetStatusCondition(&newInstance.Status.Conditions, *imagePrePullCondition)
+		} else {
+			meta.RemoveStatusCondition(&newInstance.Status.Conditions, string(rayv1.RayClusterImagesPrePulled))
+		}
+
 		suspendStatus := utils.FindRayClusterSuspendStatus(newInstance)
 		if !meta.IsStatusConditionTrue(newInstance.Status.Conditions, string(rayv1.RayClusterProvisioned)) && suspendStatus != rayv1.RayClusterSuspended {
 			// RayClusterProvisioned indicates whether all Ray Pods are ready when the RayCluster is first created.
@@ -1987,17 +3282,52 @@ func cleanUpRayClusterMetrics(rayClusterMetricsManager *metrics.RayClusterMetric
 	rayClusterMetricsManager.DeleteRayClusterMetrics(clusterName, namespace)
 }
 
-// sumGPUs sums the GPUs in the given resource list.
-func sumGPUs(resources map[corev1.ResourceName]resource.Quantity) resource.Quantity {
-	totalGPUs := resource.Quantity{}
+// calculateDesiredClaimedResources returns the devices claimed through ResourceClaimTemplates by the desired Pods of
+// the RayCluster, counted as extended resources.
+func (r *RayClusterReconciler) calculateDesiredClaimedResources(ctx context.Context, instance *rayv1.RayCluster) (corev1.ResourceList, error) {
+	podSpecs := []corev1.PodSpec{instance.Spec.HeadGroupSpec.Template.Spec}
+	for _, worker := range instance.Spec.WorkerGroupSpecs {
+		podSpecs = append(podSpecs, worker.Template.Spec)
+	}
+	templates, err := r.getResourceClaimTemplates(ctx, instance.Namespace, podSpecs...)
+	if err != nil {
+		return nil, err
+	}
+	return utils.CalculateDesiredClaimedResources(instance, templates, r.deviceClassResourceNames()), nil
+}
+
+// sumAccelerators sums the accelerators of the given type in the given resource list.
+func sumAccelerators(resources map[corev1.ResourceName]resource.Quantity, acceleratorType utils.AcceleratorType, accelerators utils.Accelerators) resource.Quantity {
+	total := resource.Quantity{}
 
 	for key, val := range resources {
-		if utils.IsGPUResourceKey(string(key)) && !val.IsZero() {
-			totalGPUs.Add(val)
+		if accelerator, ok := accelerators.Get(key); ok && accelerator.Type == acceleratorType && !val.IsZero() {
+			total.Add(val)
+		}
+	}
+
+	return total
+}
+
+// sumAcceleratorsByRayResource sums the accelerators in the given resource list by the Ray resources that they are
+// exported as. It returns nil if there are no accelerators.
+func sumAcceleratorsByRayResource(resources map[corev1.ResourceName]resource.Quantity, accelerators utils.Accelerators) map[string]resource.Quantity {
+	var totals map[string]resource.Quantity
+
+	for key, val := range resources {
+		accelerator, ok := accelerators.Get(key)
+		if !ok || val.IsZero() {
+			continue
+		}
+		if totals == nil {
+			totals = map[string]resource.Quantity{}
 		}
+		total := totals[accelerator.RayResourceName]
+		total.Add(val)
+		totals[accelerator.RayResourceName] = total
 	}
 
-	return totalGPUs
+	return totals
 }
 
 // setDefaults sets some default values for the RayCluster
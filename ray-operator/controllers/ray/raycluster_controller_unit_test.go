@@ -19,9 +19,11 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"maps"
 	"math"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -32,9 +34,13 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	resourcev1 "k8s.io/api/resource/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -52,12 +58,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/expectations"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/metrics/mocks"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
 	"github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/scheme"
 	"github.com/ray-project/kuberay/ray-operator/pkg/features"
 	"github.com/ray-project/kuberay/ray-operator/test/support"
@@ -1155,6 +1164,255 @@ func TestReconcileHeadlessService(t *testing.T) {
 	assert.Len(t, serviceList.Items, 1, "Service list len is wrong")
 }
 
+func TestReconcilePodDisruptionBudgets(t *testing.T) {
+	setupTest(t)
+
+	cluster := testRayCluster.DeepCopy()
+	cluster.UID = "raycluster-uid"
+	cluster.Spec.HeadGroupSpec.PodDisruptionBudget = &rayv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt32(1))}
+	cluster.Spec.WorkerGroupSpecs[0].PodDisruptionBudget = &rayv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromString("50%"))}
+	workerGroupName := cluster.Spec.WorkerGroupSpecs[0].GroupName
+
+	// A PodDisruptionBudget that is not owned by the RayCluster should not be touched.
+	userPDB := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "user-pdb",
+			Namespace: cluster.Namespace,
+			Labels: map[string]string{
+				utils.RayClusterLabelKey:          cluster.Name,
+				utils.KubernetesCreatedByLabelKey: utils.ComponentName,
+			},
+		},
+	}
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = policyv1.AddToScheme(newScheme)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster, userPDB).Build()
+	ctx := context.TODO()
+	r := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+
+	getPDB := func(groupName string) (*policyv1.PodDisruptionBudget, error) {
+		pdb := &policyv1.PodDisruptionBudget{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: cluster.Namespace, Name: utils.GeneratePodDisruptionBudgetName(cluster.Name, groupName)}, pdb)
+		return pdb, err
+	}
+
+	// Case 1: PodDisruptionBudgets are created for the head group and the worker group.
+	require.NoError(t, r.reconcilePodDisruptionBudgets(ctx, cluster))
+	headPDB, err := getPDB(utils.RayNodeHeadGroupLabelValue)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(intstr.FromInt32(1)), headPDB.Spec.MinAvailable)
+	assert.Equal(t, map[string]string{
+		utils.RayClusterLabelKey:  cluster.Name,
+		utils.RayNodeTypeLabelKey: string(rayv1.HeadNode),
+	}, headPDB.Spec.Selector.MatchLabels)
+	assert.True(t, metav1.IsControlledBy(headPDB, cluster))
+
+	workerPDB, err := getPDB(workerGroupName)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(intstr.FromString("50%")), workerPDB.Spec.MaxUnavailable)
+	assert.Equal(t, map[string]string{
+		utils.RayClusterLabelKey:   cluster.Name,
+		utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+		utils.RayNodeGroupLabelKey: workerGroupName,
+	}, workerPDB.Spec.Selector.MatchLabels)
+
+	// Case 2: The PodDisruptionBudget is updated when the worker group config changes.
+	cluster.Spec.WorkerGroupSpecs[0].PodDisruptionBudget.MaxUnavailable = ptr.To(intstr.FromInt32(2))
+	require.NoError(t, r.reconcilePodDisruptionBudgets(ctx, cluster))
+	workerPDB, err = getPDB(workerGroupName)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(intstr.FromInt32(2)), workerPDB.Spec.MaxUnavailable)
+
+	// Case 3: The PodDisruptionBudget is deleted when the worker group no longer configures one.
+	cluster.Spec.WorkerGroupSpecs[0].PodDisruptionBudget = nil
+	require.NoError(t, r.reconcilePodDisruptionBudgets(ctx, cluster))
+	_, err = getPDB(workerGroupName)
+	assert.True(t, k8serrors.IsNotFound(err))
+	_, err = getPDB(utils.RayNodeHeadGroupLabelValue)
+	require.NoError(t, err)
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(userPDB), &policyv1.PodDisruptionBudget{}))
+}
+
+func TestReconcileNetworkPolicy(t *testing.T) {
+	setupTest(t)
+
+	cluster := testRayCluster.DeepCopy()
+	cluster.UID = "raycluster-uid"
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = networkingv1.AddToScheme(newScheme)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster).Build()
+	ctx := context.TODO()
+	r := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+		options:  RayClusterReconcilerOptions{OperatorNamespace: "ray-system"},
+	}
+
+	getPolicy := func() (*networkingv1.NetworkPolicy, error) {
+		policy := &networkingv1.NetworkPolicy{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: cluster.Namespace, Name: utils.GenerateNetworkPolicyName(cluster.Name)}, policy)
+		return policy, err
+	}
+
+	// Case 1: No NetworkPolicy is created if network isolation is disabled.
+	require.NoError(t, r.reconcileNetworkPolicy(ctx, cluster))
+	_, err := getPolicy()
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// Case 2: The NetworkPolicy is created when network isolation is enabled.
+	cluster.Spec.NetworkIsolation = &rayv1.NetworkIsolation{}
+	require.NoError(t, r.reconcileNetworkPolicy(ctx, cluster))
+	policy, err := getPolicy()
+	require.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(policy, cluster))
+	assert.Equal(t, map[string]string{utils.RayClusterLabelKey: cluster.Name}, policy.Spec.PodSelector.MatchLabels)
+	assert.Len(t, policy.Spec.Ingress, 2)
+
+	// Case 3: The NetworkPolicy is updated when the ingress sources change.
+	cluster.Spec.NetworkIsolation.IngressFrom = []networkingv1.NetworkPolicyPeer{
+		{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}}},
+	}
+	require.NoError(t, r.reconcileNetworkPolicy(ctx, cluster))
+	policy, err = getPolicy()
+	require.NoError(t, err)
+	assert.Len(t, policy.Spec.Ingress, 3)
+
+	// Case 4: The NetworkPolicy is deleted when network isolation is disabled.
+	cluster.Spec.NetworkIsolation = nil
+	require.NoError(t, r.reconcileNetworkPolicy(ctx, cluster))
+	_, err = getPolicy()
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// Case 5: A NetworkPolicy with the same name that is not controlled by the RayCluster is not taken over.
+	userPolicy := common.BuildNetworkPolicy(cluster, "")
+	require.NoError(t, fakeClient.Create(ctx, userPolicy))
+	cluster.Spec.NetworkIsolation = &rayv1.NetworkIsolation{}
+	require.Error(t, r.reconcileNetworkPolicy(ctx, cluster))
+	policy, err = getPolicy()
+	require.NoError(t, err)
+	assert.Empty(t, policy.OwnerReferences)
+
+	// Case 6: No NetworkPolicy is created if the operator namespace is unknown, because it would block the operator.
+	require.NoError(t, fakeClient.Delete(ctx, userPolicy))
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+	r.options.OperatorNamespace = ""
+	require.NoError(t, r.reconcileNetworkPolicy(ctx, cluster))
+	_, err = getPolicy()
+	assert.True(t, k8serrors.IsNotFound(err))
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, string(utils.FailedToCreateNetworkPolicy))
+}
+
+func TestReconcileImagePrePull(t *testing.T) {
+	setupTest(t)
+
+	cluster := testRayCluster.DeepCopy()
+	cluster.UID = "raycluster-uid"
+	cluster.Spec.ImagePrePull = &rayv1.ImagePrePull{}
+	workerGroupName := cluster.Spec.WorkerGroupSpecs[0].GroupName
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = appsv1.AddToScheme(newScheme)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster).Build()
+	ctx := context.TODO()
+	r := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+
+	getDaemonSet := func(groupName string) (*appsv1.DaemonSet, error) {
+		daemonSet := &appsv1.DaemonSet{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: cluster.Namespace, Name: utils.GenerateImagePrePullDaemonSetName(cluster.Name, groupName)}, daemonSet)
+		return daemonSet, err
+	}
+
+	// Case 1: No DaemonSet exists yet, so the images are not pre-pulled.
+	condition, err := r.calculateImagePrePullCondition(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, rayv1.ImagesPrePulling, condition.Reason)
+
+	// Case 2: DaemonSets are created for the head group and the worker group.
+	require.NoError(t, r.reconcileImagePrePull(ctx, cluster))
+	_, err = getDaemonSet(utils.RayNodeHeadGroupLabelValue)
+	require.NoError(t, err)
+	workerDaemonSet, err := getDaemonSet(workerGroupName)
+	require.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(workerDaemonSet, cluster))
+	hash := workerDaemonSet.Annotations[utils.ImagePrePullHashKey]
+	assert.NotEmpty(t, hash)
+
+	// Case 3: The images are pre-pulled once all the DaemonSets are ready.
+	daemonSetList := appsv1.DaemonSetList{}
+	require.NoError(t, fakeClient.List(ctx, &daemonSetList))
+	for i := range daemonSetList.Items {
+		daemonSet := &daemonSetList.Items[i]
+		daemonSet.Status = appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberReady: 1}
+		require.NoError(t, fakeClient.Status().Update(ctx, daemonSet))
+	}
+	condition, err = r.calculateImagePrePullCondition(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+
+	for i := range daemonSetList.Items {
+		daemonSet := &daemonSetList.Items[i]
+		daemonSet.Status.NumberReady = 2
+		require.NoError(t, fakeClient.Status().Update(ctx, daemonSet))
+	}
+	condition, err = r.calculateImagePrePullCondition(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, rayv1.AllImagesPrePulled, condition.Reason)
+	assert.NotContains(t, condition.Message, "not scheduled")
+
+	// A DaemonSet that isn't scheduled on any node doesn't block the condition, but it's reported.
+	workerDaemonSet, err = getDaemonSet(workerGroupName)
+	require.NoError(t, err)
+	workerDaemonSet.Status = appsv1.DaemonSetStatus{}
+	require.NoError(t, fakeClient.Status().Update(ctx, workerDaemonSet))
+	condition, err = r.calculateImagePrePullCondition(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Contains(t, condition.Message, "DaemonSets "+workerDaemonSet.Name+" are not scheduled on any node")
+
+	// Case 4: The DaemonSet is updated when the images of the worker group change.
+	cluster.Spec.WorkerGroupSpecs[0].Template.Spec.Containers[0].Image = "rayproject/ray:nightly"
+	require.NoError(t, r.reconcileImagePrePull(ctx, cluster))
+	workerDaemonSet, err = getDaemonSet(workerGroupName)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, workerDaemonSet.Annotations[utils.ImagePrePullHashKey])
+	assert.Equal(t, "rayproject/ray:nightly", workerDaemonSet.Spec.Template.Spec.Containers[0].Image)
+
+	// Case 5: The DaemonSets are deleted when image pre-pulling is disabled.
+	cluster.Spec.ImagePrePull = nil
+	require.NoError(t, r.reconcileImagePrePull(ctx, cluster))
+	_, err = getDaemonSet(utils.RayNodeHeadGroupLabelValue)
+	assert.True(t, k8serrors.IsNotFound(err))
+	_, err = getDaemonSet(workerGroupName)
+	assert.True(t, k8serrors.IsNotFound(err))
+	condition, err = r.calculateImagePrePullCondition(ctx, cluster)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+}
+
 func getNotFailedPodItemNum(podList corev1.PodList) int {
 	count := 0
 	for _, aPod := range podList.Items {
@@ -2510,6 +2768,9 @@ func Test_RedisCleanupFeatureFlag(t *testing.T) {
 	newScheme := runtime.NewScheme()
 	_ = rayv1.AddToScheme(newScheme)
 	_ = corev1.AddToScheme(newScheme)
+	_ = policyv1.AddToScheme(newScheme)
+	_ = networkingv1.AddToScheme(newScheme)
+	_ = appsv1.AddToScheme(newScheme)
 
 	// Prepare a RayCluster with the GCS FT enabled and Autoscaling disabled.
 	gcsFTEnabledCluster := testRayCluster.DeepCopy()
@@ -2962,6 +3223,115 @@ func TestReconcile_Replicas_Optional(t *testing.T) {
 	}
 }
 
+func TestReconcilePods_ResizeBatchSchedulerGang(t *testing.T) {
+	setupTest(t)
+
+	tests := []struct {
+		resizeErr          error
+		name               string
+		expectedWorkerPods int
+	}{
+		{
+			name:               "Worker Pods are created after the gang is resized",
+			expectedWorkerPods: 6,
+		},
+		{
+			name:               "Worker Pods are not created if the gang fails to be resized",
+			resizeErr:          errors.New("queue is closed"),
+			expectedWorkerPods: 5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// `testPods` contains 1 head Pod and 5 worker Pods, so scaling the worker group to 6 replicas adds a Pod.
+			cluster := testRayCluster.DeepCopy()
+			cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](6)
+			cluster.Spec.WorkerGroupSpecs[0].MaxReplicas = ptr.To[int32](10)
+			cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+
+			fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(testPods...).Build()
+			ctx := context.Background()
+			fakeScheduler := &fakeBatchScheduler{resizeErr: tc.resizeErr}
+			recorder := record.NewFakeRecorder(100)
+			testRayClusterReconciler := &RayClusterReconciler{
+				Client:                     fakeClient,
+				Recorder:                   recorder,
+				Scheme:                     scheme.Scheme,
+				rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+				options: RayClusterReconcilerOptions{
+					BatchSchedulerManager: batchscheduler.NewSchedulerManagerForTest(fakeScheduler),
+				},
+			}
+
+			err := testRayClusterReconciler.reconcilePods(ctx, cluster)
+			if tc.resizeErr != nil {
+				require.ErrorIs(t, err, tc.resizeErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, cluster.Spec.WorkerGroupSpecs[0].GroupName, fakeScheduler.resizeGroupName)
+			assert.Equal(t, int32(6), fakeScheduler.resizeDesiredPods)
+
+			podList := corev1.PodList{}
+			err = fakeClient.List(ctx, &podList, &client.ListOptions{
+				LabelSelector: workerSelector,
+				Namespace:     namespaceStr,
+			})
+			require.NoError(t, err)
+			assert.Len(t, podList.Items, tc.expectedWorkerPods)
+
+			var foundEvent bool
+			for len(recorder.Events) > 0 {
+				if strings.Contains(<-recorder.Events, string(utils.FailedToResizeBatchSchedulerGang)) {
+					foundEvent = true
+				}
+			}
+			assert.Equal(t, tc.resizeErr != nil, foundEvent)
+		})
+	}
+}
+
+func TestReconcilePods_ResizeBatchSchedulerGangFailureOnlyBlocksItsGroup(t *testing.T) {
+	setupTest(t)
+
+	// `testPods` contains 5 worker Pods of the first worker group. The gang of the first worker group can't be resized
+	// to 6 Pods, but the second worker group is still scaled up.
+	cluster := testRayCluster.DeepCopy()
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](6)
+	cluster.Spec.WorkerGroupSpecs[0].MaxReplicas = ptr.To[int32](10)
+	cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+	otherGroup := *cluster.Spec.WorkerGroupSpecs[0].DeepCopy()
+	otherGroup.GroupName = "other-group"
+	otherGroup.Replicas = ptr.To[int32](1)
+	otherGroup.MinReplicas = ptr.To[int32](0)
+	cluster.Spec.WorkerGroupSpecs = append(cluster.Spec.WorkerGroupSpecs, otherGroup)
+
+	fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(testPods...).Build()
+	ctx := context.Background()
+	resizeErr := errors.New("queue is closed")
+	fakeScheduler := &fakeBatchScheduler{resizeErr: resizeErr, resizeErrGroup: cluster.Spec.WorkerGroupSpecs[0].GroupName}
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   record.NewFakeRecorder(100),
+		Scheme:                     scheme.Scheme,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+		options: RayClusterReconcilerOptions{
+			BatchSchedulerManager: batchscheduler.NewSchedulerManagerForTest(fakeScheduler),
+		},
+	}
+
+	err := testRayClusterReconciler.reconcilePods(ctx, cluster)
+	require.ErrorIs(t, err, resizeErr)
+	require.ErrorIs(t, err, utils.ErrFailedResizeBatchSchedulerGang)
+
+	for groupName, expectedPods := range map[string]int{cluster.Spec.WorkerGroupSpecs[0].GroupName: 5, otherGroup.GroupName: 1} {
+		podList := corev1.PodList{}
+		require.NoError(t, fakeClient.List(ctx, &podList, common.RayClusterGroupPodsAssociationOptions(cluster, groupName).ToListOptions()...))
+		assert.Len(t, podList.Items, expectedPods, "worker group %s", groupName)
+	}
+}
+
 func TestReconcile_Multihost_Replicas(t *testing.T) {
 	setupTest(t)
 
@@ -3191,12 +3561,41 @@ func TestSumGPUs(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result := sumGPUs(tc.input)
+			result := sumAccelerators(tc.input, utils.AcceleratorTypeGPU, nil)
 			assert.True(t, tc.expected.Equal(result), "GPU number is wrong")
 		})
 	}
 }
 
+func TestSumAcceleratorsByRayResource(t *testing.T) {
+	accelerators := utils.NewAccelerators([]utils.Accelerator{
+		{ResourceName: "example.com/npu", RayResourceName: "NPU"},
+		{ResourceName: "example.com/tpu-v9", RayResourceName: "TPU", Type: utils.AcceleratorTypeTPU},
+		{ResourceName: "example.com/vgpu", RayResourceName: utils.GPURayResourceName, Type: utils.AcceleratorTypeGPU},
+	})
+
+	resources := map[corev1.ResourceName]resource.Quantity{
+		corev1.ResourceCPU:                        resource.MustParse("8"),
+		corev1.ResourceName("nvidia.com/gpu"):     resource.MustParse("2"),
+		corev1.ResourceName("example.com/vgpu"):   resource.MustParse("3"),
+		corev1.ResourceName("google.com/tpu"):     resource.MustParse("4"),
+		corev1.ResourceName("example.com/tpu-v9"): resource.MustParse("1"),
+		corev1.ResourceName("example.com/npu"):    resource.MustParse("5"),
+		corev1.ResourceName("example.com/fpga"):   resource.MustParse("6"),
+	}
+
+	assert.True(t, resource.MustParse("5").Equal(sumAccelerators(resources, utils.AcceleratorTypeGPU, accelerators)))
+	assert.True(t, resource.MustParse("5").Equal(sumAccelerators(resources, utils.AcceleratorTypeTPU, accelerators)))
+
+	totals := sumAcceleratorsByRayResource(resources, accelerators)
+	assert.Len(t, totals, 3)
+	assert.True(t, resource.MustParse("5").Equal(totals["GPU"]))
+	assert.True(t, resource.MustParse("5").Equal(totals["TPU"]))
+	assert.True(t, resource.MustParse("5").Equal(totals["NPU"]))
+
+	assert.Nil(t, sumAcceleratorsByRayResource(map[corev1.ResourceName]resource.Quantity{corev1.ResourceCPU: resource.MustParse("1")}, accelerators))
+}
+
 func TestDeleteAllPods(t *testing.T) {
 	newScheme := runtime.NewScheme()
 	_ = corev1.AddToScheme(newScheme)
@@ -3375,6 +3774,9 @@ func Test_ReconcileManagedBy(t *testing.T) {
 	_ = rayv1.AddToScheme(newScheme)
 	_ = corev1.AddToScheme(newScheme)
 	_ = batchv1.AddToScheme(newScheme)
+	_ = policyv1.AddToScheme(newScheme)
+	_ = networkingv1.AddToScheme(newScheme)
+	_ = appsv1.AddToScheme(newScheme)
 
 	tests := []struct {
 		managedBy       *string
@@ -3677,6 +4079,239 @@ func TestReconcile_PodsWithAuthToken(t *testing.T) {
 	}
 }
 
+func TestReconcile_AuthTokenRotation(t *testing.T) {
+	now := time.Now()
+	formatTime := func(t time.Time) string { return t.UTC().Format(time.RFC3339) }
+
+	tests := []struct {
+		annotations            map[string]string
+		name                   string
+		expectedEvent          string
+		expectRotated          bool
+		expectPreviousToken    bool
+		expectPreviousTokenSet bool
+		stalePod               bool
+	}{
+		{
+			name: "rotation period has not elapsed",
+			annotations: map[string]string{
+				utils.RayAuthTokenRotationTimeAnnotationKey: formatTime(now.Add(-time.Minute)),
+			},
+		},
+		{
+			name: "rotation period has elapsed",
+			annotations: map[string]string{
+				utils.RayAuthTokenRotationTimeAnnotationKey: formatTime(now.Add(-2 * time.Hour)),
+			},
+			expectRotated:          true,
+			expectPreviousToken:    true,
+			expectPreviousTokenSet: true,
+			expectedEvent:          string(utils.RotatedAuthToken),
+		},
+		{
+			name: "previous token is kept during the grace period",
+			annotations: map[string]string{
+				utils.RayAuthTokenRotationTimeAnnotationKey:           formatTime(now.Add(-time.Minute)),
+				utils.RayPreviousAuthTokenExpirationTimeAnnotationKey: formatTime(now.Add(time.Minute)),
+			},
+			expectPreviousToken:    true,
+			expectPreviousTokenSet: true,
+		},
+		{
+			name: "previous token is removed after the grace period",
+			annotations: map[string]string{
+				utils.RayAuthTokenRotationTimeAnnotationKey:           formatTime(now.Add(-30 * time.Minute)),
+				utils.RayPreviousAuthTokenExpirationTimeAnnotationKey: formatTime(now.Add(-time.Minute)),
+			},
+			expectedEvent: string(utils.ExpiredPreviousAuthToken),
+		},
+		{
+			name: "previous token is kept after the grace period while a Pod uses it",
+			annotations: map[string]string{
+				utils.RayAuthTokenRotationTimeAnnotationKey:           formatTime(now.Add(-30 * time.Minute)),
+				utils.RayPreviousAuthTokenExpirationTimeAnnotationKey: formatTime(now.Add(-time.Minute)),
+			},
+			stalePod:               true,
+			expectPreviousToken:    true,
+			expectPreviousTokenSet: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setupTest(t)
+			testRayCluster.Spec.AuthOptions = &rayv1.AuthOptions{
+				Mode:                       rayv1.AuthModeToken,
+				TokenRotationPeriodSeconds: ptr.To[int32](3600),
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        instanceName,
+					Namespace:   namespaceStr,
+					Annotations: tc.annotations,
+				},
+				Data: map[string][]byte{
+					utils.RAY_AUTH_TOKEN_SECRET_KEY: []byte("current-token"),
+				},
+			}
+			if _, ok := tc.annotations[utils.RayPreviousAuthTokenExpirationTimeAnnotationKey]; ok {
+				secret.Data[utils.RAY_PREVIOUS_AUTH_TOKEN_SECRET_KEY] = []byte("previous-token")
+			}
+
+			objects := []runtime.Object{secret}
+			if tc.stalePod {
+				objects = append(objects, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "stale-head",
+						Namespace: namespaceStr,
+						Labels:    map[string]string{utils.RayClusterLabelKey: instanceName, utils.RayNodeTypeLabelKey: string(rayv1.HeadNode)},
+					},
+				})
+			}
+			fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(objects...).Build()
+			recorder := record.NewFakeRecorder(10)
+			ctx := context.Background()
+			testRayClusterReconciler := &RayClusterReconciler{
+				Client:                     fakeClient,
+				Recorder:                   recorder,
+				Scheme:                     scheme.Scheme,
+				rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+			}
+
+			err := testRayClusterReconciler.reconcileAuthSecret(ctx, testRayCluster)
+			require.NoError(t, err)
+
+			updatedSecret := &corev1.Secret{}
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: instanceName, Namespace: namespaceStr}, updatedSecret))
+
+			require.NotNil(t, testRayCluster.Status.AuthToken)
+			require.NotNil(t, testRayCluster.Status.AuthToken.LastRotationTime)
+			require.NotNil(t, testRayCluster.Status.AuthToken.NextRotationTime)
+			assert.Equal(t, time.Hour, testRayCluster.Status.AuthToken.NextRotationTime.Sub(testRayCluster.Status.AuthToken.LastRotationTime.Time))
+			assert.Equal(t, formatTime(testRayCluster.Status.AuthToken.LastRotationTime.Time),
+				updatedSecret.Annotations[utils.RayAuthTokenRotationTimeAnnotationKey])
+			assert.Equal(t, tc.expectPreviousTokenSet, testRayCluster.Status.AuthToken.PreviousTokenExpirationTime != nil)
+
+			if tc.expectRotated {
+				assert.NotEqual(t, "current-token", string(updatedSecret.Data[utils.RAY_AUTH_TOKEN_SECRET_KEY]))
+				assert.Equal(t, "current-token", string(updatedSecret.Data[utils.RAY_PREVIOUS_AUTH_TOKEN_SECRET_KEY]))
+				assert.Equal(t, 10*time.Minute, testRayCluster.Status.AuthToken.PreviousTokenExpirationTime.Sub(testRayCluster.Status.AuthToken.LastRotationTime.Time))
+			} else {
+				assert.Equal(t, "current-token", string(updatedSecret.Data[utils.RAY_AUTH_TOKEN_SECRET_KEY]))
+			}
+			_, hasPreviousToken := updatedSecret.Data[utils.RAY_PREVIOUS_AUTH_TOKEN_SECRET_KEY]
+			assert.Equal(t, tc.expectPreviousToken, hasPreviousToken)
+
+			if tc.expectedEvent != "" {
+				require.Len(t, recorder.Events, 1)
+				assert.Contains(t, <-recorder.Events, tc.expectedEvent)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+		})
+	}
+}
+
+func TestReconcile_RollOutAuthTokenRotation(t *testing.T) {
+	setupTest(t)
+	rotationTime := metav1.NewTime(time.Now().Truncate(time.Second))
+	expirationTime := metav1.NewTime(rotationTime.Add(10 * time.Minute))
+	testRayCluster.Spec.AuthOptions = &rayv1.AuthOptions{Mode: rayv1.AuthModeToken}
+	testRayCluster.Status.AuthToken = &rayv1.AuthTokenStatus{LastRotationTime: &rotationTime, PreviousTokenExpirationTime: &expirationTime}
+
+	fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(testPods...).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   &record.FakeRecorder{},
+		Scheme:                     scheme.Scheme,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+	}
+	listPodNames := func(filters common.AssociationOptions) []string {
+		podList := corev1.PodList{}
+		require.NoError(t, fakeClient.List(ctx, &podList, filters.ToListOptions()...))
+		names := make([]string, 0, len(podList.Items))
+		for _, pod := range podList.Items {
+			names = append(names, pod.Name)
+		}
+		return names
+	}
+	allFilters := common.RayClusterAllPodsAssociationOptions(testRayCluster)
+	require.NotEmpty(t, listPodNames(common.RayClusterHeadPodsAssociationOptions(testRayCluster)))
+	require.NotEmpty(t, listPodNames(common.RayClusterGroupPodsAssociationOptions(testRayCluster, groupNameStr)))
+
+	// All the Pods created with the previous token are deleted in one step, even during the grace period.
+	deleted, err := testRayClusterReconciler.rollOutAuthTokenRotation(ctx, testRayCluster)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, listPodNames(allFilters))
+
+	// The Pods created with the new token are kept.
+	newHeadPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "new-head",
+			Namespace:   namespaceStr,
+			Labels:      map[string]string{utils.RayClusterLabelKey: instanceName, utils.RayNodeTypeLabelKey: string(rayv1.HeadNode)},
+			Annotations: map[string]string{utils.RayAuthTokenRotationTimeAnnotationKey: rotationTime.UTC().Format(time.RFC3339)},
+		},
+	}
+	require.NoError(t, fakeClient.Create(ctx, newHeadPod))
+	deleted, err = testRayClusterReconciler.rollOutAuthTokenRotation(ctx, testRayCluster)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	assert.Equal(t, []string{"new-head"}, listPodNames(allFilters))
+}
+
+func TestReconcile_ManagedRedis(t *testing.T) {
+	setupTest(t)
+	testRayCluster.Spec.GcsFaultToleranceOptions = &rayv1.GcsFaultToleranceOptions{
+		ManagedRedis: &rayv1.ManagedRedisOptions{},
+	}
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = appsv1.AddToScheme(newScheme)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(testRayCluster).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+
+	require.NoError(t, testRayClusterReconciler.reconcileManagedRedis(ctx, testRayCluster))
+
+	key := types.NamespacedName{Name: utils.GenerateManagedRedisName(testRayCluster.Name), Namespace: namespaceStr}
+	secret := &corev1.Secret{}
+	require.NoError(t, fakeClient.Get(ctx, key, secret))
+	assert.NotEmpty(t, secret.StringData[utils.ManagedRedisPasswordSecretKey])
+	assert.True(t, metav1.IsControlledBy(secret, testRayCluster))
+	service := &corev1.Service{}
+	require.NoError(t, fakeClient.Get(ctx, key, service))
+	assert.True(t, metav1.IsControlledBy(service, testRayCluster))
+	statefulSet := &appsv1.StatefulSet{}
+	require.NoError(t, fakeClient.Get(ctx, key, statefulSet))
+	assert.True(t, metav1.IsControlledBy(statefulSet, testRayCluster))
+	assert.Equal(t, utils.DefaultManagedRedisImage, statefulSet.Spec.Template.Spec.Containers[0].Image)
+
+	// Reconciling again keeps the generated password and applies the new image.
+	testRayCluster.Spec.GcsFaultToleranceOptions.ManagedRedis.Image = "redis:7.2"
+	require.NoError(t, testRayClusterReconciler.reconcileManagedRedis(ctx, testRayCluster))
+	updatedSecret := &corev1.Secret{}
+	require.NoError(t, fakeClient.Get(ctx, key, updatedSecret))
+	assert.Equal(t, secret.StringData, updatedSecret.StringData)
+	require.NoError(t, fakeClient.Get(ctx, key, statefulSet))
+	assert.Equal(t, "redis:7.2", statefulSet.Spec.Template.Spec.Containers[0].Image)
+
+	// The head Pod connects to the managed Redis.
+	headPod := testRayClusterReconciler.buildHeadPod(ctx, *testRayCluster)
+	address, ok := utils.EnvVarByName(utils.RAY_REDIS_ADDRESS, headPod.Spec.Containers[utils.RayContainerIndex].Env)
+	require.True(t, ok)
+	assert.Equal(t, utils.GenerateManagedRedisAddress(testRayCluster), address.Value)
+}
+
 func TestShouldRecreatePodsForUpgrade(t *testing.T) {
 	setupTest(t)
 	ctx := context.Background()
@@ -3890,3 +4525,745 @@ func TestReconcilePodsWithAuthTokenSecretName(t *testing.T) {
 		assert.True(t, authTokenEnvFound, "Auth token env var with provided secret name not found")
 	}
 }
+
+func TestReconcileIdlePolicy(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	idleStatus := func(lastActivity time.Duration, warning *time.Duration) *rayv1.IdleStatus {
+		status := &rayv1.IdleStatus{LastActivityTime: &metav1.Time{Time: now.Add(-lastActivity)}}
+		if warning != nil {
+			status.WarningTime = &metav1.Time{Time: now.Add(-*warning)}
+		}
+		return status
+	}
+
+	tests := []struct {
+		idleStatus       *rayv1.IdleStatus
+		resourceUsage    map[string][]float64
+		name             string
+		expectedEvent    string
+		action           rayv1.IdleAction
+		hasAliveActors   bool
+		recentlyChecked  bool
+		expectTracking   bool
+		expectWarning    bool
+		expectSuspended  bool
+		expectDeleted    bool
+		expectActivityAt bool
+	}{
+		{
+			name:           "starts tracking the idle time",
+			expectTracking: true,
+		},
+		{
+			name:             "alive actors reset the idle time",
+			idleStatus:       idleStatus(50*time.Minute, ptr.To(5*time.Minute)),
+			hasAliveActors:   true,
+			expectTracking:   true,
+			expectActivityAt: true,
+		},
+		{
+			name:             "resources in use reset the idle time",
+			idleStatus:       idleStatus(50*time.Minute, nil),
+			resourceUsage:    map[string][]float64{"CPU": {1, 4}, "memory": {0, 1024}},
+			expectTracking:   true,
+			expectActivityAt: true,
+		},
+		{
+			name:            "doesn't check the activity again before the idle check interval",
+			idleStatus:      idleStatus(50*time.Minute, nil),
+			hasAliveActors:  true,
+			recentlyChecked: true,
+			expectTracking:  true,
+		},
+		{
+			name:           "memory and node resources don't count as activity",
+			idleStatus:     idleStatus(10*time.Minute, nil),
+			resourceUsage:  map[string][]float64{"CPU": {0, 4}, "memory": {512, 1024}, "node:10.0.0.1": {0.01, 1}},
+			expectTracking: true,
+		},
+		{
+			name:           "warns before the idle timeout",
+			idleStatus:     idleStatus(56*time.Minute, nil),
+			expectTracking: true,
+			expectWarning:  true,
+			expectedEvent:  string(utils.IdleRayCluster),
+		},
+		{
+			name:           "warns before acting if no warning has been emitted",
+			idleStatus:     idleStatus(2*time.Hour, nil),
+			expectTracking: true,
+			expectWarning:  true,
+			expectedEvent:  string(utils.IdleRayCluster),
+		},
+		{
+			name:            "suspends the RayCluster after the idle timeout",
+			idleStatus:      idleStatus(time.Hour, ptr.To(5*time.Minute)),
+			expectSuspended: true,
+			expectedEvent:   string(utils.SuspendedIdleRayCluster),
+		},
+		{
+			name:          "deletes the RayCluster after the idle timeout",
+			idleStatus:    idleStatus(time.Hour, ptr.To(5*time.Minute)),
+			action:        rayv1.IdleActionDelete,
+			expectDeleted: true,
+			expectedEvent: string(utils.DeletedIdleRayCluster),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setupTest(t)
+			testRayCluster.Spec.IdlePolicy = &rayv1.IdlePolicy{IdleTimeoutSeconds: 3600}
+			if tc.action != "" {
+				testRayCluster.Spec.IdlePolicy.Action = ptr.To(tc.action)
+			}
+			testRayCluster.Status.Idle = tc.idleStatus.DeepCopy()
+
+			headService, err := common.BuildServiceForHeadPod(context.Background(), *testRayCluster, nil, nil)
+			require.NoError(t, err)
+			newScheme := runtime.NewScheme()
+			_ = rayv1.AddToScheme(newScheme)
+			_ = corev1.AddToScheme(newScheme)
+			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(testRayCluster.DeepCopy(), headService).Build()
+			fakeDashboardClient := &utils.FakeRayDashboardClient{}
+			fakeDashboardClient.SetClusterActivity(tc.hasAliveActors, tc.resourceUsage)
+			recorder := record.NewFakeRecorder(10)
+			ctx := context.Background()
+			testRayClusterReconciler := &RayClusterReconciler{
+				Client:   fakeClient,
+				Recorder: recorder,
+				Scheme:   newScheme,
+				dashboardClientFunc: func(_ *rayv1.RayCluster, _ string) (dashboardclient.RayDashboardClientInterface, error) {
+					return fakeDashboardClient, nil
+				},
+			}
+
+			if tc.recentlyChecked {
+				testRayClusterReconciler.idleCheckTimes.Store(types.NamespacedName{Name: instanceName, Namespace: namespaceStr}, now)
+			}
+
+			instance := &rayv1.RayCluster{}
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: instanceName, Namespace: namespaceStr}, instance))
+			nextCheck, deleted, err := testRayClusterReconciler.reconcileIdlePolicy(ctx, instance)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectDeleted, deleted)
+			// The RayCluster is requeued for the next idle check as long as it's tracked.
+			if tc.expectTracking {
+				assert.True(t, nextCheck.After(time.Now()))
+				assert.False(t, nextCheck.After(time.Now().Add(idleCheckInterval)))
+			} else {
+				assert.True(t, nextCheck.IsZero())
+			}
+
+			if tc.expectTracking {
+				require.NotNil(t, instance.Status.Idle)
+				require.NotNil(t, instance.Status.Idle.LastActivityTime)
+				if tc.idleStatus == nil || tc.expectActivityAt {
+					assert.False(t, instance.Status.Idle.LastActivityTime.Before(&metav1.Time{Time: now}))
+				} else {
+					assert.Equal(t, tc.idleStatus.LastActivityTime, instance.Status.Idle.LastActivityTime)
+				}
+				assert.Equal(t, tc.expectWarning, instance.Status.Idle.WarningTime != nil)
+			} else if !tc.expectDeleted {
+				assert.Nil(t, instance.Status.Idle)
+			}
+
+			updatedRayCluster := &rayv1.RayCluster{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Name: instanceName, Namespace: namespaceStr}, updatedRayCluster)
+			if tc.expectDeleted {
+				assert.True(t, k8serrors.IsNotFound(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectSuspended, ptr.Deref(updatedRayCluster.Spec.Suspend, false))
+			}
+
+			if tc.expectedEvent != "" {
+				require.Len(t, recorder.Events, 1)
+				assert.Contains(t, <-recorder.Events, tc.expectedEvent)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+		})
+	}
+}
+
+func TestReconcileScalingSchedules(t *testing.T) {
+	// A window that started within the last hour is active at any time.
+	alwaysActive := rayv1.ScalingSchedule{
+		Name:            "always",
+		Schedule:        "* * * * *",
+		DurationSeconds: 3600,
+		MinReplicas:     ptr.To[int32](2),
+	}
+	// A window that only starts on February 29.
+	leapDay := rayv1.ScalingSchedule{
+		Name:            "leap-day",
+		Schedule:        "0 0 29 2 *",
+		DurationSeconds: 60,
+		MaxReplicas:     ptr.To[int32](1),
+	}
+	activeStartTime := func() time.Time {
+		_, startTime, err := utils.GetActiveScalingSchedule(rayv1.WorkerGroupSpec{ScalingSchedules: []rayv1.ScalingSchedule{alwaysActive}}, time.Now())
+		require.NoError(t, err)
+		return startTime
+	}
+
+	tests := []struct {
+		previous            *rayv1.ActiveScalingSchedule
+		name                string
+		expectedEvent       string
+		scalingSchedules    []rayv1.ScalingSchedule
+		expectedMinReplicas int32
+		expectedMaxReplicas int32
+	}{
+		{
+			name:                "applies a window that has started",
+			scalingSchedules:    []rayv1.ScalingSchedule{leapDay, alwaysActive},
+			expectedEvent:       string(utils.StartedScalingSchedule),
+			expectedMinReplicas: 2,
+			expectedMaxReplicas: 5,
+		},
+		{
+			name:                "keeps applying a window that has already been reported",
+			scalingSchedules:    []rayv1.ScalingSchedule{alwaysActive},
+			previous:            utils.NewActiveScalingSchedule(rayv1.WorkerGroupSpec{}, alwaysActive, activeStartTime()),
+			expectedMinReplicas: 2,
+			expectedMaxReplicas: 5,
+		},
+		{
+			name:                "reports the end of a window",
+			scalingSchedules:    []rayv1.ScalingSchedule{leapDay},
+			previous:            &rayv1.ActiveScalingSchedule{Name: "leap-day", StartTime: metav1.NewTime(time.Now().Add(-time.Hour)), EndTime: metav1.NewTime(time.Now().Add(-time.Minute))},
+			expectedEvent:       string(utils.EndedScalingSchedule),
+			expectedMinReplicas: 1,
+			expectedMaxReplicas: 5,
+		},
+		{
+			name:                "no scaling schedules",
+			expectedMinReplicas: 1,
+			expectedMaxReplicas: 5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setupTest(t)
+			testRayCluster.Spec.WorkerGroupSpecs[0].MinReplicas = ptr.To[int32](1)
+			testRayCluster.Spec.WorkerGroupSpecs[0].MaxReplicas = ptr.To[int32](5)
+			testRayCluster.Spec.WorkerGroupSpecs[0].ScalingSchedules = tc.scalingSchedules
+			testRayCluster.Status.WorkerGroupStatuses = []rayv1.WorkerGroupStatus{{GroupName: groupNameStr, ActiveScalingSchedule: tc.previous}}
+
+			newScheme := runtime.NewScheme()
+			_ = rayv1.AddToScheme(newScheme)
+			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(testRayCluster.DeepCopy()).Build()
+			recorder := record.NewFakeRecorder(10)
+			ctx := context.Background()
+			testRayClusterReconciler := &RayClusterReconciler{
+				Client:   fakeClient,
+				Recorder: recorder,
+				Scheme:   newScheme,
+			}
+
+			instance := &rayv1.RayCluster{}
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: instanceName, Namespace: namespaceStr}, instance))
+			nextTransition, err := testRayClusterReconciler.reconcileScalingSchedules(ctx, instance)
+			require.NoError(t, err)
+			assert.Equal(t, len(tc.scalingSchedules) > 0, !nextTransition.IsZero())
+			if !nextTransition.IsZero() {
+				assert.True(t, nextTransition.After(time.Now()))
+			}
+
+			// The overrides only apply to the in-memory RayCluster.
+			worker := instance.Spec.WorkerGroupSpecs[0]
+			assert.Equal(t, tc.expectedMinReplicas, *worker.MinReplicas)
+			assert.Equal(t, tc.expectedMaxReplicas, *worker.MaxReplicas)
+			storedRayCluster := &rayv1.RayCluster{}
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: instanceName, Namespace: namespaceStr}, storedRayCluster))
+			assert.Equal(t, testRayCluster.Spec, storedRayCluster.Spec)
+
+			if tc.expectedEvent != "" {
+				require.Len(t, recorder.Events, 1)
+				assert.Contains(t, <-recorder.Events, tc.expectedEvent)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+		})
+	}
+}
+
+func TestReconcile_ResourceClaimTemplates(t *testing.T) {
+	setupTest(t)
+	features.SetFeatureGateDuringTest(t, features.RayClusterStatusConditions, false)
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = resourcev1.AddToScheme(newScheme)
+
+	template := &resourcev1.ResourceClaimTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "two-gpus", Namespace: namespaceStr},
+		Spec: resourcev1.ResourceClaimTemplateSpec{
+			Spec: resourcev1.ResourceClaimSpec{
+				Devices: resourcev1.DeviceClaim{
+					Requests: []resourcev1.DeviceRequest{{
+						Name: "gpus",
+						Exactly: &resourcev1.ExactDeviceRequest{
+							DeviceClassName: "gpu.nvidia.com",
+							AllocationMode:  resourcev1.DeviceAllocationModeExactCount,
+							Count:           2,
+						},
+					}},
+				},
+			},
+		},
+	}
+	worker := testRayCluster.Spec.WorkerGroupSpecs[0]
+	worker.Template.Spec.ResourceClaims = []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To(template.Name)}}
+	worker.Template.Spec.Containers[utils.RayContainerIndex].Resources.Claims = []corev1.ResourceClaim{{Name: "gpus"}}
+	testRayCluster.Spec.WorkerGroupSpecs[0] = worker
+	headService, err := common.BuildServiceForHeadPod(context.Background(), *testRayCluster, nil, nil)
+	require.NoError(t, err)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(testRayCluster, template, headService).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+
+	// The claimed GPUs are passed to `ray start`.
+	workerPod := testRayClusterReconciler.buildWorkerPod(ctx, *testRayCluster.DeepCopy(), *worker.DeepCopy(), "replica", 0, 0)
+	assert.Contains(t, workerPod.Spec.Containers[utils.RayContainerIndex].Args[0], "--num-gpus=2")
+	assert.NotContains(t, worker.RayStartParams, "num-gpus")
+
+	// The claimed GPUs of all the desired worker Pods are counted in the status.
+	newInstance, err := testRayClusterReconciler.calculateStatus(ctx, testRayCluster, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*expectReplicaNum), newInstance.Status.DesiredGPU.Value())
+
+	// Mapping the device class to no resource disables the detection.
+	testRayClusterReconciler.options.DeviceClassResourceNames = utils.GetDeviceClassResourceNames(map[string]string{"gpu.nvidia.com": ""})
+	workerPod = testRayClusterReconciler.buildWorkerPod(ctx, *testRayCluster.DeepCopy(), *worker.DeepCopy(), "replica", 0, 0)
+	assert.NotContains(t, workerPod.Spec.Containers[utils.RayContainerIndex].Args[0], "--num-gpus")
+}
+
+func TestRayClustersForResourceClaimTemplate(t *testing.T) {
+	setupTest(t)
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = resourcev1.AddToScheme(newScheme)
+
+	worker := &testRayCluster.Spec.WorkerGroupSpecs[0]
+	worker.Template.Spec.ResourceClaims = []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To("gpus")}}
+	worker.Template.Spec.Containers[utils.RayContainerIndex].Resources.Claims = []corev1.ResourceClaim{{Name: "gpus"}}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).
+		WithRuntimeObjects(testRayCluster.DeepCopy()).
+		WithIndex(&rayv1.RayCluster{}, resourceClaimTemplateIndexKey, indexResourceClaimTemplates).
+		Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+
+	// A change of the ResourceClaimTemplate reconciles the RayClusters that use it.
+	requests := testRayClusterReconciler.rayClustersForResourceClaimTemplate(ctx, &resourcev1.ResourceClaimTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpus", Namespace: namespaceStr},
+	})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Name: instanceName, Namespace: namespaceStr}}}, requests)
+
+	requests = testRayClusterReconciler.rayClustersForResourceClaimTemplate(ctx, &resourcev1.ResourceClaimTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespaceStr},
+	})
+	assert.Empty(t, requests)
+}
+
+func TestReconcile_VolumeClaimTemplates(t *testing.T) {
+	setupTest(t)
+	features.SetFeatureGateDuringTest(t, features.RayMultiHostIndexing, true)
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	spillTemplate := rayv1.VolumeClaimTemplate{
+		Name: "spill",
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+	}
+	cluster := testRayCluster.DeepCopy()
+	cluster.Spec.EnableInTreeAutoscaling = ptr.To(false)
+	cluster.Spec.HeadGroupSpec.VolumeClaimTemplates = []rayv1.VolumeClaimTemplate{spillTemplate}
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](2)
+	cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+	cluster.Spec.WorkerGroupSpecs[0].VolumeClaimTemplates = []rayv1.VolumeClaimTemplate{spillTemplate}
+	cluster.Spec.VolumeClaimRetentionPolicy = &rayv1.VolumeClaimRetentionPolicy{
+		WhenDeleted: rayv1.VolumeClaimRetentionPolicyDelete,
+		WhenScaled:  rayv1.VolumeClaimRetentionPolicyDelete,
+	}
+	// A claim left over from a worker group that was removed from the RayCluster.
+	staleClaim := common.BuildWorkerVolumeClaims(cluster, rayv1.WorkerGroupSpec{GroupName: "removed-group", VolumeClaimTemplates: []rayv1.VolumeClaimTemplate{spillTemplate}}, 0, 0)[0]
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster, staleClaim).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   &record.FakeRecorder{},
+		Scheme:                     newScheme,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+	}
+
+	// The claims of the head Pod and the worker Pods are created and mounted.
+	require.NoError(t, testRayClusterReconciler.reconcilePods(ctx, cluster))
+	headClaimName := utils.GenerateHeadVolumeClaimName("spill", cluster.Name)
+	workerClaimNames := []string{
+		utils.GenerateWorkerVolumeClaimName("spill", cluster.Name, "small-group", 1, 0, 0),
+		utils.GenerateWorkerVolumeClaimName("spill", cluster.Name, "small-group", 1, 1, 0),
+	}
+	for _, name := range append([]string{headClaimName}, workerClaimNames...) {
+		claim := &corev1.PersistentVolumeClaim{}
+		require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: name}, claim))
+		assert.True(t, metav1.IsControlledBy(claim, cluster), "claim %s should be deleted with the RayCluster", name)
+		assert.Equal(t, spillTemplate.Spec, claim.Spec)
+	}
+
+	podList := corev1.PodList{}
+	require.NoError(t, fakeClient.List(ctx, &podList, client.InNamespace(namespaceStr)))
+	require.Len(t, podList.Items, 3)
+	var workerPodWithIndex1 *corev1.Pod
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		volumeIndex := slices.IndexFunc(pod.Spec.Volumes, func(volume corev1.Volume) bool { return volume.Name == "spill" })
+		require.NotEqual(t, -1, volumeIndex, "Pod %s should mount its claim", pod.Name)
+		claimName := pod.Spec.Volumes[volumeIndex].PersistentVolumeClaim.ClaimName
+		if pod.Labels[utils.RayNodeTypeLabelKey] == string(rayv1.HeadNode) {
+			assert.Equal(t, headClaimName, claimName)
+			continue
+		}
+		replicaIndex, err := strconv.Atoi(pod.Labels[utils.RayWorkerReplicaIndexKey])
+		require.NoError(t, err)
+		assert.Equal(t, workerClaimNames[replicaIndex], claimName)
+		if replicaIndex == 1 {
+			workerPodWithIndex1 = pod
+		}
+	}
+	require.NotNil(t, workerPodWithIndex1)
+
+	// Scaling down deletes the claims that are no longer used, and keeps the claims of the remaining Pods.
+	require.NoError(t, fakeClient.Delete(ctx, workerPodWithIndex1))
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](1)
+	require.NoError(t, testRayClusterReconciler.reconcileVolumeClaims(ctx, cluster))
+
+	claimList := corev1.PersistentVolumeClaimList{}
+	require.NoError(t, fakeClient.List(ctx, &claimList, client.InNamespace(namespaceStr)))
+	claimNames := make([]string, 0, len(claimList.Items))
+	for _, claim := range claimList.Items {
+		claimNames = append(claimNames, claim.Name)
+	}
+	assert.ElementsMatch(t, []string{headClaimName, workerClaimNames[0]}, claimNames)
+
+	// The claims are retained when the retention policy isn't set.
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](0)
+	cluster.Spec.VolumeClaimRetentionPolicy = nil
+	require.NoError(t, fakeClient.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(namespaceStr)))
+	require.NoError(t, testRayClusterReconciler.reconcileVolumeClaims(ctx, cluster))
+	require.NoError(t, fakeClient.List(ctx, &claimList, client.InNamespace(namespaceStr)))
+	assert.Len(t, claimList.Items, 2)
+	// The existing claims are no longer deleted with the RayCluster.
+	for _, claim := range claimList.Items {
+		assert.False(t, metav1.IsControlledBy(&claim, cluster), "claim %s should be retained", claim.Name)
+	}
+
+	// The existing claims are deleted with the RayCluster once the retention policy is set again.
+	cluster.Spec.VolumeClaimRetentionPolicy = &rayv1.VolumeClaimRetentionPolicy{WhenDeleted: rayv1.VolumeClaimRetentionPolicyDelete}
+	require.NoError(t, testRayClusterReconciler.reconcileVolumeClaims(ctx, cluster))
+	require.NoError(t, fakeClient.List(ctx, &claimList, client.InNamespace(namespaceStr)))
+	require.Len(t, claimList.Items, 2)
+	for _, claim := range claimList.Items {
+		assert.True(t, metav1.IsControlledBy(&claim, cluster), "claim %s should be deleted with the RayCluster", claim.Name)
+	}
+}
+
+func TestReconcile_WorkerGroupTopology(t *testing.T) {
+	setupTest(t)
+	features.SetFeatureGateDuringTest(t, features.RayMultiHostIndexing, true)
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	topologyKey := "cloud.google.com/gke-nodepool"
+	cluster := testRayCluster.DeepCopy()
+	cluster.Spec.EnableInTreeAutoscaling = ptr.To(false)
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](2)
+	cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+	cluster.Spec.WorkerGroupSpecs[0].NumOfHosts = 2
+	cluster.Spec.WorkerGroupSpecs[0].Topology = &rayv1.WorkerGroupTopology{RequiredTopologyKey: topologyKey}
+
+	nodes := []runtime.Object{
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a1", Labels: map[string]string{topologyKey: "pool-a"}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a2", Labels: map[string]string{topologyKey: "pool-a"}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-b1", Labels: map[string]string{topologyKey: "pool-b"}}},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(append(nodes, cluster)...).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   &record.FakeRecorder{},
+		Scheme:                     newScheme,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+	}
+
+	// The hosts of each replica have a Pod affinity to the other hosts of the replica.
+	require.NoError(t, testRayClusterReconciler.reconcilePods(ctx, cluster))
+	podList := corev1.PodList{}
+	require.NoError(t, fakeClient.List(ctx, &podList, client.InNamespace(namespaceStr), client.MatchingLabels{utils.RayNodeGroupLabelKey: "small-group"}))
+	require.Len(t, podList.Items, 4)
+	replicas := make(map[string][]corev1.Pod)
+	for _, pod := range podList.Items {
+		replicaName := pod.Labels[utils.RayWorkerReplicaNameKey]
+		terms := pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+		require.Len(t, terms, 1)
+		assert.Equal(t, topologyKey, terms[0].TopologyKey)
+		assert.Equal(t, replicaName, terms[0].LabelSelector.MatchLabels[utils.RayWorkerReplicaNameKey])
+		replicas[replicaName] = append(replicas[replicaName], pod)
+	}
+	require.Len(t, replicas, 2)
+
+	// Schedule the hosts of one replica onto the same domain, and the hosts of the other replica onto different domains.
+	replicaNames := slices.Sorted(maps.Keys(replicas))
+	colocatedReplica, splitReplica := replicaNames[0], replicaNames[1]
+	for replicaName, nodeNames := range map[string][]string{colocatedReplica: {"node-a1", "node-a2"}, splitReplica: {"node-a1", "node-b1"}} {
+		for i := range replicas[replicaName] {
+			pod := &replicas[replicaName][i]
+			pod.Spec.NodeName = nodeNames[i]
+			require.NoError(t, fakeClient.Update(ctx, pod))
+		}
+	}
+
+	// The split replica is recreated, and the colocated replica is kept.
+	require.NoError(t, fakeClient.List(ctx, &podList, client.InNamespace(namespaceStr), client.MatchingLabels{utils.RayNodeGroupLabelKey: "small-group"}))
+	require.NoError(t, testRayClusterReconciler.reconcileMultiHostWorkerGroup(ctx, cluster, &cluster.Spec.WorkerGroupSpecs[0], podList.Items))
+	require.NoError(t, fakeClient.List(ctx, &podList, client.InNamespace(namespaceStr), client.MatchingLabels{utils.RayNodeGroupLabelKey: "small-group"}))
+	assert.Len(t, podList.Items, 4)
+	remainingReplicas := make(map[string]int)
+	for _, pod := range podList.Items {
+		remainingReplicas[pod.Labels[utils.RayWorkerReplicaNameKey]]++
+	}
+	assert.Equal(t, 2, remainingReplicas[colocatedReplica])
+	assert.NotContains(t, remainingReplicas, splitReplica)
+}
+
+func TestReconcileWorkerGroupFallbacks(t *testing.T) {
+	// The test cases use the names set by setupTest.
+	setupTest(t)
+	now := time.Now()
+	newWorkerPod := func(name string, conditions ...corev1.PodCondition) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespaceStr,
+				UID:       types.UID(name),
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:   instanceName,
+					utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+					utils.RayNodeGroupLabelKey: groupNameStr,
+				},
+			},
+			Status: corev1.PodStatus{Conditions: conditions},
+		}
+	}
+	preempted := func(ago time.Duration) corev1.PodCondition {
+		return corev1.PodCondition{
+			Type:               corev1.DisruptionTarget,
+			Status:             corev1.ConditionTrue,
+			Reason:             corev1.PodReasonTerminationByKubelet,
+			LastTransitionTime: metav1.NewTime(now.Add(-ago).Truncate(time.Second)),
+		}
+	}
+	evicted := func(ago time.Duration) corev1.PodCondition {
+		cond := preempted(ago)
+		cond.Reason = "EvictionByEvictionAPI"
+		return cond
+	}
+	unschedulable := func(ago time.Duration) corev1.PodCondition {
+		return corev1.PodCondition{
+			Type:               corev1.PodScheduled,
+			Status:             corev1.ConditionFalse,
+			Reason:             corev1.PodReasonUnschedulable,
+			LastTransitionTime: metav1.NewTime(now.Add(-ago).Truncate(time.Second)),
+		}
+	}
+
+	tests := []struct {
+		activeFallback                *rayv1.ActiveFallback
+		name                          string
+		pods                          []runtime.Object
+		countedPods                   []string
+		expectedEvents                []string
+		expectedPreemptions           int32
+		expectedRecentPreemptions     int
+		expectedReplicas              int32
+		expectedFallbackReplicas      int32
+		expectedApplied               bool
+		expectNextTransitionWithinTTL bool
+	}{
+		{
+			name:                      "records a preemption below the threshold",
+			pods:                      []runtime.Object{newWorkerPod("worker-1", preempted(time.Minute))},
+			expectedEvents:            []string{string(utils.PreemptedWorkerPod)},
+			expectedPreemptions:       1,
+			expectedRecentPreemptions: 1,
+			expectedReplicas:          3,
+			expectedFallbackReplicas:  1,
+		},
+		{
+			name: "falls back after repeated preemptions",
+			pods: []runtime.Object{
+				newWorkerPod("worker-1", preempted(3*time.Minute)),
+				newWorkerPod("worker-2", preempted(2*time.Minute)),
+				newWorkerPod("worker-3", preempted(time.Minute)),
+			},
+			expectedEvents: []string{
+				string(utils.PreemptedWorkerPod), string(utils.PreemptedWorkerPod), string(utils.PreemptedWorkerPod),
+				string(utils.StartedWorkerGroupFallback),
+			},
+			expectedPreemptions:           3,
+			expectedReplicas:              0,
+			expectedFallbackReplicas:      4,
+			expectedApplied:               true,
+			expectNextTransitionWithinTTL: true,
+		},
+		{
+			name:                     "doesn't count preemptions that are already recorded",
+			pods:                     []runtime.Object{newWorkerPod("worker-1", preempted(time.Minute))},
+			countedPods:              []string{"worker-1"},
+			expectedReplicas:         3,
+			expectedFallbackReplicas: 1,
+		},
+		{
+			name: "counts Pods preempted within the same second once each",
+			pods: []runtime.Object{
+				newWorkerPod("worker-1", preempted(time.Minute)),
+				newWorkerPod("worker-2", preempted(time.Minute)),
+			},
+			countedPods:               []string{"worker-1"},
+			expectedEvents:            []string{string(utils.PreemptedWorkerPod)},
+			expectedPreemptions:       1,
+			expectedRecentPreemptions: 1,
+			expectedReplicas:          3,
+			expectedFallbackReplicas:  1,
+		},
+		{
+			name:                     "doesn't count evictions",
+			pods:                     []runtime.Object{newWorkerPod("worker-1", evicted(time.Minute))},
+			expectedReplicas:         3,
+			expectedFallbackReplicas: 1,
+		},
+		{
+			name:                          "falls back when a Pod stays unschedulable",
+			pods:                          []runtime.Object{newWorkerPod("worker-1", unschedulable(10*time.Minute))},
+			expectedEvents:                []string{string(utils.StartedWorkerGroupFallback)},
+			expectedReplicas:              0,
+			expectedFallbackReplicas:      4,
+			expectedApplied:               true,
+			expectNextTransitionWithinTTL: true,
+		},
+		{
+			name:                          "waits for an unschedulable Pod until the timeout",
+			pods:                          []runtime.Object{newWorkerPod("worker-1", unschedulable(time.Minute))},
+			expectedReplicas:              3,
+			expectedFallbackReplicas:      1,
+			expectNextTransitionWithinTTL: true,
+		},
+		{
+			name: "keeps applying an active fallback",
+			activeFallback: &rayv1.ActiveFallback{
+				GroupName: "on-demand",
+				StartTime: metav1.NewTime(now.Add(-time.Minute)),
+				EndTime:   metav1.NewTime(now.Add(time.Minute)),
+			},
+			expectedReplicas:              0,
+			expectedFallbackReplicas:      4,
+			expectedApplied:               true,
+			expectNextTransitionWithinTTL: true,
+		},
+		{
+			name: "uses the replica settings of the spec again after a fallback ends",
+			activeFallback: &rayv1.ActiveFallback{
+				GroupName: "on-demand",
+				StartTime: metav1.NewTime(now.Add(-time.Hour)),
+				EndTime:   metav1.NewTime(now.Add(-time.Minute)),
+			},
+			expectedEvents:           []string{string(utils.EndedWorkerGroupFallback)},
+			expectedReplicas:         3,
+			expectedFallbackReplicas: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setupTest(t)
+			cluster := testRayCluster.DeepCopy()
+			cluster.Spec.WorkerGroupSpecs[0].Fallback = &rayv1.WorkerGroupFallback{GroupName: "on-demand"}
+			onDemand := *cluster.Spec.WorkerGroupSpecs[0].DeepCopy()
+			onDemand.GroupName = "on-demand"
+			onDemand.Replicas = ptr.To[int32](1)
+			onDemand.Fallback = nil
+			cluster.Spec.WorkerGroupSpecs = append(cluster.Spec.WorkerGroupSpecs, onDemand)
+			cluster.Status.WorkerGroupStatuses = []rayv1.WorkerGroupStatus{
+				{GroupName: groupNameStr, PreemptedPodUIDs: tc.countedPods, ActiveFallback: tc.activeFallback},
+			}
+
+			newScheme := runtime.NewScheme()
+			_ = rayv1.AddToScheme(newScheme)
+			_ = corev1.AddToScheme(newScheme)
+			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(append(tc.pods, cluster)...).Build()
+			recorder := record.NewFakeRecorder(10)
+			ctx := context.Background()
+			testRayClusterReconciler := &RayClusterReconciler{
+				Client:   fakeClient,
+				Recorder: recorder,
+				Scheme:   newScheme,
+			}
+
+			instance := &rayv1.RayCluster{}
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: instanceName, Namespace: namespaceStr}, instance))
+			instance.Status = *cluster.Status.DeepCopy()
+			nextTransition, err := testRayClusterReconciler.reconcileWorkerGroupFallbacks(ctx, instance)
+			require.NoError(t, err)
+			if tc.expectNextTransitionWithinTTL {
+				assert.True(t, nextTransition.After(now))
+				assert.True(t, nextTransition.Before(now.Add(time.Hour)))
+			} else {
+				assert.True(t, nextTransition.IsZero())
+			}
+
+			// The fallbacks only apply to the in-memory RayCluster.
+			assert.Equal(t, tc.expectedReplicas, *instance.Spec.WorkerGroupSpecs[0].Replicas)
+			assert.Equal(t, tc.expectedFallbackReplicas, *instance.Spec.WorkerGroupSpecs[1].Replicas)
+			storedRayCluster := &rayv1.RayCluster{}
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: instanceName, Namespace: namespaceStr}, storedRayCluster))
+			assert.Equal(t, cluster.Spec, storedRayCluster.Spec)
+
+			// The preemptions and the fallback are recorded in the status of the worker group.
+			require.Len(t, instance.Status.WorkerGroupStatuses, 2)
+			status := instance.Status.WorkerGroupStatuses[0]
+			assert.Equal(t, groupNameStr, status.GroupName)
+			assert.Equal(t, tc.expectedApplied, status.ActiveFallback != nil)
+			assert.Equal(t, tc.expectedPreemptions, status.Preemptions)
+			assert.Len(t, status.RecentPreemptionTimes, tc.expectedRecentPreemptions)
+
+			require.Len(t, recorder.Events, len(tc.expectedEvents))
+			for _, expectedEvent := range tc.expectedEvents {
+				assert.Contains(t, <-recorder.Events, expectedEvent)
+			}
+		})
+	}
+}
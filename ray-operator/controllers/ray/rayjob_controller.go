@@ -14,6 +14,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -28,6 +29,7 @@ import (
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler"
+	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/metrics"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
@@ -53,11 +55,16 @@ type RayJobReconciler struct {
 type RayJobReconcilerOptions struct {
 	RayJobMetricsManager  *metrics.RayJobMetricsManager
 	BatchSchedulerManager *batchscheduler.SchedulerManager
+	// DashboardCache fetches the job info in the background when the AsyncJobInfoQuery feature gate is enabled.
+	DashboardCache *dashboardclient.RayDashboardCache
 }
 
 // NewRayJobReconciler returns a new reconcile.Reconciler
 func NewRayJobReconciler(ctx context.Context, mgr manager.Manager, options RayJobReconcilerOptions, provider utils.ClientProvider) *RayJobReconciler {
 	dashboardClientFunc := provider.GetDashboardClient(ctx, mgr)
+	if options.DashboardCache != nil {
+		dashboardClientFunc = withDashboardCache(options.DashboardCache, dashboardClientFunc)
+	}
 	return &RayJobReconciler{
 		Client:              mgr.GetClient(),
 		Scheme:              mgr.GetScheme(),
@@ -67,6 +74,17 @@ func NewRayJobReconciler(ctx context.Context, mgr manager.Manager, options RayJo
 	}
 }
 
+// withDashboardCache wraps the dashboard clients of RayClusters with clients that serve the job info from the cache.
+func withDashboardCache(cache *dashboardclient.RayDashboardCache, dashboardClientFunc func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error)) func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error) {
+	return func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error) {
+		dashboardClient, err := dashboardClientFunc(rayCluster, url)
+		if err != nil || rayCluster == nil {
+			return dashboardClient, err
+		}
+		return cache.NewClient(types.NamespacedName{Name: rayCluster.Name, Namespace: rayCluster.Namespace}, dashboardClient), nil
+	}
+}
+
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs/finalizers,verbs=update
@@ -120,7 +138,7 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			if err := r.Get(ctx, rayClusterNamespacedName, rayClusterInstance); err != nil {
 				logger.Error(err, "Failed to get RayCluster")
 
-				if features.Enabled(features.AsyncJobInfoQuery) {
+				if r.options.DashboardCache != nil {
 					// If the RayCluster is already deleted, we provide the name and namespace to the RayClusterInstance
 					// for the dashboard client to remove cache correctly.
 					rayClusterInstance.Name = rayClusterNamespacedName.Name
@@ -205,6 +223,10 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			} else {
 				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 			}
+			// The batch scheduler may suspend the RayJob until it admits the RayJob.
+			if shouldUpdate := updateStatusToSuspendingIfNeeded(ctx, rayJobInstance); shouldUpdate {
+				break
+			}
 		}
 
 		var rayClusterInstance *rayv1.RayCluster
@@ -212,6 +234,12 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 		}
 
+		if rayJobInstance.Spec.WaitForImagePrePull && !meta.IsStatusConditionTrue(rayClusterInstance.Status.Conditions, string(rayv1.RayClusterImagesPrePulled)) {
+			logger.Info("Wait for the images of the RayCluster to be pre-pulled before submitting the job.", "RayCluster", rayClusterInstance.Name)
+			rayJobInstance.Status.RayClusterStatus = rayClusterInstance.Status
+			break
+		}
+
 		// Check the current status of RayCluster before submitting.
 		if clientURL := rayJobInstance.Status.DashboardURL; clientURL == "" {
 			if rayClusterInstance.Status.State != rayv1.Ready {
@@ -274,6 +302,14 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 		}
 
+		shouldUpdate, err := r.checkPreemptionAndUpdateStatusIfNeeded(ctx, rayJobInstance, rayClusterInstance)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+		}
+		if shouldUpdate {
+			break
+		}
+
 		err = r.reconcileServices(ctx, rayJobInstance, rayClusterInstance)
 		if err != nil {
 			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
@@ -394,8 +430,11 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		rayJobInstance.Status.RayClusterName = ""
 		rayJobInstance.Status.DashboardURL = ""
 		rayJobInstance.Status.JobId = ""
-		rayJobInstance.Status.Message = ""
-		rayJobInstance.Status.Reason = ""
+		// The preemption is reported until the next attempt starts.
+		if rayJobInstance.Status.Reason != rayv1.Preempted {
+			rayJobInstance.Status.Message = ""
+			rayJobInstance.Status.Reason = ""
+		}
 		rayJobInstance.Status.RayJobStatusInfo = rayv1.RayJobStatusInfo{}
 		// Reset the JobStatus to JobStatusNew and transition the JobDeploymentStatus to `Suspended`.
 		rayJobInstance.Status.JobStatus = rayv1.JobStatusNew
@@ -413,6 +452,23 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			rayJobInstance.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusNew
 			break
 		}
+		// Batch schedulers that admit suspended RayJobs, such as Kueue, resume the RayJob once it is admitted.
+		if r.options.BatchSchedulerManager != nil {
+			scheduler, err := r.options.BatchSchedulerManager.GetScheduler()
+			if err != nil {
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+			}
+			if admitter, ok := scheduler.(schedulerinterface.SuspendedJobAdmitter); ok {
+				requeue, err := admitter.AdmitSuspendedJob(ctx, rayJobInstance)
+				if err != nil {
+					return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+				}
+				if requeue {
+					return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
+				}
+				return ctrl.Result{}, nil
+			}
+		}
 		// The RayJob is already suspended, we should not requeue it.
 		return ctrl.Result{}, nil
 	case rayv1.JobDeploymentStatusComplete, rayv1.JobDeploymentStatusFailed:
@@ -549,6 +605,72 @@ func checkBackoffLimitAndUpdateStatusIfNeeded(ctx context.Context, rayJob *rayv1
 	}
 }
 
+// checkPreemptionAndUpdateStatusIfNeeded checks whether the head Pod of the RayCluster, or a worker Pod of a RayCluster
+// scheduled as a gang, was preempted by a higher-priority workload. Other preempted worker Pods are recreated by the
+// RayCluster controller. If so, the RayJob is suspended if its batch scheduler admits suspended RayJobs, so that it's
+// queued again, and fails otherwise. Like other failures, the preemption is retried up to `BackoffLimit` times.
+// RayJobs that use an existing RayCluster are not checked.
+func (r *RayJobReconciler) checkPreemptionAndUpdateStatusIfNeeded(ctx context.Context, rayJob *rayv1.RayJob, rayCluster *rayv1.RayCluster) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	if len(rayJob.Spec.ClusterSelector) != 0 {
+		return false, nil
+	}
+
+	var scheduler schedulerinterface.BatchScheduler
+	if r.options.BatchSchedulerManager != nil {
+		var err error
+		if scheduler, err = r.options.BatchSchedulerManager.GetScheduler(); err != nil {
+			return false, err
+		}
+	}
+	isGangScheduled := false
+	if gangScheduler, ok := scheduler.(schedulerinterface.GangScheduler); ok {
+		isGangScheduled = gangScheduler.IsGangScheduled(rayJob)
+	}
+
+	pods := corev1.PodList{}
+	if err := r.List(ctx, &pods, common.RayClusterAllPodsAssociationOptions(rayCluster).ToListOptions()...); err != nil {
+		return false, err
+	}
+	// The head Pod is reported if it's preempted, since the whole RayCluster is lost with it.
+	var preemptedPod *corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		isHead := pod.Labels[utils.RayNodeTypeLabelKey] == string(rayv1.HeadNode)
+		if !utils.IsPodPreempted(pod) || (!isHead && !isGangScheduled) {
+			continue
+		}
+		if preemptedPod == nil || isHead {
+			preemptedPod = pod
+		}
+	}
+	if preemptedPod == nil {
+		return false, nil
+	}
+
+	suspend := false
+	if admitter, ok := scheduler.(schedulerinterface.SuspendedJobAdmitter); ok && rayJob.Spec.ShutdownAfterJobFinishes {
+		suspend = admitter.ManagesRayJob(rayJob)
+	}
+
+	if suspend {
+		rayJob.Spec.Suspend = true
+		if err := r.Update(ctx, rayJob); err != nil {
+			return false, err
+		}
+		rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusSuspending
+	} else {
+		// `checkBackoffLimitAndUpdateStatusIfNeeded` transitions the status to `Retrying` if the RayJob can be retried.
+		rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
+	}
+	rayJob.Status.Reason = rayv1.Preempted
+	rayJob.Status.Message = fmt.Sprintf("The Pod %s of RayCluster %s was preempted.", preemptedPod.Name, rayCluster.Name)
+	logger.Info("The RayCluster was preempted", "RayCluster", rayCluster.Name, "pod", preemptedPod.Name, "JobDeploymentStatus", rayJob.Status.JobDeploymentStatus)
+	r.Recorder.Eventf(rayJob, corev1.EventTypeWarning, string(utils.PreemptedRayCluster),
+		"The Pod %s/%s of RayCluster %s was preempted, transition the status to %s", preemptedPod.Namespace, preemptedPod.Name, rayCluster.Name, rayJob.Status.JobDeploymentStatus)
+	return true, nil
+}
+
 // createK8sJobIfNeed creates a Kubernetes Job for the RayJob if it doesn't exist.
 func (r *RayJobReconciler) createK8sJobIfNeed(ctx context.Context, rayJobInstance *rayv1.RayJob, rayClusterInstance *rayv1.RayCluster) error {
 	logger := ctrl.LoggerFrom(ctx)
@@ -580,6 +702,9 @@ func (r *RayJobReconciler) createK8sJobIfNeed(ctx context.Context, rayJobInstanc
 func getSubmitterTemplate(rayJobInstance *rayv1.RayJob, rayClusterInstance *rayv1.RayCluster) (corev1.PodTemplateSpec, error) {
 	// Set the default value for the optional field SubmitterPodTemplate if not provided.
 	submitterTemplate := common.GetSubmitterTemplate(&rayJobInstance.Spec, &rayClusterInstance.Spec)
+	if submitterTemplate.Spec.PriorityClassName == "" {
+		submitterTemplate.Spec.PriorityClassName = rayJobInstance.Spec.PriorityClassName
+	}
 
 	if err := configureSubmitterContainer(&submitterTemplate.Spec.Containers[utils.RayContainerIndex], rayJobInstance, rayClusterInstance, rayv1.K8sJobMode); err != nil {
 		return corev1.PodTemplateSpec{}, err
@@ -588,6 +713,9 @@ func getSubmitterTemplate(rayJobInstance *rayv1.RayJob, rayClusterInstance *rayv
 	if rayClusterInstance != nil && utils.IsK8sAuthEnabled(rayClusterInstance.Spec.AuthOptions) {
 		common.AddRayTokenVolume(&submitterTemplate.Spec)
 	}
+	if rayClusterInstance != nil && utils.IsTLSEnabled(&rayClusterInstance.Spec) {
+		common.AddRayTLSVolume(&submitterTemplate.Spec, rayClusterInstance.Spec.TLSOptions)
+	}
 
 	return submitterTemplate, nil
 }
@@ -605,8 +733,9 @@ func getSubmitterContainer(rayJobInstance *rayv1.RayJob, rayClusterInstance *ray
 
 // pass the RayCluster instance for cluster selector case
 func configureSubmitterContainer(container *corev1.Container, rayJobInstance *rayv1.RayJob, rayClusterInstance *rayv1.RayCluster, submissionMode rayv1.JobSubmissionMode) error {
+	httpsEnabled := rayClusterInstance != nil && utils.IsHTTPSEnabled(&rayClusterInstance.Spec)
 	// If the command in the submitter container manifest isn't set, use the default command.
-	jobCmd, err := common.BuildJobSubmitCommand(rayJobInstance, submissionMode)
+	jobCmd, err := common.BuildJobSubmitCommand(rayJobInstance, submissionMode, httpsEnabled)
 	if err != nil {
 		return err
 	}
@@ -630,6 +759,10 @@ func configureSubmitterContainer(container *corev1.Container, rayJobInstance *ra
 	if rayClusterInstance != nil && utils.IsAuthEnabled(&rayClusterInstance.Spec) {
 		common.SetContainerTokenAuthEnvVars(rayClusterInstance.Name, container, rayClusterInstance.Spec.AuthOptions)
 	}
+	// The submitter uses the same TLS configuration as the Ray containers.
+	if rayClusterInstance != nil && utils.IsTLSEnabled(&rayClusterInstance.Spec) {
+		common.SetContainerTLSEnvVars(container)
+	}
 
 	return nil
 }
@@ -885,6 +1018,11 @@ func initRayJobStatusIfNeed(ctx context.Context, rayJob *rayv1.RayJob) {
 	if rayJob.Status.JobStatus == "" {
 		rayJob.Status.JobStatus = rayv1.JobStatusNew
 	}
+	// A new attempt starts, so the preemption of the previous attempt is no longer reported.
+	if rayJob.Status.Reason == rayv1.Preempted {
+		rayJob.Status.Reason = ""
+		rayJob.Status.Message = ""
+	}
 	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusInitializing
 	rayJob.Status.StartTime = &metav1.Time{Time: time.Now()}
 }
@@ -988,6 +1126,9 @@ func (r *RayJobReconciler) constructRayClusterForRayJob(rayJobInstance *rayv1.Ra
 		},
 		Spec: *rayJobInstance.Spec.RayClusterSpec.DeepCopy(),
 	}
+	if rayCluster.Spec.PriorityClassName == "" {
+		rayCluster.Spec.PriorityClassName = rayJobInstance.Spec.PriorityClassName
+	}
 
 	// Set the ownership in order to do the garbage collection by k8s.
 	if err := ctrl.SetControllerReference(rayJobInstance, rayCluster, r.Scheme); err != nil {
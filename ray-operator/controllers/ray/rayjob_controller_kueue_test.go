@@ -0,0 +1,136 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ray
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/kueue"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// listWorkloadsForRayJob returns the number of Kueue Workloads created for the RayJob.
+func listWorkloadsForRayJob(ctx context.Context, rayJob *rayv1.RayJob) func() (int, error) {
+	return func() (int, error) {
+		workloads := &unstructured.UnstructuredList{}
+		workloads.SetGroupVersionKind(kueue.WorkloadGVK)
+		err := k8sClient.List(ctx, workloads, client.InNamespace(rayJob.Namespace), client.MatchingLabels{
+			utils.RayOriginatedFromCRNameLabelKey: rayJob.Name,
+		})
+		return len(workloads.Items), err
+	}
+}
+
+var _ = Context("RayJob queued by Kueue", func() {
+	Describe("When creating a RayJob with the Kueue queue name label", Ordered, func() {
+		ctx := context.Background()
+		namespace := "default"
+		rayCluster := &rayv1.RayCluster{}
+		rayJob := rayJobTemplate("rayjob-kueue", namespace)
+		rayJob.Labels = map[string]string{kueue.QueueNameLabelKey: "user-queue"}
+
+		It("should create a RayJob object", func() {
+			err := k8sClient.Create(ctx, rayJob)
+			Expect(err).NotTo(HaveOccurred(), "failed to create test RayJob resource")
+		})
+
+		It("should create a Workload for the RayJob", func() {
+			Eventually(
+				listWorkloadsForRayJob(ctx, rayJob),
+				time.Second*5, time.Millisecond*500).Should(Equal(1))
+		})
+
+		It("should suspend the RayJob until the Workload is admitted", func() {
+			Eventually(
+				getRayJobDeploymentStatus(ctx, rayJob),
+				time.Second*5, time.Millisecond*500).Should(Equal(rayv1.JobDeploymentStatusSuspended))
+			Consistently(
+				getRayClusterNameForRayJob(ctx, rayJob),
+				time.Second*3, time.Millisecond*500).Should(BeEmpty())
+		})
+
+		It("should admit the Workload", func() {
+			err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				workloads := &unstructured.UnstructuredList{}
+				workloads.SetGroupVersionKind(kueue.WorkloadGVK)
+				if err := k8sClient.List(ctx, workloads, client.InNamespace(namespace), client.MatchingLabels{
+					utils.RayOriginatedFromCRNameLabelKey: rayJob.Name,
+				}); err != nil {
+					return err
+				}
+				Expect(workloads.Items).To(HaveLen(1))
+				workload := &workloads.Items[0]
+				conditions := []any{map[string]any{
+					"type":               "Admitted",
+					"status":             string(metav1.ConditionTrue),
+					"reason":             "Admitted",
+					"message":            "The workload is admitted",
+					"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+				}}
+				if err := unstructured.SetNestedSlice(workload.Object, conditions, "status", "conditions"); err != nil {
+					return err
+				}
+				return k8sClient.Status().Update(ctx, workload)
+			})
+			Expect(err).NotTo(HaveOccurred(), "failed to admit the Workload")
+		})
+
+		It("should resume the RayJob and create its RayCluster", func() {
+			Eventually(
+				getRayClusterNameForRayJob(ctx, rayJob),
+				time.Second*15, time.Millisecond*500).Should(Not(BeEmpty()))
+			Eventually(
+				getResourceFunc(ctx, common.RayJobRayClusterNamespacedName(rayJob), rayCluster),
+				time.Second*15, time.Millisecond*500).Should(Succeed())
+		})
+	})
+
+	Describe("When creating a RayJob with the Kueue queue name label that keeps its RayCluster", Ordered, func() {
+		ctx := context.Background()
+		namespace := "default"
+		rayCluster := &rayv1.RayCluster{}
+		rayJob := rayJobTemplate("rayjob-kueue-no-shutdown", namespace)
+		rayJob.Labels = map[string]string{kueue.QueueNameLabelKey: "user-queue"}
+		rayJob.Spec.ShutdownAfterJobFinishes = false
+
+		It("should create a RayJob object", func() {
+			err := k8sClient.Create(ctx, rayJob)
+			Expect(err).NotTo(HaveOccurred(), "failed to create test RayJob resource")
+		})
+
+		It("should create the RayCluster without queueing the RayJob", func() {
+			Eventually(
+				getRayClusterNameForRayJob(ctx, rayJob),
+				time.Second*15, time.Millisecond*500).Should(Not(BeEmpty()))
+			Eventually(
+				getResourceFunc(ctx, common.RayJobRayClusterNamespacedName(rayJob), rayCluster),
+				time.Second*15, time.Millisecond*500).Should(Succeed())
+			Consistently(
+				listWorkloadsForRayJob(ctx, rayJob),
+				time.Second*3, time.Millisecond*500).Should(Equal(0))
+		})
+	})
+})
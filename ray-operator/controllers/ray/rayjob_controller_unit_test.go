@@ -39,6 +39,12 @@ type fakeBatchScheduler struct {
 	cleanupObject    metav1.Object
 	cleanupDidUpdate bool
 	cleanupErr       error
+
+	resizeGroupName   string
+	resizeDesiredPods int32
+	resizeErr         error
+	// resizeErrGroup limits resizeErr to the worker group if it is set.
+	resizeErrGroup string
 }
 
 func (f *fakeBatchScheduler) CleanupOnCompletion(_ context.Context, object metav1.Object) (bool, error) {
@@ -47,6 +53,37 @@ func (f *fakeBatchScheduler) CleanupOnCompletion(_ context.Context, object metav
 	return f.cleanupDidUpdate, f.cleanupErr
 }
 
+func (f *fakeBatchScheduler) OnWorkerGroupReplicasChange(_ context.Context, _ *rayv1.RayCluster, groupName string, desiredPods int32) error {
+	f.resizeGroupName = groupName
+	f.resizeDesiredPods = desiredPods
+	if f.resizeErrGroup != "" && f.resizeErrGroup != groupName {
+		return nil
+	}
+	return f.resizeErr
+}
+
+// fakeSuspendedJobAdmitter implements schedulerinterface.SuspendedJobAdmitter for testing.
+type fakeSuspendedJobAdmitter struct {
+	fakeBatchScheduler
+}
+
+func (f *fakeSuspendedJobAdmitter) ManagesRayJob(_ *rayv1.RayJob) bool {
+	return true
+}
+
+func (f *fakeSuspendedJobAdmitter) AdmitSuspendedJob(_ context.Context, _ *rayv1.RayJob) (bool, error) {
+	return true, nil
+}
+
+// fakeGangScheduler implements schedulerinterface.GangScheduler for testing.
+type fakeGangScheduler struct {
+	fakeBatchScheduler
+}
+
+func (f *fakeGangScheduler) IsGangScheduled(_ *rayv1.RayJob) bool {
+	return true
+}
+
 func TestCreateRayJobSubmitterIfNeed(t *testing.T) {
 	newScheme := runtime.NewScheme()
 	_ = rayv1.AddToScheme(newScheme)
@@ -825,3 +862,212 @@ func TestBatchSchedulerOnCompletionCalledWhenRayJobComplete(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckPreemptionAndUpdateStatusIfNeeded(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-raycluster",
+			Namespace: "default",
+		},
+	}
+	newHeadPod := func(conditions ...corev1.PodCondition) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-raycluster-head",
+				Namespace: "default",
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:  rayCluster.Name,
+					utils.RayNodeTypeLabelKey: string(rayv1.HeadNode),
+				},
+			},
+			Status: corev1.PodStatus{Conditions: conditions},
+		}
+	}
+	newWorkerPod := func(conditions ...corev1.PodCondition) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-raycluster-worker",
+				Namespace: "default",
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:  rayCluster.Name,
+					utils.RayNodeTypeLabelKey: string(rayv1.WorkerNode),
+				},
+			},
+			Status: corev1.PodStatus{Conditions: conditions},
+		}
+	}
+	preemptedCondition := corev1.PodCondition{
+		Type:   corev1.DisruptionTarget,
+		Status: corev1.ConditionTrue,
+		Reason: corev1.PodReasonPreemptionByScheduler,
+	}
+
+	tests := []struct {
+		scheduler                   schedulerinterface.BatchScheduler
+		headPod                     *corev1.Pod
+		workerPod                   *corev1.Pod
+		clusterSelector             map[string]string
+		name                        string
+		expectedJobDeploymentStatus rayv1.JobDeploymentStatus
+		expectedMessage             string
+		backoffLimit                int32
+		shutdownAfterJobFinishes    bool
+		expectedShouldUpdate        bool
+		expectedSuspend             bool
+	}{
+		{
+			name:                        "head Pod is not preempted",
+			headPod:                     newHeadPod(corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionTrue}),
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+		},
+		{
+			name:                        "head Pod is evicted for another reason",
+			headPod:                     newHeadPod(corev1.PodCondition{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue, Reason: "EvictionByEvictionAPI"}),
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+		},
+		{
+			name:                        "preempted RayJob is retried",
+			headPod:                     newHeadPod(preemptedCondition),
+			backoffLimit:                1,
+			expectedShouldUpdate:        true,
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusRetrying,
+			expectedMessage:             "The Pod test-raycluster-head of RayCluster test-raycluster was preempted.",
+		},
+		{
+			name:                        "preempted RayJob fails once its backoff limit is reached",
+			headPod:                     newHeadPod(preemptedCondition),
+			expectedShouldUpdate:        true,
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusFailed,
+			expectedMessage:             "The Pod test-raycluster-head of RayCluster test-raycluster was preempted.",
+		},
+		{
+			name:                        "preempted worker Pod is recreated by the RayCluster controller",
+			headPod:                     newHeadPod(),
+			workerPod:                   newWorkerPod(preemptedCondition),
+			backoffLimit:                1,
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+		},
+		{
+			name:                        "RayJob whose gang loses a preempted worker Pod is retried",
+			headPod:                     newHeadPod(),
+			workerPod:                   newWorkerPod(preemptedCondition),
+			scheduler:                   &fakeGangScheduler{},
+			backoffLimit:                1,
+			expectedShouldUpdate:        true,
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusRetrying,
+			expectedMessage:             "The Pod test-raycluster-worker of RayCluster test-raycluster was preempted.",
+		},
+		{
+			name:                        "preempted head Pod is reported before a preempted worker Pod",
+			headPod:                     newHeadPod(preemptedCondition),
+			workerPod:                   newWorkerPod(preemptedCondition),
+			scheduler:                   &fakeGangScheduler{},
+			backoffLimit:                1,
+			expectedShouldUpdate:        true,
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusRetrying,
+			expectedMessage:             "The Pod test-raycluster-head of RayCluster test-raycluster was preempted.",
+		},
+		{
+			name:                        "RayJob that uses an existing RayCluster is not checked",
+			headPod:                     newHeadPod(preemptedCondition),
+			clusterSelector:             map[string]string{utils.RayJobClusterSelectorKey: rayCluster.Name},
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+		},
+		{
+			name:                        "preempted RayJob is suspended if the batch scheduler admits suspended RayJobs",
+			headPod:                     newHeadPod(preemptedCondition),
+			scheduler:                   &fakeSuspendedJobAdmitter{},
+			shutdownAfterJobFinishes:    true,
+			expectedShouldUpdate:        true,
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusSuspending,
+			expectedMessage:             "The Pod test-raycluster-head of RayCluster test-raycluster was preempted.",
+			expectedSuspend:             true,
+		},
+		{
+			name:                        "preempted RayJob that keeps its RayCluster is retried instead of suspended",
+			headPod:                     newHeadPod(preemptedCondition),
+			scheduler:                   &fakeSuspendedJobAdmitter{},
+			backoffLimit:                1,
+			expectedShouldUpdate:        true,
+			expectedJobDeploymentStatus: rayv1.JobDeploymentStatusRetrying,
+			expectedMessage:             "The Pod test-raycluster-head of RayCluster test-raycluster was preempted.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rayJob := &rayv1.RayJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-rayjob",
+					Namespace: "default",
+				},
+				Spec: rayv1.RayJobSpec{
+					BackoffLimit:             ptr.To(tc.backoffLimit),
+					ShutdownAfterJobFinishes: tc.shutdownAfterJobFinishes,
+					ClusterSelector:          tc.clusterSelector,
+				},
+				Status: rayv1.RayJobStatus{
+					JobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+					Failed:              ptr.To[int32](0),
+				},
+			}
+			objects := []runtime.Object{rayJob, tc.headPod}
+			if tc.workerPod != nil {
+				objects = append(objects, tc.workerPod)
+			}
+			fakeClient := clientFake.NewClientBuilder().
+				WithScheme(newScheme).
+				WithRuntimeObjects(objects...).
+				Build()
+			recorder := record.NewFakeRecorder(100)
+			reconciler := &RayJobReconciler{
+				Client:   fakeClient,
+				Recorder: recorder,
+				Scheme:   newScheme,
+			}
+			if tc.scheduler != nil {
+				reconciler.options.BatchSchedulerManager = batchscheduler.NewSchedulerManagerForTest(tc.scheduler)
+			}
+
+			shouldUpdate, err := reconciler.checkPreemptionAndUpdateStatusIfNeeded(context.Background(), rayJob, rayCluster)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedShouldUpdate, shouldUpdate)
+			assert.Equal(t, tc.expectedSuspend, rayJob.Spec.Suspend)
+			if !tc.expectedShouldUpdate {
+				assert.Equal(t, tc.expectedJobDeploymentStatus, rayJob.Status.JobDeploymentStatus)
+				return
+			}
+			assert.Equal(t, rayv1.Preempted, rayJob.Status.Reason)
+			assert.Equal(t, tc.expectedMessage, rayJob.Status.Message)
+			assert.Len(t, recorder.Events, 1)
+
+			// Preemptions that aren't suspended count toward BackoffLimit like other failures.
+			checkBackoffLimitAndUpdateStatusIfNeeded(context.Background(), rayJob)
+			assert.Equal(t, tc.expectedJobDeploymentStatus, rayJob.Status.JobDeploymentStatus)
+			if !tc.expectedSuspend {
+				assert.Equal(t, int32(1), *rayJob.Status.Failed)
+			}
+			assert.Equal(t, rayv1.Preempted, rayJob.Status.Reason)
+		})
+	}
+}
+
+func TestInitRayJobStatusIfNeedClearsPreemption(t *testing.T) {
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: "default"},
+		Status: rayv1.RayJobStatus{
+			JobDeploymentStatus: rayv1.JobDeploymentStatusNew,
+			Reason:              rayv1.Preempted,
+			Message:             "The Pod test-raycluster-head of RayCluster test-raycluster was preempted.",
+		},
+	}
+
+	initRayJobStatusIfNeed(context.Background(), rayJob)
+	assert.Equal(t, rayv1.JobDeploymentStatusInitializing, rayJob.Status.JobDeploymentStatus)
+	assert.Empty(t, rayJob.Status.Reason)
+	assert.Empty(t, rayJob.Status.Message)
+}
@@ -1,6 +1,7 @@
 package ray
 
 import (
+	"cmp"
 	"context"
 	errstd "errors"
 	"fmt"
@@ -8,12 +9,14 @@ import (
 	"math"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	cmap "github.com/orcaman/concurrent-map/v2"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -47,6 +51,11 @@ const (
 	ServiceDefaultRequeueDuration   = 2 * time.Second
 	RayClusterDeletionDelayDuration = 60 * time.Second
 	ENABLE_ZERO_DOWNTIME            = "ENABLE_ZERO_DOWNTIME"
+
+	// serveConfigConfigMapIndexKey and serveConfigSecretIndexKey index RayServices by the name of the ConfigMap or
+	// Secret that their `serveConfigV2From` references.
+	serveConfigConfigMapIndexKey = "spec.serveConfigV2From.configMapKeyRef.name"
+	serveConfigSecretIndexKey    = "spec.serveConfigV2From.secretKeyRef.name"
 )
 
 // RayServiceReconciler reconciles a RayService object
@@ -61,7 +70,7 @@ type RayServiceReconciler struct {
 	ServeConfigs                 *lru.Cache
 	RayClusterDeletionTimestamps cmap.ConcurrentMap[string, time.Time]
 	dashboardClientFunc          func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error)
-	httpProxyClientFunc          func(hostIp, podNamespace, podName string, port int) utils.RayHttpProxyClientInterface
+	httpProxyClientFunc          func(rayCluster *rayv1.RayCluster, hostIp, podNamespace, podName string, port int) (utils.RayHttpProxyClientInterface, error)
 }
 
 // NewRayServiceReconciler returns a new reconcile.Reconciler
@@ -95,6 +104,9 @@ func NewRayServiceReconciler(ctx context.Context, mgr manager.Manager, provider
 // +kubebuilder:rbac:groups=core,resources=services/proxy,verbs=get;update;patch
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;create;update
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=apps,resources=controllerrevisions,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups="gateway.networking.k8s.io",resources=gateways,verbs=get;list;watch;create;update;
 // +kubebuilder:rbac:groups="gateway.networking.k8s.io",resources=httproutes,verbs=get;list;watch;create;update;
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=roles,verbs=get;list;watch;create;delete;update
@@ -123,6 +135,15 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		return ctrl.Result{}, nil
 	}
 
+	// Restore the spec from a revision if a rollback is requested. The spec update triggers a new reconciliation,
+	// and the restored spec then goes through the normal upgrade path.
+	if revision, ok := rayServiceInstance.Annotations[utils.RayServiceRollbackToRevisionAnnotationKey]; ok {
+		if err := r.rollbackToRevision(ctx, rayServiceInstance, revision); err != nil {
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// Perform all validations and directly fail the RayService if any of the validation fails
 	errType, err := validateRayService(ctx, rayServiceInstance)
 	// Immediately update the status after validation
@@ -186,6 +207,18 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
 	}
 
+	// Resolve the Serve config, which may be stored in a ConfigMap or Secret referenced by `serveConfigV2From`.
+	serveConfigV2, err := r.getServeConfigV2(ctx, rayServiceInstance)
+	if err != nil {
+		r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToGetServeConfig),
+			"Failed to get the Serve config for RayService %s/%s: %v", rayServiceInstance.Namespace, rayServiceInstance.Name, err)
+		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+	}
+
+	if err := r.reconcileRevisionHistory(ctx, rayServiceInstance, serveConfigV2); err != nil {
+		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+	}
+
 	// Reconcile serve applications for active and/or pending clusters
 	// 1. If there is a pending cluster, reconcile serve applications for the pending cluster.
 	// 2. If there are both active and pending clusters, reconcile serve applications for the pending cluster only.
@@ -196,7 +229,7 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 	var activeClusterServeApplications, pendingClusterServeApplications map[string]rayv1.AppStatus = nil, nil
 	if pendingRayClusterInstance != nil {
 		logger.Info("Reconciling the Serve applications for pending cluster", "clusterName", pendingRayClusterInstance.Name)
-		if isPendingClusterReady, pendingClusterServeApplications, err = r.reconcileServe(ctx, rayServiceInstance, pendingRayClusterInstance); err != nil {
+		if isPendingClusterReady, pendingClusterServeApplications, err = r.reconcileServe(ctx, rayServiceInstance, pendingRayClusterInstance, serveConfigV2); err != nil {
 			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
 		}
 	}
@@ -205,12 +238,12 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		// Only reconcile serve applications for the active cluster when there is no pending cluster. That is, during the upgrade process,
 		// in-place update and updating the serve application status for the active cluster will not work.
 		logger.Info("Reconciling the Serve applications for active cluster", "clusterName", activeRayClusterInstance.Name)
-		if isActiveClusterReady, activeClusterServeApplications, err = r.reconcileServe(ctx, rayServiceInstance, activeRayClusterInstance); err != nil {
+		if isActiveClusterReady, activeClusterServeApplications, err = r.reconcileServe(ctx, rayServiceInstance, activeRayClusterInstance, serveConfigV2); err != nil {
 			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
 		}
 	} else if activeRayClusterInstance != nil && pendingRayClusterInstance != nil && utils.IsIncrementalUpgradeEnabled(&rayServiceInstance.Spec) {
 		logger.Info("Reconciling the Serve applications for active cluster during NewClusterWithIncrementalUpgrade", "clusterName", activeRayClusterInstance.Name)
-		if isActiveClusterReady, activeClusterServeApplications, err = r.reconcileServe(ctx, rayServiceInstance, activeRayClusterInstance); err != nil {
+		if isActiveClusterReady, activeClusterServeApplications, err = r.reconcileServe(ctx, rayServiceInstance, activeRayClusterInstance, serveConfigV2); err != nil {
 			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
 		}
 	}
@@ -574,6 +607,14 @@ func setCondition(rayServiceInstance *rayv1.RayService, conditionType rayv1.RayS
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RayServiceReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcurrency int) error {
+	indexer := mgr.GetFieldIndexer()
+	if err := indexer.IndexField(context.Background(), &rayv1.RayService{}, serveConfigConfigMapIndexKey, indexServeConfigConfigMap); err != nil {
+		return err
+	}
+	if err := indexer.IndexField(context.Background(), &rayv1.RayService{}, serveConfigSecretIndexKey, indexServeConfigSecret); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&rayv1.RayService{}, builder.WithPredicates(predicate.Or(
 			predicate.GenerationChangedPredicate{},
@@ -582,6 +623,8 @@ func (r *RayServiceReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcu
 		))).
 		Owns(&rayv1.RayCluster{}).
 		Owns(&corev1.Service{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.rayServicesForServeConfigSource)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.rayServicesForServeConfigSource)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: reconcileConcurrency,
 			LogConstructor: func(request *reconcile.Request) logr.Logger {
@@ -1087,6 +1130,175 @@ func (r *RayServiceReconciler) cleanUpServeConfigCache(ctx context.Context, rayS
 	}
 }
 
+// listRevisions returns the revisions of the RayService sorted by revision number in ascending order.
+func (r *RayServiceReconciler) listRevisions(ctx context.Context, rayServiceInstance *rayv1.RayService) ([]appsv1.ControllerRevision, error) {
+	revisionList := appsv1.ControllerRevisionList{}
+	if err := r.List(ctx, &revisionList, common.RayServiceRevisionsAssociationOptions(rayServiceInstance).ToListOptions()...); err != nil {
+		return nil, err
+	}
+	revisions := revisionList.Items
+	slices.SortFunc(revisions, func(a, b appsv1.ControllerRevision) int {
+		return cmp.Compare(a.Revision, b.Revision)
+	})
+	return revisions, nil
+}
+
+// reconcileRevisionHistory records the RayCluster spec and the resolved Serve config of the RayService as a
+// ControllerRevision and deletes the oldest revisions beyond `revisionHistoryLimit`. If the current spec matches an
+// existing revision, for example after a rollback, that revision is promoted to the latest revision number instead of
+// creating a new one.
+func (r *RayServiceReconciler) reconcileRevisionHistory(ctx context.Context, rayServiceInstance *rayv1.RayService, serveConfig string) error {
+	logger := ctrl.LoggerFrom(ctx)
+	revisions, err := r.listRevisions(ctx, rayServiceInstance)
+	if err != nil {
+		return err
+	}
+
+	limit := int(ptr.Deref(rayServiceInstance.Spec.RevisionHistoryLimit, utils.DefaultRayServiceRevisionHistoryLimit))
+	if limit > 0 {
+		var latestRevision int64
+		if len(revisions) > 0 {
+			latestRevision = revisions[len(revisions)-1].Revision
+		}
+		goalRevision, err := common.BuildRayServiceRevision(rayServiceInstance, serveConfig, latestRevision+1)
+		if err != nil {
+			return err
+		}
+
+		index := slices.IndexFunc(revisions, func(revision appsv1.ControllerRevision) bool {
+			return revision.Name == goalRevision.Name
+		})
+		switch {
+		case index == -1:
+			if err := ctrl.SetControllerReference(rayServiceInstance, goalRevision, r.Scheme); err != nil {
+				return err
+			}
+			if err := r.Create(ctx, goalRevision); err != nil {
+				r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToCreateRevision), "Failed to create the revision %s/%s: %v", goalRevision.Namespace, goalRevision.Name, err)
+				return err
+			}
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, string(utils.CreatedRevision), "Created the revision %s/%s with revision number %d", goalRevision.Namespace, goalRevision.Name, goalRevision.Revision)
+			// The Serve config read from a Secret is stored in a Secret that is deleted with the revision.
+			if isServeConfigFromSecret(rayServiceInstance) {
+				secret := common.BuildRayServiceRevisionSecret(goalRevision, serveConfig)
+				if err := ctrl.SetControllerReference(goalRevision, secret, r.Scheme); err != nil {
+					return err
+				}
+				if err := r.Create(ctx, secret); err != nil && !errors.IsAlreadyExists(err) {
+					r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToCreateRevision), "Failed to create the Secret of the revision %s/%s: %v", goalRevision.Namespace, goalRevision.Name, err)
+					return err
+				}
+			}
+			revisions = append(revisions, *goalRevision)
+		case index != len(revisions)-1:
+			existingRevision := revisions[index]
+			logger.Info("Promoting an existing revision to the latest revision", "revision", existingRevision.Name, "from", existingRevision.Revision, "to", latestRevision+1)
+			existingRevision.Revision = latestRevision + 1
+			if err := r.Update(ctx, &existingRevision); err != nil {
+				return err
+			}
+			revisions = append(slices.Delete(revisions, index, index+1), existingRevision)
+		}
+	}
+
+	for len(revisions) > limit {
+		logger.Info("Deleting the oldest revision", "revision", revisions[0].Name, "revisionNumber", revisions[0].Revision, "revisionHistoryLimit", limit)
+		if err := r.Delete(ctx, &revisions[0]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		revisions = revisions[1:]
+	}
+	return nil
+}
+
+// rollbackToRevision restores the RayCluster spec and Serve config of the RayService from the revision requested by
+// the `ray.io/rollback-to-revision` annotation and removes the annotation. If the revision does not exist, only the
+// annotation is removed and a warning event is emitted.
+func (r *RayServiceReconciler) rollbackToRevision(ctx context.Context, rayServiceInstance *rayv1.RayService, revision string) error {
+	logger := ctrl.LoggerFrom(ctx)
+	delete(rayServiceInstance.Annotations, utils.RayServiceRollbackToRevisionAnnotationKey)
+
+	var targetRevision *appsv1.ControllerRevision
+	if revisionNumber, err := strconv.ParseInt(revision, 10, 64); err == nil {
+		revisions, err := r.listRevisions(ctx, rayServiceInstance)
+		if err != nil {
+			return err
+		}
+		if index := slices.IndexFunc(revisions, func(rev appsv1.ControllerRevision) bool { return rev.Revision == revisionNumber }); index != -1 {
+			targetRevision = &revisions[index]
+		}
+	}
+
+	if targetRevision == nil {
+		logger.Info("The revision to roll back to was not found", "revision", revision)
+		r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToRollbackRayService), "Failed to roll back: revision %q not found", revision)
+		return r.Update(ctx, rayServiceInstance)
+	}
+
+	spec, err := common.GetRayServiceSpecFromRevision(targetRevision)
+	if err != nil {
+		return err
+	}
+	rayServiceInstance.Spec.RayClusterSpec = spec.RayClusterSpec
+	rayServiceInstance.Spec.ServeConfigV2 = spec.ServeConfigV2
+	rayServiceInstance.Spec.ServeConfigV2From = nil
+	// The Serve config of a revision that was read from a Secret is copied to a Secret owned by the RayService, because
+	// the Secret of the revision is deleted with the revision.
+	if spec.ServeConfigV2From != nil && spec.ServeConfigV2From.SecretKeyRef != nil {
+		if rayServiceInstance.Spec.ServeConfigV2From, err = r.restoreServeConfigSecret(ctx, rayServiceInstance, spec.ServeConfigV2From.SecretKeyRef); err != nil {
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToRollbackRayService), "Failed to roll back to revision %s: %v", revision, err)
+			return err
+		}
+	}
+	if err := r.Update(ctx, rayServiceInstance); err != nil {
+		r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToRollbackRayService), "Failed to roll back to revision %s: %v", revision, err)
+		return err
+	}
+	logger.Info("Rolled back the RayService", "revision", targetRevision.Name, "revisionNumber", targetRevision.Revision)
+	r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, string(utils.RolledBackRayService), "Rolled back to revision %s (%s/%s)", revision, targetRevision.Namespace, targetRevision.Name)
+	return nil
+}
+
+// restoreServeConfigSecret copies the Serve config stored in the Secret of a revision to the Secret that the RayService
+// owns, and returns the Serve config source that references it.
+func (r *RayServiceReconciler) restoreServeConfigSecret(ctx context.Context, rayServiceInstance *rayv1.RayService, ref *corev1.SecretKeySelector) (*rayv1.ServeConfigV2Source, error) {
+	revisionSecret := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: rayServiceInstance.Namespace, Name: ref.Name}, revisionSecret); err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: rayServiceInstance.Namespace, Name: utils.GenerateRayServiceServeConfigSecretName(rayServiceInstance.Name)}
+	if err := r.Get(ctx, key, secret); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		if err := ctrl.SetControllerReference(rayServiceInstance, secret, r.Scheme); err != nil {
+			return nil, err
+		}
+		secret.Data = map[string][]byte{utils.ServeConfigV2SecretKey: revisionSecret.Data[ref.Key]}
+		if err := r.Create(ctx, secret); err != nil {
+			return nil, err
+		}
+	} else {
+		if !metav1.IsControlledBy(secret, rayServiceInstance) {
+			return nil, fmt.Errorf("Secret %s/%s already exists and is not controlled by the RayService", key.Namespace, key.Name)
+		}
+		secret.Data = map[string][]byte{utils.ServeConfigV2SecretKey: revisionSecret.Data[ref.Key]}
+		if err := r.Update(ctx, secret); err != nil {
+			return nil, err
+		}
+	}
+
+	return &rayv1.ServeConfigV2Source{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+			Key:                  utils.ServeConfigV2SecretKey,
+		},
+	}, nil
+}
+
 func shouldUpdateCluster(rayServiceInstance *rayv1.RayService, cluster *rayv1.RayCluster, isActiveCluster bool) bool {
 	// Check whether to update the RayCluster or not.
 	if cluster == nil {
@@ -1296,12 +1508,204 @@ func checkIfNeedSubmitServeApplications(cachedServeConfigV2 string, serveConfigV
 	return false, "Current V2 Serve config matches cached Serve config."
 }
 
-func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, rayServiceInstance *rayv1.RayService, rayDashboardClient dashboardclient.RayDashboardClientInterface, clusterName string) error {
+// serveRolloutPlan describes the next step of a `Sequential` Serve application rollout on a RayCluster.
+type serveRolloutPlan struct {
+	// serveConfig is the Serve config to submit. It is empty if nothing needs to be submitted in this step.
+	serveConfig string
+	// application is the application that is rolled out by submitting `serveConfig`.
+	application string
+	// failedApplication is the application that failed to deploy and stopped the rollout.
+	failedApplication string
+	// isComplete is true if all applications in the goal Serve config have been submitted and are RUNNING.
+	isComplete bool
+}
+
+// getServeApplicationName returns the name of an application in the `applications` list of a Serve config.
+func getServeApplicationName(app any) string {
+	if appConfig, ok := app.(map[string]any); ok {
+		if name, ok := appConfig["name"].(string); ok && name != "" {
+			return name
+		}
+	}
+	return utils.DefaultServeAppName
+}
+
+// planSequentialServeRollout compares the goal Serve config with the Serve config that was last submitted to the
+// RayCluster and returns the next step of the rollout. The applications are rolled out in `applicationOrder` first,
+// followed by the remaining applications in the order of the goal Serve config. Each step submits the goal config of
+// the next application together with the goal config of the applications before it and the previously submitted
+// config of the applications after it, because Ray Serve deletes applications that are missing from a submitted
+// Serve config. The next application is only submitted once all applications before it are RUNNING.
+func planSequentialServeRollout(goalServeConfigV2, submittedServeConfigV2 string, applicationOrder []string, serveApplications map[string]rayv1.AppStatus) (serveRolloutPlan, error) {
+	goalConfig := make(map[string]any)
+	if err := yaml.Unmarshal([]byte(goalServeConfigV2), &goalConfig); err != nil {
+		return serveRolloutPlan{}, err
+	}
+	goalApps, _ := goalConfig["applications"].([]any)
+	if len(goalApps) == 0 {
+		return serveRolloutPlan{serveConfig: goalServeConfigV2}, nil
+	}
+
+	// The RayCluster has Serve applications that KubeRay did not submit, for example after the KubeRay operator
+	// restarts. Their configs are unknown, so submitting a subset of the applications would delete them.
+	if submittedServeConfigV2 == "" && len(serveApplications) > 0 {
+		return serveRolloutPlan{serveConfig: goalServeConfigV2}, nil
+	}
+
+	submittedConfig := make(map[string]any)
+	if err := yaml.Unmarshal([]byte(submittedServeConfigV2), &submittedConfig); err != nil {
+		return serveRolloutPlan{}, err
+	}
+	submittedApps := make(map[string]any)
+	if list, ok := submittedConfig["applications"].([]any); ok {
+		for _, app := range list {
+			submittedApps[getServeApplicationName(app)] = app
+		}
+	}
+
+	goalAppsByName := make(map[string]any, len(goalApps))
+	var orderedNames []string
+	for _, name := range applicationOrder {
+		if _, ok := goalAppsByName[name]; ok {
+			continue
+		}
+		for _, app := range goalApps {
+			if getServeApplicationName(app) == name {
+				goalAppsByName[name] = app
+				orderedNames = append(orderedNames, name)
+				break
+			}
+		}
+	}
+	for _, app := range goalApps {
+		name := getServeApplicationName(app)
+		if _, ok := goalAppsByName[name]; !ok {
+			goalAppsByName[name] = app
+			orderedNames = append(orderedNames, name)
+		}
+	}
+
+	for i, name := range orderedNames {
+		appStatus, isDeployed := serveApplications[name]
+		submittedApp, isSubmitted := submittedApps[name]
+		if isDeployed && isSubmitted && reflect.DeepEqual(submittedApp, goalAppsByName[name]) {
+			switch appStatus.Status {
+			case rayv1.ApplicationStatusEnum.RUNNING:
+				continue
+			case rayv1.ApplicationStatusEnum.DEPLOY_FAILED, rayv1.ApplicationStatusEnum.UNHEALTHY:
+				return serveRolloutPlan{failedApplication: name}, nil
+			default:
+				// Wait for the application to become RUNNING before rolling out the next one.
+				return serveRolloutPlan{}, nil
+			}
+		}
+
+		apps := make([]any, 0, len(orderedNames))
+		for j, appName := range orderedNames {
+			if j <= i {
+				apps = append(apps, goalAppsByName[appName])
+			} else if submittedApp, ok := submittedApps[appName]; ok {
+				apps = append(apps, submittedApp)
+			}
+		}
+		serveConfig := make(map[string]any, len(goalConfig))
+		for key, value := range goalConfig {
+			serveConfig[key] = value
+		}
+		serveConfig["applications"] = apps
+		serveConfigJson, err := json.Marshal(serveConfig)
+		if err != nil {
+			return serveRolloutPlan{}, err
+		}
+		return serveRolloutPlan{serveConfig: string(serveConfigJson), application: name}, nil
+	}
+
+	// All applications are RUNNING with the goal config. Submit the goal Serve config if the submitted config still
+	// differs from it, for example because an application was removed or a top-level option changed.
+	if !reflect.DeepEqual(goalConfig, submittedConfig) {
+		return serveRolloutPlan{serveConfig: goalServeConfigV2, isComplete: true}, nil
+	}
+	return serveRolloutPlan{isComplete: true}, nil
+}
+
+// rolloutServeApplications runs one step of a `Sequential` Serve application rollout on the RayCluster and returns
+// whether the RayCluster is ready to serve traffic. If an application fails to deploy, the rollout stops and the
+// applications after it keep their previously submitted config. In that case, the RayCluster is still considered
+// ready if any application is RUNNING, unless it is a pending RayCluster that would replace an active RayCluster.
+// The status message of the failed application in `serveApplications` records that the rollout is stopped.
+func (r *RayServiceReconciler) rolloutServeApplications(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, serveConfigV2 string, serveApplications map[string]rayv1.AppStatus, rayDashboardClient dashboardclient.RayDashboardClientInterface, isReady bool) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	cachedServeConfigV2 := r.getServeConfigFromCache(rayServiceInstance, rayClusterInstance.Name)
+	if len(serveApplications) == 0 {
+		// The submitted Serve applications are gone, for example because the head Pod crashed and GCS FT was not enabled.
+		cachedServeConfigV2 = ""
+	}
+
+	plan, err := planSequentialServeRollout(serveConfigV2, cachedServeConfigV2, rayServiceInstance.Spec.ServeRolloutStrategy.ApplicationOrder, serveApplications)
+	if err != nil {
+		return false, err
+	}
+	logger.Info("planSequentialServeRollout", "application", plan.application, "failedApplication", plan.failedApplication, "isComplete", plan.isComplete)
+
+	if plan.serveConfig != "" {
+		if err := r.updateServeDeployment(ctx, rayServiceInstance, plan.serveConfig, rayDashboardClient, rayClusterInstance.Name); err != nil {
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToUpdateServeApplications), "Failed to update serve applications to the RayCluster %s/%s: %v", rayClusterInstance.Namespace, rayClusterInstance.Name, err)
+			return false, err
+		}
+		if plan.application != "" {
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, string(utils.UpdatedServeApplications), "Rolled out serve application %s to the RayCluster %s/%s", plan.application, rayClusterInstance.Namespace, rayClusterInstance.Name)
+		} else {
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, string(utils.UpdatedServeApplications), "Updated serve applications to the RayCluster %s/%s", rayClusterInstance.Namespace, rayClusterInstance.Name)
+		}
+	}
+
+	if plan.isComplete {
+		// Cache the goal Serve config so that `checkIfNeedSubmitServeApplications` stops submitting it.
+		r.cacheServeConfig(rayServiceInstance, serveConfigV2, rayClusterInstance.Name)
+		return isReady, nil
+	}
+
+	if plan.failedApplication != "" {
+		appStatus := serveApplications[plan.failedApplication]
+		// The RayService status still holds the application statuses of the previous reconciliation. Only emit the
+		// event when the application transitions to the failed status to avoid emitting it on every reconciliation.
+		previousApplications := rayServiceInstance.Status.ActiveServiceStatus.Applications
+		if rayClusterInstance.Name == rayServiceInstance.Status.PendingServiceStatus.RayClusterName {
+			previousApplications = rayServiceInstance.Status.PendingServiceStatus.Applications
+		}
+		if previousApplications[plan.failedApplication].Status != appStatus.Status {
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToRolloutServeApplication),
+				"Serve application %s is %s on the RayCluster %s/%s, and the rollout of the remaining applications is stopped: %s",
+				plan.failedApplication, appStatus.Status, rayClusterInstance.Namespace, rayClusterInstance.Name, appStatus.Message)
+		}
+		// Events expire, so the application status in the RayService status also records why the rollout stopped.
+		appStatus.Message = fmt.Sprintf("The rollout of the remaining serve applications is stopped because this application is %s: %s",
+			appStatus.Status, appStatus.Message)
+		serveApplications[plan.failedApplication] = appStatus
+
+		isPendingCluster := rayClusterInstance.Name == rayServiceInstance.Status.PendingServiceStatus.RayClusterName
+		if isPendingCluster && rayServiceInstance.Status.ActiveServiceStatus.RayClusterName != "" {
+			return false, nil
+		}
+		for _, app := range serveApplications {
+			if app.Status == rayv1.ApplicationStatusEnum.RUNNING {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, rayServiceInstance *rayv1.RayService, serveConfigV2 string, rayDashboardClient dashboardclient.RayDashboardClientInterface, clusterName string) error {
 	logger := ctrl.LoggerFrom(ctx)
-	logger.Info("updateServeDeployment", "V2 config", rayServiceInstance.Spec.ServeConfigV2)
+	// Do not log the Serve config if it is sourced from a Secret.
+	logServeConfig := !isServeConfigFromSecret(rayServiceInstance)
+	if logServeConfig {
+		logger.Info("updateServeDeployment", "V2 config", serveConfigV2)
+	}
 
 	serveConfig := make(map[string]any)
-	if err := yaml.Unmarshal([]byte(rayServiceInstance.Spec.ServeConfigV2), &serveConfig); err != nil {
+	if err := yaml.Unmarshal([]byte(serveConfigV2), &serveConfig); err != nil {
 		return err
 	}
 
@@ -1327,7 +1731,9 @@ func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, raySer
 	if err != nil {
 		return fmt.Errorf("failed to marshal converted serve config into bytes: %w", err)
 	}
-	logger.Info("updateServeDeployment", "MULTI_APP json config", string(configJson))
+	if logServeConfig {
+		logger.Info("updateServeDeployment", "MULTI_APP json config", string(configJson))
+	}
 	if err := rayDashboardClient.UpdateDeployments(ctx, configJson); err != nil {
 		err = fmt.Errorf(
 			"fail to create / update Serve applications. If you observe this error consistently, "+
@@ -1337,7 +1743,7 @@ func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, raySer
 		return err
 	}
 
-	r.cacheServeConfig(rayServiceInstance, clusterName)
+	r.cacheServeConfig(rayServiceInstance, serveConfigV2, clusterName)
 	logger.Info("updateServeDeployment", "message", "Cached Serve config for Ray cluster with the key", "rayClusterName", clusterName)
 	return nil
 }
@@ -1413,13 +1819,13 @@ func (r *RayServiceReconciler) checkIfNeedTargetCapacityUpdate(ctx context.Conte
 }
 
 // applyServeTargetCapacity updates the target_capacity for a given RayCluster's Serve applications.
-func (r *RayServiceReconciler) applyServeTargetCapacity(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, rayDashboardClient dashboardclient.RayDashboardClientInterface, goalTargetCapacity int32) error {
+func (r *RayServiceReconciler) applyServeTargetCapacity(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, serveConfigV2 string, rayDashboardClient dashboardclient.RayDashboardClientInterface, goalTargetCapacity int32) error {
 	logger := ctrl.LoggerFrom(ctx).WithValues("RayCluster", rayClusterInstance.Name)
 
 	// Retrieve cached ServeConfig from last reconciliation for cluster to update
 	cachedConfig := r.getServeConfigFromCache(rayServiceInstance, rayClusterInstance.Name)
 	if cachedConfig == "" {
-		cachedConfig = rayServiceInstance.Spec.ServeConfigV2
+		cachedConfig = serveConfigV2
 	}
 
 	serveConfig := make(map[string]any)
@@ -1465,7 +1871,7 @@ func (r *RayServiceReconciler) applyServeTargetCapacity(ctx context.Context, ray
 
 // reconcileServeTargetCapacity reconciles the target_capacity of the ServeConfig for a given RayCluster during
 // a NewClusterWithIncrementalUpgrade while also updating the Status.TargetCapacity of the Active and Pending RayServices.
-func (r *RayServiceReconciler) reconcileServeTargetCapacity(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, rayDashboardClient dashboardclient.RayDashboardClientInterface) error {
+func (r *RayServiceReconciler) reconcileServeTargetCapacity(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, serveConfigV2 string, rayDashboardClient dashboardclient.RayDashboardClientInterface) error {
 	logger := ctrl.LoggerFrom(ctx)
 	logger.Info("reconcileServeTargetCapacity", "RayService", rayServiceInstance.Name)
 
@@ -1505,13 +1911,13 @@ func (r *RayServiceReconciler) reconcileServeTargetCapacity(ctx context.Context,
 			if rayClusterInstance.Name == pendingRayServiceStatus.RayClusterName {
 				goalTargetCapacity := max(int32(0), pendingTargetCapacity-maxSurgePercent)
 				logger.Info("Rollback: Scaling down pending cluster `target_capacity`.", "goal", goalTargetCapacity)
-				return r.applyServeTargetCapacity(ctx, rayServiceInstance, rayClusterInstance, rayDashboardClient, goalTargetCapacity)
+				return r.applyServeTargetCapacity(ctx, rayServiceInstance, rayClusterInstance, serveConfigV2, rayDashboardClient, goalTargetCapacity)
 			}
 		} else {
 			if rayClusterInstance.Name == activeRayServiceStatus.RayClusterName {
 				goalTargetCapacity := min(int32(100), activeTargetCapacity+maxSurgePercent)
 				logger.Info("Rollback: Scaling up active cluster `target_capacity`.", "goal", goalTargetCapacity)
-				return r.applyServeTargetCapacity(ctx, rayServiceInstance, rayClusterInstance, rayDashboardClient, goalTargetCapacity)
+				return r.applyServeTargetCapacity(ctx, rayServiceInstance, rayClusterInstance, serveConfigV2, rayDashboardClient, goalTargetCapacity)
 			}
 		}
 		return nil
@@ -1553,7 +1959,7 @@ func (r *RayServiceReconciler) reconcileServeTargetCapacity(ctx context.Context,
 		return nil
 	}
 
-	return r.applyServeTargetCapacity(ctx, rayServiceInstance, rayClusterInstance, rayDashboardClient, goalTargetCapacity)
+	return r.applyServeTargetCapacity(ctx, rayServiceInstance, rayClusterInstance, serveConfigV2, rayDashboardClient, goalTargetCapacity)
 }
 
 // `getAndCheckServeStatus` gets Serve applications' and deployments' statuses and check whether the
@@ -1626,8 +2032,7 @@ func (r *RayServiceReconciler) getServeConfigFromCache(rayServiceInstance *rayv1
 	return serveConfig
 }
 
-func (r *RayServiceReconciler) cacheServeConfig(rayServiceInstance *rayv1.RayService, clusterName string) {
-	serveConfig := rayServiceInstance.Spec.ServeConfigV2
+func (r *RayServiceReconciler) cacheServeConfig(rayServiceInstance *rayv1.RayService, serveConfig string, clusterName string) {
 	if serveConfig == "" {
 		return
 	}
@@ -1643,6 +2048,91 @@ func (r *RayServiceReconciler) cacheServeConfig(rayServiceInstance *rayv1.RaySer
 	rayServiceServeConfigs.Set(clusterName, serveConfig)
 }
 
+// getServeConfigV2 returns the Serve config of the RayService. If `serveConfigV2From` is set, the Serve config
+// is read from the referenced ConfigMap or Secret key in the RayService's namespace.
+func (r *RayServiceReconciler) getServeConfigV2(ctx context.Context, rayServiceInstance *rayv1.RayService) (string, error) {
+	source := rayServiceInstance.Spec.ServeConfigV2From
+	if source == nil {
+		return rayServiceInstance.Spec.ServeConfigV2, nil
+	}
+
+	if ref := source.ConfigMapKeyRef; ref != nil {
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: rayServiceInstance.Namespace, Name: ref.Name}, configMap); err != nil {
+			return "", err
+		}
+		serveConfig, ok := configMap.Data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("key %s not found in ConfigMap %s/%s", ref.Key, configMap.Namespace, configMap.Name)
+		}
+		return serveConfig, nil
+	}
+
+	if ref := source.SecretKeyRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: rayServiceInstance.Namespace, Name: ref.Name}, secret); err != nil {
+			return "", err
+		}
+		serveConfig, ok := secret.Data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("key %s not found in Secret %s/%s", ref.Key, secret.Namespace, secret.Name)
+		}
+		return string(serveConfig), nil
+	}
+
+	return "", errstd.New("serveConfigV2From must set either configMapKeyRef or secretKeyRef")
+}
+
+func isServeConfigFromSecret(rayServiceInstance *rayv1.RayService) bool {
+	source := rayServiceInstance.Spec.ServeConfigV2From
+	return source != nil && source.SecretKeyRef != nil
+}
+
+// indexServeConfigConfigMap indexes a RayService by the name of the ConfigMap that its `serveConfigV2From` references.
+func indexServeConfigConfigMap(obj client.Object) []string {
+	source := obj.(*rayv1.RayService).Spec.ServeConfigV2From
+	if source == nil || source.ConfigMapKeyRef == nil {
+		return nil
+	}
+	return []string{source.ConfigMapKeyRef.Name}
+}
+
+// indexServeConfigSecret indexes a RayService by the name of the Secret that its `serveConfigV2From` references.
+func indexServeConfigSecret(obj client.Object) []string {
+	source := obj.(*rayv1.RayService).Spec.ServeConfigV2From
+	if source == nil || source.SecretKeyRef == nil {
+		return nil
+	}
+	return []string{source.SecretKeyRef.Name}
+}
+
+// rayServicesForServeConfigSource maps a ConfigMap or Secret to the RayServices in the same namespace
+// whose `serveConfigV2From` references it, so that changes to the referenced data trigger a reconciliation.
+func (r *RayServiceReconciler) rayServicesForServeConfigSource(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := ctrl.LoggerFrom(ctx)
+	var indexKey string
+	switch obj.(type) {
+	case *corev1.ConfigMap:
+		indexKey = serveConfigConfigMapIndexKey
+	case *corev1.Secret:
+		indexKey = serveConfigSecretIndexKey
+	default:
+		return nil
+	}
+
+	rayServices := &rayv1.RayServiceList{}
+	if err := r.List(ctx, rayServices, client.InNamespace(obj.GetNamespace()), client.MatchingFields{indexKey: obj.GetName()}); err != nil {
+		logger.Error(err, "Failed to list RayServices", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(rayServices.Items))
+	for i := range rayServices.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rayServices.Items[i])})
+	}
+	return requests
+}
+
 func (r *RayServiceReconciler) reconcileServices(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, serviceType utils.ServiceType) (*corev1.Service, error) {
 	logger := ctrl.LoggerFrom(ctx)
 
@@ -1703,7 +2193,7 @@ func (r *RayServiceReconciler) reconcileServices(ctx context.Context, rayService
 
 // Reconciles the Serve applications on the RayCluster. Returns (isReady, serveApplicationStatus, error).
 // The `isReady` flag indicates whether the RayCluster is ready to handle incoming traffic.
-func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster) (bool, map[string]rayv1.AppStatus, error) {
+func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, serveConfigV2 string) (bool, map[string]rayv1.AppStatus, error) {
 	logger := ctrl.LoggerFrom(ctx)
 	var err error
 	var serveApplications map[string]rayv1.AppStatus
@@ -1756,11 +2246,15 @@ func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceIns
 	if err != nil {
 		return false, serveApplications, err
 	}
-	shouldUpdate, reason := checkIfNeedSubmitServeApplications(cachedServeConfigV2, rayServiceInstance.Spec.ServeConfigV2, serveApplications)
+	shouldUpdate, reason := checkIfNeedSubmitServeApplications(cachedServeConfigV2, serveConfigV2, serveApplications)
 	logger.Info("checkIfNeedSubmitServeApplications", "shouldUpdate", shouldUpdate, "reason", reason)
 
-	if shouldUpdate && !skipConfigUpdate {
-		if err = r.updateServeDeployment(ctx, rayServiceInstance, rayDashboardClient, rayClusterInstance.Name); err != nil {
+	if shouldUpdate && !skipConfigUpdate && utils.IsSequentialServeRolloutEnabled(&rayServiceInstance.Spec) {
+		if isReady, err = r.rolloutServeApplications(ctx, rayServiceInstance, rayClusterInstance, serveConfigV2, serveApplications, rayDashboardClient, isReady); err != nil {
+			return false, serveApplications, err
+		}
+	} else if shouldUpdate && !skipConfigUpdate {
+		if err = r.updateServeDeployment(ctx, rayServiceInstance, serveConfigV2, rayDashboardClient, rayClusterInstance.Name); err != nil {
 			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToUpdateServeApplications), "Failed to update serve applications to the RayCluster %s/%s: %v", rayClusterInstance.Namespace, rayClusterInstance.Name, err)
 			return false, serveApplications, err
 		}
@@ -1770,7 +2264,7 @@ func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceIns
 		incrementalUpgradeUpdate, reason := r.checkIfNeedTargetCapacityUpdate(ctx, rayServiceInstance)
 		logger.Info("checkIfNeedTargetCapacityUpdate", "incrementalUpgradeUpdate", incrementalUpgradeUpdate, "reason", reason)
 		if incrementalUpgradeUpdate {
-			if err := r.reconcileServeTargetCapacity(ctx, rayServiceInstance, rayClusterInstance, rayDashboardClient); err != nil {
+			if err := r.reconcileServeTargetCapacity(ctx, rayServiceInstance, rayClusterInstance, serveConfigV2, rayDashboardClient); err != nil {
 				r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToUpdateTargetCapacity), "Failed to update target_capacity of serve applications to the RayCluster %s/%s: %v", rayClusterInstance.Namespace, rayClusterInstance.Name, err)
 				return false, serveApplications, err
 			}
@@ -1802,7 +2296,10 @@ func (r *RayServiceReconciler) updateHeadPodServeLabel(ctx context.Context, rayS
 	rayContainer := headPod.Spec.Containers[utils.RayContainerIndex]
 	servingPort := int(utils.FindContainerPort(&rayContainer, utils.ServingPortName, utils.DefaultServingPort))
 
-	client := r.httpProxyClientFunc(headPod.Status.PodIP, headPod.Namespace, headPod.Name, servingPort)
+	client, err := r.httpProxyClientFunc(rayClusterInstance, headPod.Status.PodIP, headPod.Namespace, headPod.Name, servingPort)
+	if err != nil {
+		return err
+	}
 	if headPod.Labels == nil {
 		headPod.Labels = make(map[string]string)
 	}
@@ -12,12 +12,14 @@ import (
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/lru"
 	"k8s.io/utils/ptr"
@@ -449,6 +451,156 @@ func TestCheckIfNeedSubmitServeApplications(t *testing.T) {
 	assert.True(t, shouldCreate)
 }
 
+func TestGetServeConfigV2(t *testing.T) {
+	namespace := "ray"
+	serveConfigV2 := "applications:\n- name: app1\n"
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "serve-config", Namespace: namespace},
+		Data:       map[string]string{"serveConfigV2": serveConfigV2},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "serve-config", Namespace: namespace},
+		Data:       map[string][]byte{"serveConfigV2": []byte(serveConfigV2)},
+	}
+
+	tests := []struct {
+		source         *rayv1.ServeConfigV2Source
+		name           string
+		inlineConfig   string
+		expectedConfig string
+		expectErr      bool
+	}{
+		{
+			name:           "inline Serve config",
+			inlineConfig:   serveConfigV2,
+			expectedConfig: serveConfigV2,
+		},
+		{
+			name: "Serve config from ConfigMap",
+			source: &rayv1.ServeConfigV2Source{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+					Key:                  "serveConfigV2",
+				},
+			},
+			expectedConfig: serveConfigV2,
+		},
+		{
+			name: "Serve config from Secret",
+			source: &rayv1.ServeConfigV2Source{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+					Key:                  "serveConfigV2",
+				},
+			},
+			expectedConfig: serveConfigV2,
+		},
+		{
+			name: "key not found in ConfigMap",
+			source: &rayv1.ServeConfigV2Source{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+					Key:                  "missing",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Secret not found",
+			source: &rayv1.ServeConfigV2Source{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+					Key:                  "serveConfigV2",
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rayService := &rayv1.RayService{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: namespace},
+				Spec: rayv1.RayServiceSpec{
+					ServeConfigV2:     tc.inlineConfig,
+					ServeConfigV2From: tc.source,
+				},
+			}
+			fakeClient := clientFake.NewClientBuilder().WithObjects(configMap, secret).Build()
+			r := &RayServiceReconciler{Client: fakeClient}
+
+			config, err := r.getServeConfigV2(context.TODO(), rayService)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConfig, config)
+		})
+	}
+}
+
+func TestRayServicesForServeConfigSource(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	configMapRayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{Name: "configmap-service", Namespace: namespace},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2From: &rayv1.ServeConfigV2Source{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+					Key:                  "serveConfigV2",
+				},
+			},
+		},
+	}
+	secretRayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{Name: "secret-service", Namespace: namespace},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2From: &rayv1.ServeConfigV2Source{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+					Key:                  "serveConfigV2",
+				},
+			},
+		},
+	}
+	inlineRayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{Name: "inline-service", Namespace: namespace},
+		Spec:       rayv1.RayServiceSpec{ServeConfigV2: "applications: []"},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).
+		WithObjects(configMapRayService, secretRayService, inlineRayService).
+		WithIndex(&rayv1.RayService{}, serveConfigConfigMapIndexKey, indexServeConfigConfigMap).
+		WithIndex(&rayv1.RayService{}, serveConfigSecretIndexKey, indexServeConfigSecret).
+		Build()
+	r := &RayServiceReconciler{Client: fakeClient}
+	ctx := context.TODO()
+
+	// A ConfigMap only triggers the RayServices that reference it through `configMapKeyRef`.
+	requests := r.rayServicesForServeConfigSource(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "serve-config", Namespace: namespace},
+	})
+	require.Len(t, requests, 1)
+	assert.Equal(t, client.ObjectKeyFromObject(configMapRayService), requests[0].NamespacedName)
+
+	// A Secret only triggers the RayServices that reference it through `secretKeyRef`.
+	requests = r.rayServicesForServeConfigSource(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "serve-config", Namespace: namespace},
+	})
+	require.Len(t, requests, 1)
+	assert.Equal(t, client.ObjectKeyFromObject(secretRayService), requests[0].NamespacedName)
+
+	// Objects that are not referenced by any RayService do not trigger a reconciliation.
+	requests = r.rayServicesForServeConfigSource(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespace},
+	})
+	assert.Empty(t, requests)
+}
+
 func TestReconcileRayCluster_CreatePendingCluster(t *testing.T) {
 	newScheme := runtime.NewScheme()
 	_ = rayv1.AddToScheme(newScheme)
@@ -704,8 +856,8 @@ func TestLabelHeadPodForServeStatus(t *testing.T) {
 				Client:   fakeClient,
 				Recorder: &record.FakeRecorder{},
 				Scheme:   newScheme,
-				httpProxyClientFunc: func(_, _, _ string, _ int) utils.RayHttpProxyClientInterface {
-					return fakeRayHttpProxyClient
+				httpProxyClientFunc: func(_ *rayv1.RayCluster, _, _, _ string, _ int) (utils.RayHttpProxyClientInterface, error) {
+					return fakeRayHttpProxyClient, nil
 				},
 			}
 
@@ -1984,7 +2136,7 @@ func TestReconcileServeTargetCapacity(t *testing.T) {
 				ServeConfigs: lru.New(10),
 			}
 
-			err := reconciler.reconcileServeTargetCapacity(ctx, rayService, rayCluster, fakeDashboard)
+			err := reconciler.reconcileServeTargetCapacity(ctx, rayService, rayCluster, "", fakeDashboard)
 			require.NoError(t, err)
 			require.NotEmpty(t, fakeDashboard.LastUpdatedConfig)
 
@@ -2574,6 +2726,7 @@ func Test_RayServiceReconcileManagedBy(t *testing.T) {
 	_ = rayv1.AddToScheme(newScheme)
 	_ = corev1.AddToScheme(newScheme)
 	_ = discoveryv1.AddToScheme(newScheme)
+	_ = appsv1.AddToScheme(newScheme)
 
 	const (
 		// MultiKueueController represents the value of the MultiKueue controller
@@ -2832,3 +2985,493 @@ func TestShouldUpdateCluster_SuspendFlip(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileRevisionHistory(t *testing.T) {
+	ctx := context.TODO()
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = appsv1.AddToScheme(newScheme)
+
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "ray", UID: "test-uid"},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2:        "applications:\n- name: app1\n",
+			RevisionHistoryLimit: ptr.To(int32(2)),
+			RayClusterSpec: rayv1.RayClusterSpec{
+				RayVersion: "2.54.0",
+			},
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayService).Build()
+	r := &RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: record.NewFakeRecorder(10),
+		Scheme:   newScheme,
+	}
+
+	listRevisionNames := func() []string {
+		revisions, err := r.listRevisions(ctx, rayService)
+		require.NoError(t, err)
+		names := make([]string, 0, len(revisions))
+		for _, revision := range revisions {
+			names = append(names, revision.Name)
+		}
+		return names
+	}
+
+	// The first reconciliation creates revision 1.
+	require.NoError(t, r.reconcileRevisionHistory(ctx, rayService, rayService.Spec.ServeConfigV2))
+	revision1, err := common.BuildRayServiceRevision(rayService, rayService.Spec.ServeConfigV2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{revision1.Name}, listRevisionNames())
+
+	// Reconciling the same spec again does not create a new revision.
+	require.NoError(t, r.reconcileRevisionHistory(ctx, rayService, rayService.Spec.ServeConfigV2))
+	assert.Equal(t, []string{revision1.Name}, listRevisionNames())
+
+	// Changing the Serve config creates revision 2.
+	rayService.Spec.ServeConfigV2 = "applications:\n- name: app2\n"
+	require.NoError(t, r.reconcileRevisionHistory(ctx, rayService, rayService.Spec.ServeConfigV2))
+	revision2, err := common.BuildRayServiceRevision(rayService, rayService.Spec.ServeConfigV2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{revision1.Name, revision2.Name}, listRevisionNames())
+
+	// Going back to the first spec promotes revision 1 to the latest revision instead of creating a new one.
+	rayService.Spec.ServeConfigV2 = "applications:\n- name: app1\n"
+	require.NoError(t, r.reconcileRevisionHistory(ctx, rayService, rayService.Spec.ServeConfigV2))
+	assert.Equal(t, []string{revision2.Name, revision1.Name}, listRevisionNames())
+	revisions, err := r.listRevisions(ctx, rayService)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), revisions[1].Revision)
+
+	// A new spec beyond `revisionHistoryLimit` prunes the oldest revision.
+	rayService.Spec.RayClusterSpec.RayVersion = "2.55.0"
+	require.NoError(t, r.reconcileRevisionHistory(ctx, rayService, rayService.Spec.ServeConfigV2))
+	revision4, err := common.BuildRayServiceRevision(rayService, rayService.Spec.ServeConfigV2, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []string{revision1.Name, revision4.Name}, listRevisionNames())
+
+	// Setting `revisionHistoryLimit` to 0 deletes all revisions.
+	rayService.Spec.RevisionHistoryLimit = ptr.To(int32(0))
+	require.NoError(t, r.reconcileRevisionHistory(ctx, rayService, rayService.Spec.ServeConfigV2))
+	assert.Empty(t, listRevisionNames())
+}
+
+func TestRollbackToRevision(t *testing.T) {
+	ctx := context.TODO()
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = appsv1.AddToScheme(newScheme)
+
+	newRayService := func() *rayv1.RayService {
+		return &rayv1.RayService{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "ray"},
+			Spec: rayv1.RayServiceSpec{
+				ServeConfigV2: "applications:\n- name: app1\n",
+				RayClusterSpec: rayv1.RayClusterSpec{
+					RayVersion: "2.54.0",
+				},
+			},
+		}
+	}
+	revision, err := common.BuildRayServiceRevision(newRayService(), "applications:\n- name: app1\n", 1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                string
+		revision            string
+		expectedServeConfig string
+		expectedRayVersion  string
+		expectedEventReason string
+	}{
+		{
+			name:                "roll back to an existing revision",
+			revision:            "1",
+			expectedServeConfig: "applications:\n- name: app1\n",
+			expectedRayVersion:  "2.54.0",
+			expectedEventReason: string(utils.RolledBackRayService),
+		},
+		{
+			name:                "roll back to a revision that does not exist",
+			revision:            "2",
+			expectedServeConfig: "applications:\n- name: app2\n",
+			expectedRayVersion:  "2.55.0",
+			expectedEventReason: string(utils.FailedToRollbackRayService),
+		},
+		{
+			name:                "roll back to an invalid revision",
+			revision:            "latest",
+			expectedServeConfig: "applications:\n- name: app2\n",
+			expectedRayVersion:  "2.55.0",
+			expectedEventReason: string(utils.FailedToRollbackRayService),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rayService := newRayService()
+			rayService.Spec.ServeConfigV2 = "applications:\n- name: app2\n"
+			rayService.Spec.RayClusterSpec.RayVersion = "2.55.0"
+			rayService.Annotations = map[string]string{utils.RayServiceRollbackToRevisionAnnotationKey: tc.revision}
+
+			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayService, revision.DeepCopy()).Build()
+			recorder := record.NewFakeRecorder(10)
+			r := &RayServiceReconciler{
+				Client:   fakeClient,
+				Recorder: recorder,
+				Scheme:   newScheme,
+			}
+
+			require.NoError(t, r.rollbackToRevision(ctx, rayService, tc.revision))
+
+			updated := &rayv1.RayService{}
+			require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(rayService), updated))
+			assert.NotContains(t, updated.Annotations, utils.RayServiceRollbackToRevisionAnnotationKey)
+			assert.Equal(t, tc.expectedServeConfig, updated.Spec.ServeConfigV2)
+			assert.Equal(t, tc.expectedRayVersion, updated.Spec.RayClusterSpec.RayVersion)
+			require.Len(t, recorder.Events, 1)
+			assert.Contains(t, <-recorder.Events, tc.expectedEventReason)
+		})
+	}
+}
+
+func TestRollbackToSecretRevision(t *testing.T) {
+	ctx := context.TODO()
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = appsv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	serveConfig := "applications:\n- name: app1\n"
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "ray", UID: "test-uid"},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2From: &rayv1.ServeConfigV2Source{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "user-serve-config"},
+					Key:                  "config",
+				},
+			},
+			RayClusterSpec: rayv1.RayClusterSpec{
+				RayVersion: "2.54.0",
+			},
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayService).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: recorder,
+		Scheme:   newScheme,
+	}
+
+	// The Serve config read from a Secret is stored in a Secret owned by the revision, not in the revision itself.
+	require.NoError(t, r.reconcileRevisionHistory(ctx, rayService, serveConfig))
+	revisions, err := r.listRevisions(ctx, rayService)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	assert.NotContains(t, string(revisions[0].Data.Raw), "app1")
+	revisionSecret := &corev1.Secret{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: "ray", Name: revisions[0].Name}, revisionSecret))
+	assert.True(t, metav1.IsControlledBy(revisionSecret, &revisions[0]))
+	assert.Equal(t, serveConfig, string(revisionSecret.Data[utils.ServeConfigV2SecretKey]))
+
+	// Rolling back restores the recorded Serve config even if the user's Secret changed in the meantime.
+	rayService.Spec.RayClusterSpec.RayVersion = "2.55.0"
+	require.NoError(t, fakeClient.Update(ctx, rayService))
+	require.NoError(t, r.rollbackToRevision(ctx, rayService, "1"))
+
+	updated := &rayv1.RayService{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(rayService), updated))
+	assert.Equal(t, "2.54.0", updated.Spec.RayClusterSpec.RayVersion)
+	assert.Empty(t, updated.Spec.ServeConfigV2)
+	require.NotNil(t, updated.Spec.ServeConfigV2From)
+	require.NotNil(t, updated.Spec.ServeConfigV2From.SecretKeyRef)
+	assert.Equal(t, utils.GenerateRayServiceServeConfigSecretName(rayService.Name), updated.Spec.ServeConfigV2From.SecretKeyRef.Name)
+
+	restoredSecret := &corev1.Secret{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: "ray", Name: updated.Spec.ServeConfigV2From.SecretKeyRef.Name}, restoredSecret))
+	assert.True(t, metav1.IsControlledBy(restoredSecret, rayService))
+	assert.Equal(t, serveConfig, string(restoredSecret.Data[updated.Spec.ServeConfigV2From.SecretKeyRef.Key]))
+}
+
+func TestPlanSequentialServeRollout(t *testing.T) {
+	goalServeConfigV2 := `
+http_options:
+  port: 8000
+applications:
+- name: app1
+  import_path: app1:v2
+- name: app2
+  import_path: app2:v2
+- name: app3
+  import_path: app3:v2
+`
+	submittedServeConfigV2 := `
+http_options:
+  port: 8000
+applications:
+- name: app1
+  import_path: app1:v1
+- name: app2
+  import_path: app2:v1
+- name: app3
+  import_path: app3:v1
+`
+	appStatuses := func(statuses ...string) map[string]rayv1.AppStatus {
+		apps := make(map[string]rayv1.AppStatus)
+		for i, status := range statuses {
+			apps[fmt.Sprintf("app%d", i+1)] = rayv1.AppStatus{Status: status}
+		}
+		return apps
+	}
+	running := rayv1.ApplicationStatusEnum.RUNNING
+
+	tests := []struct {
+		serveApplications      map[string]rayv1.AppStatus
+		name                   string
+		submittedServeConfigV2 string
+		expectedApplication    string
+		expectedFailedApp      string
+		expectedImportPaths    []string
+		applicationOrder       []string
+		expectedComplete       bool
+	}{
+		{
+			name:                   "roll out the first application to a new RayCluster",
+			submittedServeConfigV2: "",
+			serveApplications:      appStatuses(),
+			expectedApplication:    "app1",
+			expectedImportPaths:    []string{"app1:v2"},
+		},
+		{
+			name:                   "roll out the first application and keep the others at the submitted config",
+			submittedServeConfigV2: submittedServeConfigV2,
+			serveApplications:      appStatuses(running, running, running),
+			expectedApplication:    "app1",
+			expectedImportPaths:    []string{"app1:v2", "app2:v1", "app3:v1"},
+		},
+		{
+			name:                   "roll out the applications in applicationOrder first",
+			submittedServeConfigV2: submittedServeConfigV2,
+			serveApplications:      appStatuses(running, running, running),
+			applicationOrder:       []string{"app3", "unknown-app"},
+			expectedApplication:    "app3",
+			expectedImportPaths:    []string{"app3:v2", "app1:v1", "app2:v1"},
+		},
+		{
+			name: "roll out the next application after the previous one is RUNNING",
+			submittedServeConfigV2: `
+http_options:
+  port: 8000
+applications:
+- name: app1
+  import_path: app1:v2
+- name: app2
+  import_path: app2:v1
+- name: app3
+  import_path: app3:v1
+`,
+			serveApplications:   appStatuses(running, running, running),
+			expectedApplication: "app2",
+			expectedImportPaths: []string{"app1:v2", "app2:v2", "app3:v1"},
+		},
+		{
+			name: "wait for the previous application to become RUNNING",
+			submittedServeConfigV2: `
+applications:
+- name: app1
+  import_path: app1:v2
+`,
+			serveApplications: appStatuses(rayv1.ApplicationStatusEnum.DEPLOYING),
+		},
+		{
+			name: "stop the rollout if an application fails to deploy",
+			submittedServeConfigV2: `
+http_options:
+  port: 8000
+applications:
+- name: app1
+  import_path: app1:v2
+- name: app2
+  import_path: app2:v2
+- name: app3
+  import_path: app3:v1
+`,
+			serveApplications: appStatuses(running, rayv1.ApplicationStatusEnum.DEPLOY_FAILED, running),
+			expectedFailedApp: "app2",
+		},
+		{
+			name:                   "submit the whole Serve config if the submitted config is unknown",
+			submittedServeConfigV2: "",
+			serveApplications:      appStatuses(running, running, running),
+			expectedImportPaths:    []string{"app1:v2", "app2:v2", "app3:v2"},
+		},
+		{
+			name:                   "the rollout is complete",
+			submittedServeConfigV2: goalServeConfigV2,
+			serveApplications:      appStatuses(running, running, running),
+			expectedComplete:       true,
+		},
+		{
+			name: "remove applications that are not in the goal Serve config after the rollout",
+			submittedServeConfigV2: `
+http_options:
+  port: 8000
+applications:
+- name: app1
+  import_path: app1:v2
+- name: app2
+  import_path: app2:v2
+- name: app3
+  import_path: app3:v2
+- name: app4
+  import_path: app4:v1
+`,
+			serveApplications:   appStatuses(running, running, running, running),
+			expectedImportPaths: []string{"app1:v2", "app2:v2", "app3:v2"},
+			expectedComplete:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plan, err := planSequentialServeRollout(goalServeConfigV2, tc.submittedServeConfigV2, tc.applicationOrder, tc.serveApplications)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedApplication, plan.application)
+			assert.Equal(t, tc.expectedFailedApp, plan.failedApplication)
+			assert.Equal(t, tc.expectedComplete, plan.isComplete)
+
+			if tc.expectedImportPaths == nil {
+				assert.Empty(t, plan.serveConfig)
+				return
+			}
+			serveConfig := make(map[string]any)
+			require.NoError(t, yaml.Unmarshal([]byte(plan.serveConfig), &serveConfig))
+			assert.Equal(t, map[string]any{"port": int64(8000)}, serveConfig["http_options"])
+			var importPaths []string
+			for _, app := range serveConfig["applications"].([]any) {
+				importPaths = append(importPaths, app.(map[string]any)["import_path"].(string))
+			}
+			assert.Equal(t, tc.expectedImportPaths, importPaths)
+		})
+	}
+}
+
+func TestRolloutServeApplications(t *testing.T) {
+	ctx := context.TODO()
+	serveConfigV2 := `
+applications:
+- name: app1
+  import_path: app1:v1
+- name: app2
+  import_path: app2:v1
+`
+	submittedServeConfigV2 := `
+applications:
+- name: app1
+  import_path: app1:v1
+- name: app2
+  import_path: app2:v0
+`
+	running := rayv1.AppStatus{Status: rayv1.ApplicationStatusEnum.RUNNING}
+	failed := rayv1.AppStatus{Status: rayv1.ApplicationStatusEnum.DEPLOY_FAILED, Message: "import failed"}
+
+	tests := []struct {
+		serveApplications   map[string]rayv1.AppStatus
+		previousApps        map[string]rayv1.AppStatus
+		name                string
+		activeClusterName   string
+		cachedServeConfigV2 string
+		expectedEvent       string
+		expectedCacheConfig string
+		isReady             bool
+		expectedReady       bool
+		expectSubmission    bool
+	}{
+		{
+			name:                "roll out the next application",
+			cachedServeConfigV2: submittedServeConfigV2,
+			serveApplications:   map[string]rayv1.AppStatus{"app1": running, "app2": running},
+			isReady:             true,
+			expectedReady:       false,
+			expectSubmission:    true,
+			expectedEvent:       "Rolled out serve application app2",
+		},
+		{
+			name:                "a failed application does not block the readiness of a new RayCluster",
+			cachedServeConfigV2: serveConfigV2 + "\n",
+			serveApplications:   map[string]rayv1.AppStatus{"app1": running, "app2": failed},
+			expectedReady:       true,
+			expectedEvent:       string(utils.FailedToRolloutServeApplication),
+			expectedCacheConfig: serveConfigV2 + "\n",
+		},
+		{
+			name:                "a failed application blocks the readiness of a pending RayCluster during an upgrade",
+			activeClusterName:   "active-cluster",
+			cachedServeConfigV2: serveConfigV2 + "\n",
+			serveApplications:   map[string]rayv1.AppStatus{"app1": running, "app2": failed},
+			expectedReady:       false,
+			expectedEvent:       string(utils.FailedToRolloutServeApplication),
+			expectedCacheConfig: serveConfigV2 + "\n",
+		},
+		{
+			name:                "a failed application that was already failed does not emit the event again",
+			cachedServeConfigV2: serveConfigV2 + "\n",
+			serveApplications:   map[string]rayv1.AppStatus{"app1": running, "app2": failed},
+			previousApps:        map[string]rayv1.AppStatus{"app1": running, "app2": failed},
+			expectedReady:       true,
+			expectedCacheConfig: serveConfigV2 + "\n",
+		},
+		{
+			name:                "cache the goal Serve config once the rollout is complete",
+			cachedServeConfigV2: serveConfigV2 + "\n",
+			serveApplications:   map[string]rayv1.AppStatus{"app1": running, "app2": running},
+			isReady:             true,
+			expectedReady:       true,
+			expectedCacheConfig: serveConfigV2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rayService := &rayv1.RayService{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "ray"},
+				Spec: rayv1.RayServiceSpec{
+					ServeConfigV2:        serveConfigV2,
+					ServeRolloutStrategy: &rayv1.ServeRolloutStrategy{Type: ptr.To(rayv1.ServeRolloutSequential)},
+				},
+				Status: rayv1.RayServiceStatuses{
+					ActiveServiceStatus:  rayv1.RayServiceStatus{RayClusterName: tc.activeClusterName},
+					PendingServiceStatus: rayv1.RayServiceStatus{RayClusterName: "pending-cluster", Applications: tc.previousApps},
+				},
+			}
+			rayCluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "pending-cluster", Namespace: "ray"}}
+			recorder := record.NewFakeRecorder(10)
+			r := &RayServiceReconciler{
+				Recorder:     recorder,
+				ServeConfigs: lru.New(utils.ServeConfigLRUSize),
+			}
+			r.cacheServeConfig(rayService, tc.cachedServeConfigV2, rayCluster.Name)
+			fakeDashboard := &utils.FakeRayDashboardClient{}
+
+			isReady, err := r.rolloutServeApplications(ctx, rayService, rayCluster, serveConfigV2, tc.serveApplications, fakeDashboard, tc.isReady)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReady, isReady)
+			assert.Equal(t, tc.expectSubmission, fakeDashboard.LastUpdatedConfig != nil)
+			// The failed application's status records that the rollout is stopped.
+			if app2 := tc.serveApplications["app2"]; app2.Status == rayv1.ApplicationStatusEnum.DEPLOY_FAILED {
+				assert.Equal(t, "The rollout of the remaining serve applications is stopped because this application is DEPLOY_FAILED: import failed", app2.Message)
+			}
+			if tc.expectedCacheConfig != "" {
+				assert.Equal(t, tc.expectedCacheConfig, r.getServeConfigFromCache(rayService, rayCluster.Name))
+			}
+			if tc.expectedEvent == "" {
+				assert.Empty(t, recorder.Events)
+			} else {
+				require.Len(t, recorder.Events, 1)
+				assert.Contains(t, <-recorder.Events, tc.expectedEvent)
+			}
+		})
+	}
+}
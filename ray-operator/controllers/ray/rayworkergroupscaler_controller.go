@@ -0,0 +1,293 @@
+package ray
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const (
+	RayWorkerGroupScalerDefaultRequeueDuration = 3 * time.Second
+
+	// rayWorkerGroupScalerClusterIndexKey and rayWorkerGroupScalerGroupIndexKey index RayWorkerGroupScalers by the
+	// RayCluster, and by the RayCluster and worker group, that they target.
+	rayWorkerGroupScalerClusterIndexKey = "spec.rayClusterName"
+	rayWorkerGroupScalerGroupIndexKey   = "spec.rayClusterName.groupName"
+)
+
+// RayWorkerGroupScalerReconciler reconciles a RayWorkerGroupScaler object
+type RayWorkerGroupScalerReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// NewRayWorkerGroupScalerReconciler returns a new RayWorkerGroupScalerReconciler
+func NewRayWorkerGroupScalerReconciler(mgr ctrl.Manager) *RayWorkerGroupScalerReconciler {
+	return &RayWorkerGroupScalerReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("rayworkergroupscaler-controller"),
+	}
+}
+
+//+kubebuilder:rbac:groups=ray.io,resources=rayworkergroupscalers,verbs=get;list;watch
+//+kubebuilder:rbac:groups=ray.io,resources=rayworkergroupscalers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=ray.io,resources=rayclusters,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// [WARNING]: There MUST be a newline after kubebuilder markers.
+// Reconcile applies the desired replicas of a RayWorkerGroupScaler to the target worker group of its RayCluster,
+// and reports the current replicas and Pod selector of the worker group through the scale subresource.
+func (r *RayWorkerGroupScalerReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	scaler := &rayv1.RayWorkerGroupScaler{}
+	if err := r.Get(ctx, request.NamespacedName, scaler); err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request. Stop reconciliation.
+			logger.Info("RayWorkerGroupScaler resource not found.")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get RayWorkerGroupScaler")
+		return ctrl.Result{RequeueAfter: RayWorkerGroupScalerDefaultRequeueDuration}, err
+	}
+
+	// Please do NOT modify `originalScaler` in the following code.
+	originalScaler := scaler.DeepCopy()
+	scaler.Status.ObservedGeneration = scaler.Generation
+
+	reconcileErr := r.reconcileWorkerGroup(ctx, scaler)
+
+	if err := r.updateRayWorkerGroupScalerStatus(ctx, originalScaler, scaler); err != nil {
+		logger.Info("Failed to update RayWorkerGroupScaler status", "error", err)
+		return ctrl.Result{RequeueAfter: RayWorkerGroupScalerDefaultRequeueDuration}, err
+	}
+	if reconcileErr != nil {
+		return ctrl.Result{RequeueAfter: RayWorkerGroupScalerDefaultRequeueDuration}, reconcileErr
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileWorkerGroup scales the target worker group and fills in the status of the RayWorkerGroupScaler.
+// A returned error means the reconciliation should be retried; conditions that require user action are
+// surfaced through the Ready condition and events instead.
+func (r *RayWorkerGroupScalerReconciler) reconcileWorkerGroup(ctx context.Context, scaler *rayv1.RayWorkerGroupScaler) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	if err := utils.ValidateRayWorkerGroupScalerSpec(scaler); err != nil {
+		r.Recorder.Eventf(scaler, corev1.EventTypeWarning, string(utils.InvalidRayWorkerGroupScalerSpec),
+			"%s/%s: %v", scaler.Namespace, scaler.Name, err)
+		setRayWorkerGroupScalerReadyCondition(scaler, metav1.ConditionFalse, rayv1.WorkerGroupScalerSpecInvalid, err.Error())
+		return nil
+	}
+
+	rayCluster := &rayv1.RayCluster{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: scaler.Namespace, Name: scaler.Spec.RayClusterName}, rayCluster); err != nil {
+		if errors.IsNotFound(err) {
+			setRayWorkerGroupScalerReadyCondition(scaler, metav1.ConditionFalse, rayv1.TargetRayClusterNotFound,
+				fmt.Sprintf("RayCluster %s not found", scaler.Spec.RayClusterName))
+			return nil
+		}
+		return err
+	}
+
+	// The Ray autoscaler owns the replicas of every worker group when in-tree autoscaling is enabled.
+	// Refuse to compete with it rather than letting the two scalers overwrite each other.
+	if utils.IsAutoscalingEnabled(&rayCluster.Spec) {
+		message := fmt.Sprintf("RayCluster %s has in-tree autoscaling enabled; disable enableInTreeAutoscaling to scale worker group %s with a RayWorkerGroupScaler",
+			rayCluster.Name, scaler.Spec.GroupName)
+		// Only emit the event on the transition to avoid flooding it on every RayCluster update.
+		if cond := meta.FindStatusCondition(scaler.Status.Conditions, string(rayv1.RayWorkerGroupScalerReady)); cond == nil || cond.Reason != rayv1.InTreeAutoscalingEnabled {
+			r.Recorder.Event(scaler, corev1.EventTypeWarning, string(utils.InTreeAutoscalingConflict), message)
+		}
+		setRayWorkerGroupScalerReadyCondition(scaler, metav1.ConditionFalse, rayv1.InTreeAutoscalingEnabled, message)
+		return nil
+	}
+
+	groupIndex := -1
+	for i, workerGroup := range rayCluster.Spec.WorkerGroupSpecs {
+		if workerGroup.GroupName == scaler.Spec.GroupName {
+			groupIndex = i
+			break
+		}
+	}
+	if groupIndex == -1 {
+		setRayWorkerGroupScalerReadyCondition(scaler, metav1.ConditionFalse, rayv1.TargetWorkerGroupNotFound,
+			fmt.Sprintf("worker group %s not found in RayCluster %s", scaler.Spec.GroupName, rayCluster.Name))
+		return nil
+	}
+
+	workerGroup := rayCluster.Spec.WorkerGroupSpecs[groupIndex]
+	if scaler.Spec.Replicas != nil {
+		desiredReplicas := clampWorkerGroupReplicas(workerGroup, *scaler.Spec.Replicas)
+		if currentReplicas := ptr.Deref(workerGroup.Replicas, 0); currentReplicas != desiredReplicas {
+			patch := client.MergeFrom(rayCluster.DeepCopy())
+			rayCluster.Spec.WorkerGroupSpecs[groupIndex].Replicas = ptr.To(desiredReplicas)
+			if err := r.Patch(ctx, rayCluster, patch); err != nil {
+				r.Recorder.Eventf(scaler, corev1.EventTypeWarning, string(utils.FailedToScaleWorkerGroup),
+					"Failed to scale worker group %s of RayCluster %s/%s to %d replicas: %v",
+					workerGroup.GroupName, rayCluster.Namespace, rayCluster.Name, desiredReplicas, err)
+				return err
+			}
+			logger.Info("Scaled worker group", "RayCluster", rayCluster.Name, "group", workerGroup.GroupName,
+				"oldReplicas", currentReplicas, "newReplicas", desiredReplicas)
+			r.Recorder.Eventf(scaler, corev1.EventTypeNormal, string(utils.ScaledWorkerGroup),
+				"Scaled worker group %s of RayCluster %s/%s from %d to %d replicas",
+				workerGroup.GroupName, rayCluster.Namespace, rayCluster.Name, currentReplicas, desiredReplicas)
+		}
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{
+		utils.RayClusterLabelKey:   rayCluster.Name,
+		utils.RayNodeGroupLabelKey: workerGroup.GroupName,
+	})
+	scaler.Status.Selector = selector.String()
+
+	workerPods := corev1.PodList{}
+	if err := r.List(ctx, &workerPods, common.RayClusterGroupPodsAssociationOptions(rayCluster, workerGroup.GroupName).ToListOptions()...); err != nil {
+		return err
+	}
+	var runningPods int32
+	for _, pod := range workerPods.Items {
+		if pod.DeletionTimestamp.IsZero() {
+			runningPods++
+		}
+	}
+	// Each replica of a multi-host worker group consists of NumOfHosts Pods.
+	numOfHosts := max(workerGroup.NumOfHosts, 1)
+	scaler.Status.Replicas = runningPods / numOfHosts
+
+	setRayWorkerGroupScalerReadyCondition(scaler, metav1.ConditionTrue, rayv1.WorkerGroupScaled,
+		fmt.Sprintf("worker group %s of RayCluster %s is driven by this RayWorkerGroupScaler", workerGroup.GroupName, rayCluster.Name))
+	return nil
+}
+
+// clampWorkerGroupReplicas bounds the requested replicas by the MinReplicas and MaxReplicas of the worker group.
+func clampWorkerGroupReplicas(workerGroup rayv1.WorkerGroupSpec, replicas int32) int32 {
+	minReplicas := ptr.Deref(workerGroup.MinReplicas, int32(0))
+	maxReplicas := ptr.Deref(workerGroup.MaxReplicas, int32(math.MaxInt32))
+	return min(max(replicas, minReplicas), maxReplicas)
+}
+
+func setRayWorkerGroupScalerReadyCondition(scaler *rayv1.RayWorkerGroupScaler, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&scaler.Status.Conditions, metav1.Condition{
+		Type:               string(rayv1.RayWorkerGroupScalerReady),
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: scaler.Generation,
+	})
+}
+
+func (r *RayWorkerGroupScalerReconciler) updateRayWorkerGroupScalerStatus(ctx context.Context, oldScaler *rayv1.RayWorkerGroupScaler, newScaler *rayv1.RayWorkerGroupScaler) error {
+	logger := ctrl.LoggerFrom(ctx)
+	if utils.InconsistentRayWorkerGroupScalerStatus(oldScaler.Status, newScaler.Status) {
+		logger.Info("updateRayWorkerGroupScalerStatus", "old RayWorkerGroupScalerStatus", oldScaler.Status, "new RayWorkerGroupScalerStatus", newScaler.Status)
+		if err := r.Status().Update(ctx, newScaler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rayWorkerGroupScalersForRayCluster maps a RayCluster to the RayWorkerGroupScalers that target it.
+func (r *RayWorkerGroupScalerReconciler) rayWorkerGroupScalersForRayCluster(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.listRayWorkerGroupScalerRequests(ctx, obj.GetNamespace(), obj.GetName(), "")
+}
+
+// rayWorkerGroupScalersForPod maps a worker Pod to the RayWorkerGroupScalers that target its worker group, so that
+// status.replicas is updated when worker Pods are created or deleted.
+func (r *RayWorkerGroupScalerReconciler) rayWorkerGroupScalersForPod(ctx context.Context, obj client.Object) []reconcile.Request {
+	podLabels := obj.GetLabels()
+	clusterName, groupName := podLabels[utils.RayClusterLabelKey], podLabels[utils.RayNodeGroupLabelKey]
+	if clusterName == "" || groupName == "" || podLabels[utils.RayNodeTypeLabelKey] != string(rayv1.WorkerNode) {
+		return nil
+	}
+	return r.listRayWorkerGroupScalerRequests(ctx, obj.GetNamespace(), clusterName, groupName)
+}
+
+// listRayWorkerGroupScalerRequests returns the RayWorkerGroupScalers that target the RayCluster. If groupName is not
+// empty, only the RayWorkerGroupScalers that target that worker group are returned.
+func (r *RayWorkerGroupScalerReconciler) listRayWorkerGroupScalerRequests(ctx context.Context, namespace, clusterName, groupName string) []reconcile.Request {
+	logger := ctrl.LoggerFrom(ctx)
+	fields := client.MatchingFields{rayWorkerGroupScalerClusterIndexKey: clusterName}
+	if groupName != "" {
+		fields = client.MatchingFields{rayWorkerGroupScalerGroupIndexKey: rayWorkerGroupScalerGroupIndexValue(clusterName, groupName)}
+	}
+	scalers := &rayv1.RayWorkerGroupScalerList{}
+	if err := r.List(ctx, scalers, client.InNamespace(namespace), fields); err != nil {
+		logger.Error(err, "Failed to list RayWorkerGroupScalers", "namespace", namespace)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(scalers.Items))
+	for _, scaler := range scalers.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&scaler)})
+	}
+	return requests
+}
+
+// indexRayWorkerGroupScalerCluster indexes a RayWorkerGroupScaler by the name of the RayCluster that it targets.
+func indexRayWorkerGroupScalerCluster(obj client.Object) []string {
+	return []string{obj.(*rayv1.RayWorkerGroupScaler).Spec.RayClusterName}
+}
+
+// indexRayWorkerGroupScalerGroup indexes a RayWorkerGroupScaler by the RayCluster and worker group that it targets.
+func indexRayWorkerGroupScalerGroup(obj client.Object) []string {
+	scaler := obj.(*rayv1.RayWorkerGroupScaler)
+	return []string{rayWorkerGroupScalerGroupIndexValue(scaler.Spec.RayClusterName, scaler.Spec.GroupName)}
+}
+
+func rayWorkerGroupScalerGroupIndexValue(clusterName, groupName string) string {
+	return clusterName + "/" + groupName
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RayWorkerGroupScalerReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcurrency int) error {
+	indexer := mgr.GetFieldIndexer()
+	if err := indexer.IndexField(context.Background(), &rayv1.RayWorkerGroupScaler{}, rayWorkerGroupScalerClusterIndexKey, indexRayWorkerGroupScalerCluster); err != nil {
+		return err
+	}
+	if err := indexer.IndexField(context.Background(), &rayv1.RayWorkerGroupScaler{}, rayWorkerGroupScalerGroupIndexKey, indexRayWorkerGroupScalerGroup); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rayv1.RayWorkerGroupScaler{}).
+		Watches(&rayv1.RayCluster{}, handler.EnqueueRequestsFromMapFunc(r.rayWorkerGroupScalersForRayCluster)).
+		// status.replicas is counted from the worker Pods, so it must be updated whenever they are created or deleted.
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.rayWorkerGroupScalersForPod)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: reconcileConcurrency,
+			LogConstructor: func(request *reconcile.Request) logr.Logger {
+				logger := ctrl.Log.WithName("controllers").WithName("RayWorkerGroupScaler")
+				if request != nil {
+					logger = logger.WithValues("RayWorkerGroupScaler", request.NamespacedName)
+				}
+				return logger
+			},
+		}).
+		Complete(r)
+}
@@ -0,0 +1,244 @@
+package ray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func rayWorkerGroupScalerTemplate(replicas *int32) *rayv1.RayWorkerGroupScaler {
+	return &rayv1.RayWorkerGroupScaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "scaler",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: rayv1.RayWorkerGroupScalerSpec{
+			RayClusterName: "raycluster-sample",
+			GroupName:      "small-group",
+			Replicas:       replicas,
+		},
+	}
+}
+
+func rayClusterForScalerTemplate(autoscaling bool) *rayv1.RayCluster {
+	return &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "raycluster-sample",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayClusterSpec{
+			EnableInTreeAutoscaling: ptr.To(autoscaling),
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "small-group",
+					Replicas:    ptr.To[int32](1),
+					MinReplicas: ptr.To[int32](1),
+					MaxReplicas: ptr.To[int32](5),
+					NumOfHosts:  1,
+				},
+			},
+		},
+	}
+}
+
+func workerPodForScaler(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				utils.RayClusterLabelKey:   "raycluster-sample",
+				utils.RayNodeGroupLabelKey: "small-group",
+				utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+			},
+		},
+	}
+}
+
+func TestRayWorkerGroupScalerReconcile(t *testing.T) {
+	tests := []struct {
+		name             string
+		scaler           *rayv1.RayWorkerGroupScaler
+		objects          []runtime.Object
+		expectedReason   string
+		expectedEvent    string
+		expectedReplicas *int32
+		expectedStatus   int32
+		expectedReady    metav1.ConditionStatus
+	}{
+		{
+			name:             "scale the worker group to the desired replicas",
+			scaler:           rayWorkerGroupScalerTemplate(ptr.To[int32](3)),
+			objects:          []runtime.Object{rayClusterForScalerTemplate(false), workerPodForScaler("worker-1")},
+			expectedReady:    metav1.ConditionTrue,
+			expectedReason:   rayv1.WorkerGroupScaled,
+			expectedEvent:    string(utils.ScaledWorkerGroup),
+			expectedReplicas: ptr.To[int32](3),
+			expectedStatus:   1,
+		},
+		{
+			name:             "clamp the desired replicas to maxReplicas",
+			scaler:           rayWorkerGroupScalerTemplate(ptr.To[int32](10)),
+			objects:          []runtime.Object{rayClusterForScalerTemplate(false)},
+			expectedReady:    metav1.ConditionTrue,
+			expectedReason:   rayv1.WorkerGroupScaled,
+			expectedEvent:    string(utils.ScaledWorkerGroup),
+			expectedReplicas: ptr.To[int32](5),
+		},
+		{
+			name:             "clamp the desired replicas to minReplicas",
+			scaler:           rayWorkerGroupScalerTemplate(ptr.To[int32](0)),
+			objects:          []runtime.Object{rayClusterForScalerTemplate(false), workerPodForScaler("worker-1")},
+			expectedReady:    metav1.ConditionTrue,
+			expectedReason:   rayv1.WorkerGroupScaled,
+			expectedReplicas: ptr.To[int32](1),
+			expectedStatus:   1,
+		},
+		{
+			name:             "refuse to scale when in-tree autoscaling is enabled",
+			scaler:           rayWorkerGroupScalerTemplate(ptr.To[int32](3)),
+			objects:          []runtime.Object{rayClusterForScalerTemplate(true)},
+			expectedReady:    metav1.ConditionFalse,
+			expectedReason:   rayv1.InTreeAutoscalingEnabled,
+			expectedEvent:    string(utils.InTreeAutoscalingConflict),
+			expectedReplicas: ptr.To[int32](1),
+		},
+		{
+			name:           "RayCluster not found",
+			scaler:         rayWorkerGroupScalerTemplate(ptr.To[int32](3)),
+			expectedReady:  metav1.ConditionFalse,
+			expectedReason: rayv1.TargetRayClusterNotFound,
+		},
+		{
+			name: "worker group not found",
+			scaler: func() *rayv1.RayWorkerGroupScaler {
+				scaler := rayWorkerGroupScalerTemplate(ptr.To[int32](3))
+				scaler.Spec.GroupName = "unknown-group"
+				return scaler
+			}(),
+			objects:          []runtime.Object{rayClusterForScalerTemplate(false)},
+			expectedReady:    metav1.ConditionFalse,
+			expectedReason:   rayv1.TargetWorkerGroupNotFound,
+			expectedReplicas: ptr.To[int32](1),
+		},
+		{
+			name: "invalid spec",
+			scaler: func() *rayv1.RayWorkerGroupScaler {
+				scaler := rayWorkerGroupScalerTemplate(ptr.To[int32](3))
+				scaler.Spec.RayClusterName = ""
+				return scaler
+			}(),
+			expectedReady:  metav1.ConditionFalse,
+			expectedReason: rayv1.WorkerGroupScalerSpecInvalid,
+			expectedEvent:  string(utils.InvalidRayWorkerGroupScalerSpec),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			require.NoError(t, rayv1.AddToScheme(scheme))
+			require.NoError(t, corev1.AddToScheme(scheme))
+
+			fakeClient := clientFake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRuntimeObjects(append(tc.objects, tc.scaler)...).
+				WithStatusSubresource(&rayv1.RayWorkerGroupScaler{}).
+				Build()
+			fakeRecorder := record.NewFakeRecorder(10)
+			reconciler := &RayWorkerGroupScalerReconciler{
+				Client:   fakeClient,
+				Scheme:   scheme,
+				Recorder: fakeRecorder,
+			}
+
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "scaler"}})
+			require.NoError(t, err)
+
+			scaler := &rayv1.RayWorkerGroupScaler{}
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "scaler"}, scaler))
+			condition := meta.FindStatusCondition(scaler.Status.Conditions, string(rayv1.RayWorkerGroupScalerReady))
+			require.NotNil(t, condition)
+			assert.Equal(t, tc.expectedReady, condition.Status)
+			assert.Equal(t, tc.expectedReason, condition.Reason)
+			assert.Equal(t, tc.expectedStatus, scaler.Status.Replicas)
+			assert.Equal(t, int64(1), scaler.Status.ObservedGeneration)
+
+			if tc.expectedReady == metav1.ConditionTrue {
+				assert.Equal(t, "ray.io/cluster=raycluster-sample,ray.io/group=small-group", scaler.Status.Selector)
+			}
+
+			if tc.expectedEvent != "" {
+				require.Len(t, fakeRecorder.Events, 1)
+				assert.Contains(t, <-fakeRecorder.Events, tc.expectedEvent)
+			} else {
+				assert.Empty(t, fakeRecorder.Events)
+			}
+
+			if tc.expectedReplicas != nil {
+				rayCluster := &rayv1.RayCluster{}
+				require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "raycluster-sample"}, rayCluster))
+				assert.Equal(t, *tc.expectedReplicas, *rayCluster.Spec.WorkerGroupSpecs[0].Replicas)
+			}
+		})
+	}
+}
+
+func TestRayWorkerGroupScalersForRayCluster(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, rayv1.AddToScheme(scheme))
+
+	matching := rayWorkerGroupScalerTemplate(nil)
+	other := rayWorkerGroupScalerTemplate(nil)
+	other.Name = "other-scaler"
+	other.Spec.RayClusterName = "other-cluster"
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(matching, other).
+		WithIndex(&rayv1.RayWorkerGroupScaler{}, rayWorkerGroupScalerClusterIndexKey, indexRayWorkerGroupScalerCluster).
+		Build()
+	reconciler := &RayWorkerGroupScalerReconciler{Client: fakeClient, Scheme: scheme}
+
+	requests := reconciler.rayWorkerGroupScalersForRayCluster(context.Background(), rayClusterForScalerTemplate(false))
+	require.Len(t, requests, 1)
+	assert.Equal(t, "scaler", requests[0].Name)
+}
+
+func TestRayWorkerGroupScalersForPod(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, rayv1.AddToScheme(scheme))
+
+	matching := rayWorkerGroupScalerTemplate(nil)
+	otherGroup := rayWorkerGroupScalerTemplate(nil)
+	otherGroup.Name = "other-group-scaler"
+	otherGroup.Spec.GroupName = "large-group"
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(matching, otherGroup).
+		WithIndex(&rayv1.RayWorkerGroupScaler{}, rayWorkerGroupScalerGroupIndexKey, indexRayWorkerGroupScalerGroup).
+		Build()
+	reconciler := &RayWorkerGroupScalerReconciler{Client: fakeClient, Scheme: scheme}
+
+	requests := reconciler.rayWorkerGroupScalersForPod(context.Background(), workerPodForScaler("worker-1"))
+	require.Len(t, requests, 1)
+	assert.Equal(t, "scaler", requests[0].Name)
+
+	// The head Pod doesn't belong to any worker group.
+	headPod := workerPodForScaler("head")
+	headPod.Labels[utils.RayNodeTypeLabelKey] = string(rayv1.HeadNode)
+	assert.Empty(t, reconciler.rayWorkerGroupScalersForPod(context.Background(), headPod))
+}
@@ -34,7 +34,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
+	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/kueue"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
 )
@@ -59,9 +62,9 @@ func (testProvider TestClientProvider) GetDashboardClient(_ context.Context, _ m
 	}
 }
 
-func (testProvider TestClientProvider) GetHttpProxyClient(_ manager.Manager) func(hostIp, podNamespace, podName string, port int) utils.RayHttpProxyClientInterface {
-	return func(_, _, _ string, _ int) utils.RayHttpProxyClientInterface {
-		return fakeRayHttpProxyClient
+func (testProvider TestClientProvider) GetHttpProxyClient(_ manager.Manager) func(rayCluster *rayv1.RayCluster, hostIp, podNamespace, podName string, port int) (utils.RayHttpProxyClientInterface, error) {
+	return func(_ *rayv1.RayCluster, _, _, _ string, _ int) (utils.RayHttpProxyClientInterface, error) {
+		return fakeRayHttpProxyClient, nil
 	}
 }
 
@@ -76,7 +79,7 @@ var _ = BeforeSuite(func(ctx SpecContext) {
 
 	By("bootstrapping test environment")
 	testEnv = &envtest.Environment{
-		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases"), filepath.Join("testdata", "crd")},
 		ErrorIfCRDPathMissing: true,
 	}
 
@@ -118,14 +121,18 @@ var _ = BeforeSuite(func(ctx SpecContext) {
 			},
 		},
 	}
-	err = NewReconciler(mgr, options).SetupWithManager(mgr, 1)
+	testClientProvider := TestClientProvider{}
+	err = NewReconciler(ctx, mgr, options, testClientProvider).SetupWithManager(mgr, 1)
 	Expect(err).NotTo(HaveOccurred(), "failed to setup RayCluster controller")
 
-	testClientProvider := TestClientProvider{}
 	err = NewRayServiceReconciler(ctx, mgr, testClientProvider).SetupWithManager(mgr, 1)
 	Expect(err).NotTo(HaveOccurred(), "failed to setup RayService controller")
 
-	rayJobOptions := RayJobReconcilerOptions{}
+	// RayJobs with the queue name label of the Kueue plugin are queued through Workloads.
+	batchSchedulerManager, err := batchscheduler.NewSchedulerManager(ctx, configapi.Configuration{BatchScheduler: kueue.GetPluginName()}, cfg, mgr.GetClient())
+	Expect(err).NotTo(HaveOccurred(), "failed to create batch scheduler manager")
+
+	rayJobOptions := RayJobReconcilerOptions{BatchSchedulerManager: batchSchedulerManager}
 	err = NewRayJobReconciler(ctx, mgr, rayJobOptions, testClientProvider).SetupWithManager(mgr, 1)
 	Expect(err).NotTo(HaveOccurred(), "failed to setup RayJob controller")
 
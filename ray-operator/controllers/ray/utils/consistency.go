@@ -27,9 +27,18 @@ func InconsistentRayClusterStatus(oldStatus rayv1.RayClusterStatus, newStatus ra
 	if !reflect.DeepEqual(oldStatus.Endpoints, newStatus.Endpoints) || !reflect.DeepEqual(oldStatus.Head, newStatus.Head) {
 		return true
 	}
+	if !reflect.DeepEqual(oldStatus.WorkerGroupStatuses, newStatus.WorkerGroupStatuses) {
+		return true
+	}
 	if !reflect.DeepEqual(oldStatus.Conditions, newStatus.Conditions) {
 		return true
 	}
+	if !reflect.DeepEqual(oldStatus.AuthToken, newStatus.AuthToken) {
+		return true
+	}
+	if !reflect.DeepEqual(oldStatus.Idle, newStatus.Idle) {
+		return true
+	}
 	return false
 }
 
@@ -111,3 +120,14 @@ func InconsistentRayServiceStatuses(oldStatus rayv1.RayServiceStatuses, newStatu
 
 	return false
 }
+
+// Determine whether to update the status of the RayWorkerGroupScaler instance.
+func InconsistentRayWorkerGroupScalerStatus(oldStatus rayv1.RayWorkerGroupScalerStatus, newStatus rayv1.RayWorkerGroupScalerStatus) bool {
+	if oldStatus.Replicas != newStatus.Replicas || oldStatus.Selector != newStatus.Selector {
+		return true
+	}
+	if oldStatus.ObservedGeneration != newStatus.ObservedGeneration {
+		return true
+	}
+	return !reflect.DeepEqual(oldStatus.Conditions, newStatus.Conditions)
+}
@@ -112,6 +112,20 @@ func TestInconsistentRayClusterStatus(t *testing.T) {
 			},
 			expectResult: true,
 		},
+		{
+			name: "WorkerGroupStatuses is updated, expect result to be true",
+			modifyStatus: func(newStatus *rayv1.RayClusterStatus) {
+				newStatus.WorkerGroupStatuses = []rayv1.WorkerGroupStatus{{GroupName: "small-group", DesiredReplicas: 1}}
+			},
+			expectResult: true,
+		},
+		{
+			name: "Idle is updated, expect result to be true",
+			modifyStatus: func(newStatus *rayv1.RayClusterStatus) {
+				newStatus.Idle = &rayv1.IdleStatus{LastActivityTime: &metav1.Time{Time: time.Now()}}
+			},
+			expectResult: true,
+		},
 		{
 			name: "RayClusterReplicaFailure is updated, expect result to be true",
 			modifyStatus: func(newStatus *rayv1.RayClusterStatus) {
@@ -189,3 +203,21 @@ func TestInconsistentRayServiceStatuses(t *testing.T) {
 	newStatus = oldStatus.DeepCopy()
 	assert.False(t, InconsistentRayServiceStatuses(oldStatus, *newStatus))
 }
+
+func TestInconsistentRayWorkerGroupScalerStatus(t *testing.T) {
+	oldStatus := rayv1.RayWorkerGroupScalerStatus{
+		Replicas:           2,
+		Selector:           "ray.io/cluster=raycluster-sample,ray.io/group=small-group",
+		ObservedGeneration: 1,
+	}
+
+	newStatus := oldStatus.DeepCopy()
+	assert.False(t, InconsistentRayWorkerGroupScalerStatus(oldStatus, *newStatus))
+
+	newStatus.Replicas = 3
+	assert.True(t, InconsistentRayWorkerGroupScalerStatus(oldStatus, *newStatus))
+
+	newStatus = oldStatus.DeepCopy()
+	newStatus.Conditions = []metav1.Condition{{Type: string(rayv1.RayWorkerGroupScalerReady), Status: metav1.ConditionTrue}}
+	assert.True(t, InconsistentRayWorkerGroupScalerStatus(oldStatus, *newStatus))
+}
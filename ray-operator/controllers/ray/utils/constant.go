@@ -25,11 +25,22 @@ const (
 	RayClusterHeadlessServiceLabelKey        = "ray.io/headless-worker-svc"
 	HashWithoutReplicasAndWorkersToDeleteKey = "ray.io/hash-without-replicas-and-workers-to-delete"
 	UpgradeStrategyRecreateHashKey           = "ray.io/upgrade-strategy-recreate-hash"
+	ImagePrePullHashKey                      = "ray.io/image-pre-pull-hash"
 	NumWorkerGroupsKey                       = "ray.io/num-worker-groups"
 	KubeRayVersion                           = "ray.io/kuberay-version"
 	RayCronJobNameLabelKey                   = "ray.io/cronjob-name"
 	RayCronJobTimestampAnnotationKey         = "ray.io/cronjob-scheduled-timestamp"
 	RayJobSubmissionModeLabelKey             = "ray.io/job-submission-mode"
+	// RayManagedRedisLabelKey selects the Redis Pod that KubeRay manages for a RayCluster's GCS fault tolerance.
+	// The Redis Pod doesn't have the `ray.io/cluster` label so that it isn't treated as a Ray Pod.
+	RayManagedRedisLabelKey = "ray.io/managed-redis"
+	// RayImagePrePullLabelKey selects the Pods of the DaemonSets that pre-pull the images of a RayCluster. Like the
+	// managed Redis Pod, these Pods don't have the `ray.io/cluster` label so that they aren't treated as Ray Pods.
+	RayImagePrePullLabelKey = "ray.io/image-pre-pull"
+	// RayVolumeClaimTemplateLabelKey is the name of the volume claim template that a PersistentVolumeClaim is created from.
+	RayVolumeClaimTemplateLabelKey = "ray.io/volume-claim-template"
+	// KueueQueueNameLabelKey selects the Kueue LocalQueue of a RayJob queued by the Kueue batch scheduler.
+	KueueQueueNameLabelKey = "ray.io/kueue-queue-name"
 	// DisableProvisionedHeadRestartAnnotationKey marks RayClusters created for sidecar-mode RayJobs to skip head Pod recreation after provisioning.
 	DisableProvisionedHeadRestartAnnotationKey = "ray.io/disable-provisioned-head-restart"
 
@@ -77,6 +88,21 @@ const (
 	//   The RayService must be deleted and recreated. Updating the spec will NOT retry initialization.
 	RayServiceInitializingTimeoutAnnotation = "ray.io/initializing-timeout"
 
+	// RayServiceRollbackToRevisionAnnotationKey is set on a RayService to restore the RayCluster spec and Serve config
+	// recorded in the revision with the given number. KubeRay removes the annotation once the spec has been restored,
+	// and the restored spec goes through the normal upgrade path.
+	RayServiceRollbackToRevisionAnnotationKey = "ray.io/rollback-to-revision"
+	// ServeConfigV2SecretKey is the key of the Serve config in the Secrets that KubeRay creates to store the Serve config
+	// of a revision, and the Serve config that a RayService was rolled back to.
+	ServeConfigV2SecretKey = "serveConfigV2" // #nosec G101
+
+	// RayAuthTokenRotationTimeAnnotationKey records when the auth token generated by KubeRay was last rotated.
+	// It is set on the auth Secret, and on Ray Pods to tell which token they were created with.
+	RayAuthTokenRotationTimeAnnotationKey = "ray.io/auth-token-rotation-time" // #nosec G101
+	// RayPreviousAuthTokenExpirationTimeAnnotationKey is set on the auth Secret and records when the grace period
+	// of the previous auth token ends.
+	RayPreviousAuthTokenExpirationTimeAnnotationKey = "ray.io/previous-auth-token-expiration-time" // #nosec G101
+
 	// RayJob default cluster selector key
 	RayJobClusterSelectorKey = "ray.io/cluster"
 
@@ -168,6 +194,9 @@ const (
 	RAY_AUTH_TOKEN_ENV_VAR = "RAY_AUTH_TOKEN" // #nosec G101
 	// RAY_AUTH_TOKEN_SECRET_KEY is the key used in the Secret containing Ray auth token
 	RAY_AUTH_TOKEN_SECRET_KEY = "auth_token"
+	// RAY_PREVIOUS_AUTH_TOKEN_SECRET_KEY is the key used in the Secret to keep the previous Ray auth token
+	// during the grace period after a rotation.
+	RAY_PREVIOUS_AUTH_TOKEN_SECRET_KEY = "previous_auth_token" // #nosec G101
 	// RAY_ENABLE_K8S_TOKEN_AUTH is the Ray environment variable for enabling K8s token authentication.
 	RAY_ENABLE_K8S_TOKEN_AUTH_ENV_VAR = "RAY_ENABLE_K8S_TOKEN_AUTH" // #nosec G101
 	// RayTokenVolumeName is the name of the projected volume for Kubernetes token authentication.
@@ -175,6 +204,19 @@ const (
 	// RayTokenMountPath is the mount path for the projected volume for Kubernetes token authentication.
 	RayTokenMountPath = "/var/run/secrets/ray.io/serviceaccount" // #nosec G101
 
+	// Ray environment variables for configuring TLS between Ray components.
+	RAY_USE_TLS         = "RAY_USE_TLS"
+	RAY_TLS_SERVER_CERT = "RAY_TLS_SERVER_CERT"
+	RAY_TLS_SERVER_KEY  = "RAY_TLS_SERVER_KEY"
+	RAY_TLS_CA_CERT     = "RAY_TLS_CA_CERT"
+	// RayTLSCACertKey is the key of the CA certificate in the Secret referenced by TLSOptions.
+	// The certificate and private key use the standard `tls.crt` and `tls.key` keys.
+	RayTLSCACertKey = "ca.crt"
+	// RayTLSVolumeName is the name of the volume that holds the Secret referenced by TLSOptions.
+	RayTLSVolumeName = "kuberay-tls"
+	// RayTLSMountPath is the mount path of the TLS volume in Ray containers.
+	RayTLSMountPath = "/etc/kuberay/tls"
+
 	// This KubeRay operator environment variable is used to determine if random Pod
 	// deletion should be enabled. Note that this only takes effect when autoscaling
 	// is enabled for the RayCluster. This is a feature flag for v0.6.0, and will be
@@ -285,6 +327,25 @@ const (
 
 	ServeConfigLRUSize = 1000
 
+	// DefaultRayServiceRevisionHistoryLimit is the default number of revisions retained for a RayService.
+	DefaultRayServiceRevisionHistoryLimit = 10
+
+	// DefaultAuthTokenRotationGracePeriodSeconds is the default number of seconds the previous auth token is kept after a rotation.
+	DefaultAuthTokenRotationGracePeriodSeconds = 600
+
+	// DefaultIdleWarningPeriodSeconds is the default number of seconds before the idle action that a Warning event is emitted.
+	DefaultIdleWarningPeriodSeconds = 300
+
+	// DefaultImagePrePullHelperImage is the default image that provides the static binary run by the image pre-pull
+	// DaemonSet Pods.
+	DefaultImagePrePullHelperImage = "busybox:1.36"
+
+	// Defaults of the Redis that KubeRay manages for GCS fault tolerance.
+	DefaultManagedRedisImage = "redis:7.4"
+	ManagedRedisPort         = 6379
+	// ManagedRedisPasswordSecretKey is the key of the Redis password in the managed Redis Secret.
+	ManagedRedisPasswordSecretKey = "password"
+
 	// MaxRayClusterNameLength is the maximum RayCluster name to make sure we don't truncate
 	// their k8s service names. Currently, "-serve-svc" is the longest service suffix:
 	// 63 - len("-serve-svc") == 53, so the name should not be longer than 53 characters.
@@ -325,6 +386,8 @@ var (
 	ErrFailedCreateHeadPod   = &errRayClusterReplicaFailure{reason: "FailedCreateHeadPod"}
 	ErrFailedDeleteWorkerPod = &errRayClusterReplicaFailure{reason: "FailedDeleteWorkerPod"}
 	ErrFailedCreateWorkerPod = &errRayClusterReplicaFailure{reason: "FailedCreateWorkerPod"}
+
+	ErrFailedResizeBatchSchedulerGang = &errRayClusterReplicaFailure{reason: "FailedResizeBatchSchedulerGang"}
 )
 
 func RayClusterReplicaFailureReason(err error) string {
@@ -360,6 +423,62 @@ const (
 	CreatedRedisCleanupJob        K8sEventType = "CreatedRedisCleanupJob"
 	FailedToCreateRedisCleanupJob K8sEventType = "FailedToCreateRedisCleanupJob"
 
+	// Managed Redis event list
+	CreatedManagedRedis        K8sEventType = "CreatedManagedRedis"
+	UpdatedManagedRedis        K8sEventType = "UpdatedManagedRedis"
+	FailedToCreateManagedRedis K8sEventType = "FailedToCreateManagedRedis"
+	FailedToUpdateManagedRedis K8sEventType = "FailedToUpdateManagedRedis"
+
+	// PodDisruptionBudget event list
+	CreatedPodDisruptionBudget        K8sEventType = "CreatedPodDisruptionBudget"
+	UpdatedPodDisruptionBudget        K8sEventType = "UpdatedPodDisruptionBudget"
+	DeletedPodDisruptionBudget        K8sEventType = "DeletedPodDisruptionBudget"
+	FailedToCreatePodDisruptionBudget K8sEventType = "FailedToCreatePodDisruptionBudget"
+	FailedToUpdatePodDisruptionBudget K8sEventType = "FailedToUpdatePodDisruptionBudget"
+	FailedToDeletePodDisruptionBudget K8sEventType = "FailedToDeletePodDisruptionBudget"
+
+	// NetworkPolicy event list
+	CreatedNetworkPolicy        K8sEventType = "CreatedNetworkPolicy"
+	UpdatedNetworkPolicy        K8sEventType = "UpdatedNetworkPolicy"
+	DeletedNetworkPolicy        K8sEventType = "DeletedNetworkPolicy"
+	FailedToCreateNetworkPolicy K8sEventType = "FailedToCreateNetworkPolicy"
+	FailedToUpdateNetworkPolicy K8sEventType = "FailedToUpdateNetworkPolicy"
+	FailedToDeleteNetworkPolicy K8sEventType = "FailedToDeleteNetworkPolicy"
+
+	// DaemonSet event list
+	CreatedDaemonSet        K8sEventType = "CreatedDaemonSet"
+	UpdatedDaemonSet        K8sEventType = "UpdatedDaemonSet"
+	DeletedDaemonSet        K8sEventType = "DeletedDaemonSet"
+	FailedToCreateDaemonSet K8sEventType = "FailedToCreateDaemonSet"
+	FailedToUpdateDaemonSet K8sEventType = "FailedToUpdateDaemonSet"
+	FailedToDeleteDaemonSet K8sEventType = "FailedToDeleteDaemonSet"
+
+	// Auth token event list
+	RotatedAuthToken         K8sEventType = "RotatedAuthToken"
+	FailedToRotateAuthToken  K8sEventType = "FailedToRotateAuthToken"
+	ExpiredPreviousAuthToken K8sEventType = "ExpiredPreviousAuthToken"
+
+	// Idle policy event list
+	IdleRayCluster                K8sEventType = "IdleRayCluster"
+	SuspendedIdleRayCluster       K8sEventType = "SuspendedIdleRayCluster"
+	DeletedIdleRayCluster         K8sEventType = "DeletedIdleRayCluster"
+	FailedToReclaimIdleRayCluster K8sEventType = "FailedToReclaimIdleRayCluster"
+
+	// Scaling schedule event list
+	StartedScalingSchedule K8sEventType = "StartedScalingSchedule"
+	EndedScalingSchedule   K8sEventType = "EndedScalingSchedule"
+
+	// Worker group fallback event list
+	PreemptedWorkerPod         K8sEventType = "PreemptedWorkerPod"
+	StartedWorkerGroupFallback K8sEventType = "StartedWorkerGroupFallback"
+	EndedWorkerGroupFallback   K8sEventType = "EndedWorkerGroupFallback"
+
+	// PersistentVolumeClaim event list
+	CreatedPersistentVolumeClaim        K8sEventType = "CreatedPersistentVolumeClaim"
+	DeletedPersistentVolumeClaim        K8sEventType = "DeletedPersistentVolumeClaim"
+	FailedToCreatePersistentVolumeClaim K8sEventType = "FailedToCreatePersistentVolumeClaim"
+	FailedToDeletePersistentVolumeClaim K8sEventType = "FailedToDeletePersistentVolumeClaim"
+
 	// RayJob event list
 	InvalidRayJobSpec             K8sEventType = "InvalidRayJobSpec"
 	InvalidRayJobMetadata         K8sEventType = "InvalidRayJobMetadata"
@@ -375,15 +494,23 @@ const (
 	FailedToDeleteRayCluster      K8sEventType = "FailedToDeleteRayCluster"
 	FailedToUpdateRayCluster      K8sEventType = "FailedToUpdateRayCluster"
 	RayClusterNotFound            K8sEventType = "RayClusterNotFound"
+	PreemptedRayCluster           K8sEventType = "PreemptedRayCluster"
 
 	// Batch scheduler event list
-	BatchSchedulerCleanedUp       K8sEventType = "BatchSchedulerCleanedUp"
-	FailedToCleanupBatchScheduler K8sEventType = "FailedToCleanupBatchScheduler"
+	BatchSchedulerCleanedUp          K8sEventType = "BatchSchedulerCleanedUp"
+	FailedToCleanupBatchScheduler    K8sEventType = "FailedToCleanupBatchScheduler"
+	FailedToResizeBatchSchedulerGang K8sEventType = "FailedToResizeBatchSchedulerGang"
 
 	// RayCronJob event list
 	InvalidRayCronJobSpec K8sEventType = "InvalidRayCronJobSpec"
 	SuspendedRayCronJob   K8sEventType = "SuspendedRayCronJob"
 
+	// RayWorkerGroupScaler event list
+	InvalidRayWorkerGroupScalerSpec K8sEventType = "InvalidRayWorkerGroupScalerSpec"
+	ScaledWorkerGroup               K8sEventType = "ScaledWorkerGroup"
+	FailedToScaleWorkerGroup        K8sEventType = "FailedToScaleWorkerGroup"
+	InTreeAutoscalingConflict       K8sEventType = "InTreeAutoscalingConflict"
+
 	// RayService event list
 	CreatedGateway                  K8sEventType = "CreatedGateway"
 	CreatedHTTPRoute                K8sEventType = "CreatedHTTPRoute"
@@ -398,6 +525,12 @@ const (
 	FailedToUpdateHeadPodServeLabel K8sEventType = "FailedToUpdateHeadPodServeLabel"
 	FailedToUpdateServeApplications K8sEventType = "FailedToUpdateServeApplications"
 	FailedToUpdateTargetCapacity    K8sEventType = "FailedToUpdateTargetCapacity"
+	FailedToGetServeConfig          K8sEventType = "FailedToGetServeConfig"
+	FailedToRolloutServeApplication K8sEventType = "FailedToRolloutServeApplication"
+	CreatedRevision                 K8sEventType = "CreatedRevision"
+	FailedToCreateRevision          K8sEventType = "FailedToCreateRevision"
+	RolledBackRayService            K8sEventType = "RolledBackRayService"
+	FailedToRollbackRayService      K8sEventType = "FailedToRollbackRayService"
 	FailedToCreateGateway           K8sEventType = "FailedToCreateGateway"
 	FailedToUpdateGateway           K8sEventType = "FailedToUpdateGateway"
 	FailedToCreateHTTPRoute         K8sEventType = "FailedToCreateHTTPRoute"
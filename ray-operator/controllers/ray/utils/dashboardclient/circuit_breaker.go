@@ -0,0 +1,117 @@
+package dashboardclient
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ErrCircuitOpen is returned without sending the request when the circuit breaker of the RayCluster's dashboard is open.
+var ErrCircuitOpen = errors.New("the Ray dashboard is unhealthy and requests to it are short-circuited")
+
+// CircuitBreakerState is the state of the circuit breaker of a RayCluster's dashboard.
+type CircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed lets requests through.
+	CircuitBreakerClosed CircuitBreakerState = "Closed"
+	// CircuitBreakerOpen short-circuits requests until the open duration has elapsed.
+	CircuitBreakerOpen CircuitBreakerState = "Open"
+	// CircuitBreakerHalfOpen lets a single probe request through to decide whether to close the circuit again.
+	CircuitBreakerHalfOpen CircuitBreakerState = "HalfOpen"
+)
+
+type circuitBreaker struct {
+	openedAt            time.Time
+	state               CircuitBreakerState
+	consecutiveFailures int
+	probing             bool
+}
+
+// CircuitBreakers tracks the circuit breakers of the RayClusters whose dashboard requests have recently failed.
+// A RayCluster without failures has no entry, so it doesn't grow with the number of healthy RayClusters. The dashboard
+// clients of all reconcilers share one CircuitBreakers so that the state of a RayCluster's dashboard survives across
+// reconciliations, and the entry of a RayCluster is removed when the RayCluster is deleted.
+type CircuitBreakers struct {
+	breakers map[types.NamespacedName]*circuitBreaker
+	now      func() time.Time
+	mu       sync.Mutex
+}
+
+// NewCircuitBreakers creates a CircuitBreakers without entries.
+func NewCircuitBreakers() *CircuitBreakers {
+	return &CircuitBreakers{
+		breakers: map[types.NamespacedName]*circuitBreaker{},
+		now:      time.Now,
+	}
+}
+
+// allow returns ErrCircuitOpen if a request to the RayCluster's dashboard should be short-circuited.
+func (c *CircuitBreakers) allow(key types.NamespacedName, openDuration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	breaker, ok := c.breakers[key]
+	if !ok {
+		return nil
+	}
+	switch breaker.state {
+	case CircuitBreakerOpen:
+		if c.now().Sub(breaker.openedAt) < openDuration {
+			return ErrCircuitOpen
+		}
+		breaker.state = CircuitBreakerHalfOpen
+		breaker.probing = true
+	case CircuitBreakerHalfOpen:
+		if breaker.probing {
+			return ErrCircuitOpen
+		}
+		breaker.probing = true
+	}
+	return nil
+}
+
+// record updates the circuit breaker of the RayCluster with the result of a request. The circuit opens after
+// failureThreshold consecutive failures, or when the probe request of a half-open circuit fails.
+func (c *CircuitBreakers) record(key types.NamespacedName, success bool, failureThreshold int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if success {
+		delete(c.breakers, key)
+		return
+	}
+	breaker, ok := c.breakers[key]
+	if !ok {
+		breaker = &circuitBreaker{state: CircuitBreakerClosed}
+		c.breakers[key] = breaker
+	}
+	breaker.consecutiveFailures++
+	breaker.probing = false
+	if breaker.state == CircuitBreakerHalfOpen || breaker.consecutiveFailures >= failureThreshold {
+		breaker.state = CircuitBreakerOpen
+		breaker.openedAt = c.now()
+	}
+}
+
+// States returns a snapshot of the circuit breaker states of the RayClusters whose dashboard requests have recently failed.
+func (c *CircuitBreakers) States() map[types.NamespacedName]CircuitBreakerState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	states := make(map[types.NamespacedName]CircuitBreakerState, len(c.breakers))
+	for key, breaker := range c.breakers {
+		states[key] = breaker.state
+	}
+	return states
+}
+
+// Remove removes the circuit breaker of a deleted RayCluster.
+func (c *CircuitBreakers) Remove(key types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.breakers, key)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -20,29 +21,44 @@ import (
 // https://stackoverflow.com/questions/4058368/what-does-eagain-mean
 var ErrAgain = errors.New("EAGAIN")
 
-const (
-	// TODO: make worker size configurable.
-	workerSize = 8
-
-	queryInterval = 3 * time.Second
-
-	// TODO: consider a proper size for accommodating the all live job info
-	cacheSize   = 10000
-	cacheExpiry = 10 * time.Minute
-
-	initBufferSize = 128
-)
+const initBufferSize = 128
+
+// RayDashboardCacheOptions configures the worker pool and the storage of a RayDashboardCache.
+type RayDashboardCacheOptions struct {
+	// Workers is the number of goroutines that query job info in the background.
+	Workers int
+	// QueryInterval is the interval between two queries of the job info of a running Ray job.
+	QueryInterval time.Duration
+	// CacheSize is the maximum number of cached job infos. The least recently used job info is evicted when it is exceeded.
+	CacheSize int
+	// CacheExpiry is how long a job info that is no longer updated stays in the cache.
+	CacheExpiry time.Duration
+}
 
-var (
-	// singleton
-	initWorkPool sync.Once
-	pool         workerPool
+// DefaultRayDashboardCacheOptions returns the RayDashboardCacheOptions used when the operator configuration doesn't override them.
+func DefaultRayDashboardCacheOptions() RayDashboardCacheOptions {
+	return RayDashboardCacheOptions{
+		Workers:       8,
+		QueryInterval: 3 * time.Second,
+		// TODO: consider a proper size for accommodating the all live job info
+		CacheSize:   10000,
+		CacheExpiry: 10 * time.Minute,
+	}
+}
 
-	// singleton
-	initCacheStorage sync.Once
-	cacheStorage     *lru.Cache[string, *JobInfoCache]
-	rwLock           sync.RWMutex
-)
+// RayDashboardCacheStats is a snapshot of the counters of a RayDashboardCache.
+type RayDashboardCacheStats struct {
+	// Hits is the number of GetJobInfo calls served with a job info or an error fetched in the background.
+	Hits int64
+	// Misses is the number of GetJobInfo calls that returned ErrAgain because no result was available yet.
+	Misses int64
+	// CapacityEvictions is the number of job infos evicted because the cache was full.
+	CapacityEvictions int64
+	// ExpiryEvictions is the number of job infos removed because they were not updated within the cache expiry.
+	ExpiryEvictions int64
+	// QueueDepth is the number of queries waiting for a worker.
+	QueueDepth int
+}
 
 type (
 	// Task defines a unit of work for the worker pool and the return value indicate if it should re-queue or not.
@@ -55,25 +71,39 @@ type (
 
 	workerPool struct {
 		taskQueue *chanx.UnboundedChan[Task]
+		// stopped is closed when the pool stops, after which new tasks are dropped.
+		stopped <-chan struct{}
+		stop    context.CancelFunc
+		// queued counts the tasks waiting for a worker. UnboundedChan.Len() can't be read concurrently without a race.
+		queued atomic.Int64
 	}
 )
 
-func (w *workerPool) start(ctx context.Context, numWorkers int, requeueDelay time.Duration) {
+func newWorkerPool() *workerPool {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &workerPool{
+		taskQueue: chanx.NewUnboundedChanSize[Task](ctx, 0, 0, initBufferSize),
+		stopped:   ctx.Done(),
+		stop:      cancel,
+	}
+}
+
+func (w *workerPool) start(ctx context.Context, wg *sync.WaitGroup, numWorkers int, requeueDelay time.Duration) {
 	logger := ctrl.LoggerFrom(ctx).WithName("WorkerPool")
-	w.taskQueue = chanx.NewUnboundedChanSize[Task](ctx, 0, 0, initBufferSize)
 
 	for i := range numWorkers {
-		go func(workerID int) {
+		wg.Go(func() {
 			for {
 				select {
 				case <-ctx.Done():
-					logger.Info("worker exiting...", "workerID", workerID)
+					logger.Info("worker exiting...", "workerID", i)
 					return
 				case task, ok := <-w.taskQueue.Out:
 					if !ok {
-						logger.Info("worker exiting from a closed channel", "workerID", workerID)
+						logger.Info("worker exiting from a closed channel", "workerID", i)
 						return
 					}
+					w.queued.Add(-1)
 					shouldRequeue := task(ctx)
 
 					if shouldRequeue && ctx.Err() == nil {
@@ -82,83 +112,152 @@ func (w *workerPool) start(ctx context.Context, numWorkers int, requeueDelay tim
 							case <-ctx.Done():
 								return
 							case w.taskQueue.In <- task:
+								w.queued.Add(1)
 							}
 						})
 					}
 				}
 			}
-		}(i)
+		})
 	}
 	logger.Info(fmt.Sprintf("Initialize a worker pool with %d goroutines and requeueDelay is %v.", numWorkers, requeueDelay))
 }
 
 func (w *workerPool) AddTask(task Task) {
-	w.taskQueue.In <- task
+	select {
+	case <-w.stopped:
+	case w.taskQueue.In <- task:
+		w.queued.Add(1)
+	}
 }
 
-var _ RayDashboardClientInterface = (*RayDashboardCacheClient)(nil)
-
-type RayDashboardCacheClient struct {
-	client         RayDashboardClientInterface
-	namespacedName types.NamespacedName
+// RayDashboardCache fetches the job info of Ray jobs in the background with a pool of workers, and caches it for
+// the RayDashboardCacheClients that it creates. It implements manager.Runnable, so that the workers are started
+// and stopped with the manager.
+type RayDashboardCache struct {
+	storage           *lru.Cache[string, *JobInfoCache]
+	pool              *workerPool
+	options           RayDashboardCacheOptions
+	rwLock            sync.RWMutex
+	hits              atomic.Int64
+	misses            atomic.Int64
+	capacityEvictions atomic.Int64
+	expiryEvictions   atomic.Int64
 }
 
-func (r *RayDashboardCacheClient) InitClient(ctx context.Context, namespacedName types.NamespacedName, client RayDashboardClientInterface) {
-	logger := ctrl.LoggerFrom(ctx).WithName("RayDashboardCacheClient")
+// NewRayDashboardCache creates a RayDashboardCache. It returns an error if the cache size isn't positive.
+func NewRayDashboardCache(options RayDashboardCacheOptions) (*RayDashboardCache, error) {
+	storage, err := lru.New[string, *JobInfoCache](options.CacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &RayDashboardCache{
+		storage: storage,
+		pool:    newWorkerPool(),
+		options: options,
+	}, nil
+}
 
-	r.namespacedName = namespacedName
+// Start runs the workers and the cleanup of expired job infos until the context is done. Tasks added before Start
+// are queued until the workers are running.
+func (c *RayDashboardCache) Start(ctx context.Context) error {
+	logger := ctrl.LoggerFrom(ctx).WithName("RayDashboardCache")
+	ctx = ctrl.LoggerInto(ctx, logger)
 
-	initWorkPool.Do(func() {
-		pool.start(ctx, workerSize, queryInterval)
+	var wg sync.WaitGroup
+	c.pool.start(ctx, &wg, c.options.Workers, c.options.QueryInterval)
+	wg.Go(func() {
+		c.cleanupExpired(ctx)
 	})
 
-	initCacheStorage.Do(func() {
-		// The NewWithEvict() returns error only if the cacheSize is less than or equal to zero.
-		// While we set cacheSize as constant, we can ignore the error here.
-		cacheStorage, _ = lru.NewWithEvict[string, *JobInfoCache](cacheSize, func(key string, _ *JobInfoCache) {
-			logger.WithName("cacheStorage").Info("Evict cache for key.", "key", key)
-		})
-
-		// expiry cache cleanup
-		go func() {
-			ticker := time.NewTicker(queryInterval * 10)
-			defer ticker.Stop()
+	<-ctx.Done()
+	wg.Wait()
+	c.pool.stop()
+	logger.Info("RayDashboardCache stopped")
+	return nil
+}
 
-			loggerForGC := logger.WithName("CacheCleanup")
-			loggerForGC.Info(fmt.Sprintf("Initialize a cache cleanup goroutine with interval %v.", queryInterval*10))
+// cleanupExpired periodically removes the job infos that were not updated within the cache expiry.
+func (c *RayDashboardCache) cleanupExpired(ctx context.Context) {
+	interval := c.options.QueryInterval * 10
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	loggerForGC := ctrl.LoggerFrom(ctx).WithName("CacheCleanup")
+	loggerForGC.Info(fmt.Sprintf("Initialize a cache cleanup goroutine with interval %v.", interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			loggerForGC.Info("clean up goroutine exiting...")
+			return
+		case t := <-ticker.C:
+			expiredThreshold := time.Now().Add(-c.options.CacheExpiry)
+			removed := c.removeExpired(expiredThreshold)
+			loggerForGC.Info(fmt.Sprintf("clean up %d cache.", len(removed)), "expiredThreshold", expiredThreshold, "tick at", t, "removed keys", removed)
+		}
+	}
+}
 
-			for {
-				select {
-				case <-ctx.Done():
-					loggerForGC.Info("clean up goroutine exiting...")
-					return
-				case t := <-ticker.C:
-					rwLock.RLock()
-					keys := cacheStorage.Keys()
-					rwLock.RUnlock()
-
-					expiredThreshold := time.Now().Add(-cacheExpiry)
-					loggerForGC.Info(fmt.Sprintf("Found %d keys to verify,", len(keys)), "expiredThreshold", expiredThreshold, "tick at", t)
-
-					// zero allocate filtering
-					removed := keys[:0]
-					for _, key := range keys {
-						rwLock.Lock()
-						if cached, ok := cacheStorage.Peek(key); ok {
-							if cached.UpdatedAt.Before(expiredThreshold) {
-								cacheStorage.Remove(key)
-								removed = append(removed, key)
-							}
-						}
-						rwLock.Unlock()
-					}
-					loggerForGC.Info(fmt.Sprintf("clean up %d cache.", len(removed)), "expiredThreshold", expiredThreshold, "removed keys", removed)
-				}
+// removeExpired removes the job infos updated before expiredThreshold and returns their keys.
+func (c *RayDashboardCache) removeExpired(expiredThreshold time.Time) []string {
+	c.rwLock.RLock()
+	keys := c.storage.Keys()
+	c.rwLock.RUnlock()
+
+	// zero allocate filtering
+	removed := keys[:0]
+	for _, key := range keys {
+		c.rwLock.Lock()
+		if cached, ok := c.storage.Peek(key); ok {
+			if cached.UpdatedAt.Before(expiredThreshold) {
+				c.storage.Remove(key)
+				removed = append(removed, key)
 			}
-		}()
-	})
+		}
+		c.rwLock.Unlock()
+	}
+	c.expiryEvictions.Add(int64(len(removed)))
+	return removed
+}
 
-	r.client = client
+// recordLookup counts a GetJobInfo call that found an entry in the cache. A placeholder that is still waiting for
+// the first query counts as a miss.
+func (c *RayDashboardCache) recordLookup(cached *JobInfoCache) {
+	if errors.Is(cached.Err, ErrAgain) {
+		c.misses.Add(1)
+		return
+	}
+	c.hits.Add(1)
+}
+
+// Stats returns a snapshot of the counters of the cache.
+func (c *RayDashboardCache) Stats() RayDashboardCacheStats {
+	return RayDashboardCacheStats{
+		Hits:              c.hits.Load(),
+		Misses:            c.misses.Load(),
+		CapacityEvictions: c.capacityEvictions.Load(),
+		ExpiryEvictions:   c.expiryEvictions.Load(),
+		QueueDepth:        int(c.pool.queued.Load()),
+	}
+}
+
+// NewClient returns a RayDashboardCacheClient that serves the job info of the RayCluster's jobs from the cache and
+// forwards the other calls to client.
+func (c *RayDashboardCache) NewClient(namespacedName types.NamespacedName, client RayDashboardClientInterface) *RayDashboardCacheClient {
+	return &RayDashboardCacheClient{
+		cache:          c,
+		client:         client,
+		namespacedName: namespacedName,
+	}
+}
+
+var _ RayDashboardClientInterface = (*RayDashboardCacheClient)(nil)
+
+type RayDashboardCacheClient struct {
+	cache          *RayDashboardCache
+	client         RayDashboardClientInterface
+	namespacedName types.NamespacedName
 }
 
 func (r *RayDashboardCacheClient) UpdateDeployments(ctx context.Context, configJson []byte) error {
@@ -176,39 +275,44 @@ func (r *RayDashboardCacheClient) GetMultiApplicationStatus(ctx context.Context)
 func (r *RayDashboardCacheClient) GetJobInfo(ctx context.Context, jobId string) (*utiltypes.RayJobInfo, error) {
 	logger := ctrl.LoggerFrom(ctx).WithName("RayDashboardCacheClient")
 
-	rwLock.Lock()
-	if cached, ok := cacheStorage.Get(cacheKey(r.namespacedName, jobId)); ok {
+	r.cache.rwLock.Lock()
+	if cached, ok := r.cache.storage.Get(cacheKey(r.namespacedName, jobId)); ok {
 		if cached.Err != nil && !errors.Is(cached.Err, ErrAgain) {
 			// Consume the error.
 			// If the RayJob is still exists, the next Reconcile iteration will put the task back for updating JobInfo
-			cacheStorage.Remove(cacheKey(r.namespacedName, jobId))
+			r.cache.storage.Remove(cacheKey(r.namespacedName, jobId))
 			logger.Info("Consume the cached error for jobId", "jobId", jobId, "error", cached.Err, "cacheKey", cacheKey(r.namespacedName, jobId))
 		}
-		rwLock.Unlock()
+		r.cache.rwLock.Unlock()
+		r.cache.recordLookup(cached)
 		return cached.JobInfo, cached.Err
 	}
-	rwLock.Unlock()
+	r.cache.rwLock.Unlock()
+	r.cache.misses.Add(1)
 
 	currentTime := time.Now()
 	placeholder := &JobInfoCache{Err: ErrAgain, UpdatedAt: &currentTime}
 
 	// Put a placeholder in storage. The cache will be updated only if the placeholder exists.
 	// The placeholder will be removed when StopJob or DeleteJob.
-	rwLock.Lock()
-	if cached, existed, _ := cacheStorage.PeekOrAdd(cacheKey(r.namespacedName, jobId), placeholder); existed {
-		rwLock.Unlock()
+	r.cache.rwLock.Lock()
+	cached, existed, evicted := r.cache.storage.PeekOrAdd(cacheKey(r.namespacedName, jobId), placeholder)
+	r.cache.rwLock.Unlock()
+	if existed {
 		return cached.JobInfo, cached.Err
 	}
-	rwLock.Unlock()
+	if evicted {
+		r.cache.capacityEvictions.Add(1)
+	}
 
 	var task Task = func(taskCTX context.Context) bool {
-		rwLock.RLock()
-		if existed := cacheStorage.Contains(cacheKey(r.namespacedName, jobId)); !existed {
+		r.cache.rwLock.RLock()
+		if existed := r.cache.storage.Contains(cacheKey(r.namespacedName, jobId)); !existed {
 			logger.Info("The placeholder is removed for jobId", "jobId", jobId, "cacheKey", cacheKey(r.namespacedName, jobId))
-			rwLock.RUnlock()
+			r.cache.rwLock.RUnlock()
 			return false
 		}
-		rwLock.RUnlock()
+		r.cache.rwLock.RUnlock()
 
 		jobInfo, err := r.client.GetJobInfo(taskCTX, jobId)
 		currentTime := time.Now()
@@ -220,14 +324,15 @@ func (r *RayDashboardCacheClient) GetJobInfo(ctx context.Context, jobId string)
 			UpdatedAt: &currentTime,
 		}
 
-		rwLock.Lock()
-		if existed := cacheStorage.Contains(cacheKey(r.namespacedName, jobId)); !existed {
+		r.cache.rwLock.Lock()
+		if existed := r.cache.storage.Contains(cacheKey(r.namespacedName, jobId)); !existed {
 			logger.Info("The placeholder is removed before updating for jobId", "jobId", jobId, "cacheKey", cacheKey(r.namespacedName, jobId))
-			rwLock.Unlock()
+			r.cache.rwLock.Unlock()
 			return false
 		}
-		cacheStorage.Add(cacheKey(r.namespacedName, jobId), newJobInfoCache)
-		rwLock.Unlock()
+		// The placeholder exists, so updating it never evicts another job info.
+		r.cache.storage.Add(cacheKey(r.namespacedName, jobId), newJobInfoCache)
+		r.cache.rwLock.Unlock()
 
 		if err != nil {
 			// Exits the updating loop after getting an error.
@@ -247,7 +352,7 @@ func (r *RayDashboardCacheClient) GetJobInfo(ctx context.Context, jobId string)
 		return true
 	}
 
-	pool.AddTask(task)
+	r.cache.pool.AddTask(task)
 
 	logger.Info("Put a task to fetch job info in background for jobId ", "jobId", jobId, "cacheKey", cacheKey(r.namespacedName, jobId))
 
@@ -271,21 +376,29 @@ func (r *RayDashboardCacheClient) GetJobLog(ctx context.Context, jobName string)
 }
 
 func (r *RayDashboardCacheClient) StopJob(ctx context.Context, jobName string) error {
-	rwLock.Lock()
-	cacheStorage.Remove(cacheKey(r.namespacedName, jobName))
-	rwLock.Unlock()
+	r.cache.rwLock.Lock()
+	r.cache.storage.Remove(cacheKey(r.namespacedName, jobName))
+	r.cache.rwLock.Unlock()
 
 	return r.client.StopJob(ctx, jobName)
 }
 
 func (r *RayDashboardCacheClient) DeleteJob(ctx context.Context, jobName string) error {
-	rwLock.Lock()
-	cacheStorage.Remove(cacheKey(r.namespacedName, jobName))
-	rwLock.Unlock()
+	r.cache.rwLock.Lock()
+	r.cache.storage.Remove(cacheKey(r.namespacedName, jobName))
+	r.cache.rwLock.Unlock()
 
 	return r.client.DeleteJob(ctx, jobName)
 }
 
+func (r *RayDashboardCacheClient) HasAliveActors(ctx context.Context) (bool, error) {
+	return r.client.HasAliveActors(ctx)
+}
+
+func (r *RayDashboardCacheClient) GetClusterResourceUsage(ctx context.Context) (map[string][]float64, error) {
+	return r.client.GetClusterResourceUsage(ctx)
+}
+
 func cacheKey(namespacedName types.NamespacedName, jobId string) string {
 	return namespacedName.String() + string(types.Separator) + jobId
 }
@@ -0,0 +1,106 @@
+package dashboardclient
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	utiltypes "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/types"
+)
+
+// jobInfoClient returns the job status of jobStatus for every job.
+type jobInfoClient struct {
+	RayDashboardClientInterface
+	jobStatus atomic.Value
+}
+
+func (c *jobInfoClient) GetJobInfo(_ context.Context, jobId string) (*utiltypes.RayJobInfo, error) {
+	return &utiltypes.RayJobInfo{JobId: jobId, JobStatus: c.jobStatus.Load().(rayv1.JobStatus)}, nil
+}
+
+var _ = Describe("RayDashboardCache", func() {
+	var (
+		options        RayDashboardCacheOptions
+		namespacedName types.NamespacedName
+		client         *jobInfoClient
+	)
+
+	BeforeEach(func() {
+		options = RayDashboardCacheOptions{
+			Workers:       2,
+			QueryInterval: 10 * time.Millisecond,
+			CacheSize:     10,
+			CacheExpiry:   time.Minute,
+		}
+		namespacedName = types.NamespacedName{Name: "raycluster", Namespace: "default"}
+		client = &jobInfoClient{}
+		client.jobStatus.Store(rayv1.JobStatusRunning)
+	})
+
+	It("Test fetching job info in the background", func() {
+		cache, err := NewRayDashboardCache(options)
+		Expect(err).ToNot(HaveOccurred())
+		cacheClient := cache.NewClient(namespacedName, client)
+
+		// The query is queued until the cache is started.
+		_, err = cacheClient.GetJobInfo(context.TODO(), "job-1")
+		Expect(err).To(MatchError(ErrAgain))
+		Expect(cache.Stats().QueueDepth).To(Equal(1))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stopped := make(chan error)
+		go func() {
+			stopped <- cache.Start(ctx)
+		}()
+
+		Eventually(func() (*utiltypes.RayJobInfo, error) {
+			return cacheClient.GetJobInfo(context.TODO(), "job-1")
+		}, time.Second, 10*time.Millisecond).Should(HaveField("JobStatus", rayv1.JobStatusRunning))
+
+		// The job info is updated until the job reaches a terminal status.
+		client.jobStatus.Store(rayv1.JobStatusSucceeded)
+		Eventually(func() (*utiltypes.RayJobInfo, error) {
+			return cacheClient.GetJobInfo(context.TODO(), "job-1")
+		}, time.Second, 10*time.Millisecond).Should(HaveField("JobStatus", rayv1.JobStatusSucceeded))
+
+		stats := cache.Stats()
+		Expect(stats.Hits).To(BeNumerically(">=", 2))
+		Expect(stats.Misses).To(BeNumerically(">=", 1))
+		Expect(stats.QueueDepth).To(Equal(0))
+
+		cancel()
+		Eventually(stopped, time.Second).Should(Receive(Not(HaveOccurred())))
+
+		// Queries are dropped after the cache stops.
+		_, err = cacheClient.GetJobInfo(context.TODO(), "job-2")
+		Expect(err).To(MatchError(ErrAgain))
+	})
+
+	It("Test evicting job info", func() {
+		options.CacheSize = 1
+		cache, err := NewRayDashboardCache(options)
+		Expect(err).ToNot(HaveOccurred())
+		cacheClient := cache.NewClient(namespacedName, client)
+
+		_, err = cacheClient.GetJobInfo(context.TODO(), "job-1")
+		Expect(err).To(MatchError(ErrAgain))
+		_, err = cacheClient.GetJobInfo(context.TODO(), "job-2")
+		Expect(err).To(MatchError(ErrAgain))
+		Expect(cache.Stats().CapacityEvictions).To(Equal(int64(1)))
+
+		removed := cache.removeExpired(time.Now().Add(time.Minute))
+		Expect(removed).To(ConsistOf(cacheKey(namespacedName, "job-2")))
+		Expect(cache.Stats().ExpiryEvictions).To(Equal(int64(1)))
+	})
+
+	It("Test invalid cache size", func() {
+		options.CacheSize = 0
+		_, err := NewRayDashboardCache(options)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -5,11 +5,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
@@ -24,8 +27,13 @@ var (
 	DeployPathV2     = "/api/serve/applications/"
 	// Job URL paths
 	JobPath = "/api/jobs/"
+	// Cluster activity URL paths
+	AliveActorsPath   = "/api/v0/actors?filter_keys=state&filter_predicates=%3D&filter_values=ALIVE&limit=1&detail=false"
+	ClusterStatusPath = "/api/cluster_status"
 )
 
+const authHeaderKey = "x-ray-authorization"
+
 type RayDashboardClientInterface interface {
 	UpdateDeployments(ctx context.Context, configJson []byte) error
 	// V2/multi-app Rest API
@@ -38,12 +46,46 @@ type RayDashboardClientInterface interface {
 	GetJobLog(ctx context.Context, jobName string) (*string, error)
 	StopJob(ctx context.Context, jobName string) error
 	DeleteJob(ctx context.Context, jobName string) error
+	// HasAliveActors returns whether the RayCluster has at least one alive actor.
+	HasAliveActors(ctx context.Context) (bool, error)
+	// GetClusterResourceUsage returns the used and total amounts of each logical resource of the RayCluster.
+	GetClusterResourceUsage(ctx context.Context) (map[string][]float64, error)
+}
+
+// ClientOptions configures the timeout, the retries, and the circuit breaker of a RayDashboardClient.
+type ClientOptions struct {
+	// Timeout is the timeout of a single request. Zero means the default timeout of the connection mode.
+	Timeout time.Duration
+	// MaxRetries is the maximum number of retries of idempotent GET requests.
+	MaxRetries int
+	// InitialBackoff is the backoff before the first retry. It doubles on each retry, with jitter, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// CircuitBreakerFailureThreshold is the number of consecutive failed requests to a RayCluster's dashboard after
+	// which requests to it are short-circuited. Zero disables the circuit breaker.
+	CircuitBreakerFailureThreshold int
+	// CircuitBreakerOpenDuration is how long requests are short-circuited before a probe request is let through.
+	CircuitBreakerOpenDuration time.Duration
+}
+
+// DefaultClientOptions returns the ClientOptions used when the operator configuration doesn't override them.
+func DefaultClientOptions() ClientOptions {
+	return ClientOptions{
+		MaxRetries:                     2,
+		InitialBackoff:                 100 * time.Millisecond,
+		MaxBackoff:                     time.Second,
+		CircuitBreakerFailureThreshold: 5,
+		CircuitBreakerOpenDuration:     30 * time.Second,
+	}
 }
 
 type RayDashboardClient struct {
-	client       *http.Client
-	dashboardURL string
-	authToken    string
+	client          *http.Client
+	clusterKey      *types.NamespacedName
+	circuitBreakers *CircuitBreakers
+	dashboardURL    string
+	authToken       string
+	options         ClientOptions
 }
 
 func (r *RayDashboardClient) InitClient(client *http.Client, dashboardURL string, authToken string) {
@@ -52,12 +94,116 @@ func (r *RayDashboardClient) InitClient(client *http.Client, dashboardURL string
 	r.authToken = authToken
 }
 
+// SetClientOptions enables retries of idempotent requests and the circuit breaker of the RayCluster's dashboard.
+// The circuit breaker is disabled if circuitBreakers is nil.
+func (r *RayDashboardClient) SetClientOptions(clusterKey types.NamespacedName, options ClientOptions, circuitBreakers *CircuitBreakers) {
+	r.clusterKey = &clusterKey
+	r.circuitBreakers = circuitBreakers
+	r.options = options
+}
+
+// do sends the request. GET requests are retried with jittered exponential backoff when the Ray dashboard is
+// unreachable or unavailable, and requests are short-circuited while the RayCluster's circuit breaker is open.
+func (r *RayDashboardClient) do(req *http.Request) (*http.Response, error) {
+	breakerEnabled := r.clusterKey != nil && r.circuitBreakers != nil && r.options.CircuitBreakerFailureThreshold > 0
+	for attempt := 0; ; attempt++ {
+		if breakerEnabled {
+			if err := r.circuitBreakers.allow(*r.clusterKey, r.options.CircuitBreakerOpenDuration); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := r.client.Do(req)
+		failed := err != nil || isDashboardUnavailable(resp.StatusCode)
+		if breakerEnabled {
+			r.circuitBreakers.record(*r.clusterKey, !failed, r.options.CircuitBreakerFailureThreshold)
+		}
+		if !failed || req.Method != http.MethodGet || attempt >= r.options.MaxRetries {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(r.retryBackoff(attempt)):
+		}
+	}
+}
+
+// retryBackoff returns the exponential backoff before the retry after the given attempt, with up to 50% jitter.
+func (r *RayDashboardClient) retryBackoff(attempt int) time.Duration {
+	backoff := r.options.InitialBackoff << attempt
+	if backoff <= 0 || backoff > r.options.MaxBackoff {
+		backoff = r.options.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// isDashboardUnavailable returns whether the status code means that the Ray dashboard, or the proxy in front of it,
+// can't serve requests. Other errors are returned by a healthy dashboard and don't count towards the circuit breaker.
+func isDashboardUnavailable(statusCode int) bool {
+	return statusCode == http.StatusBadGateway || statusCode == http.StatusServiceUnavailable || statusCode == http.StatusGatewayTimeout
+}
+
+// SetPreviousAuthToken makes the client retry a request with the previous auth token when the Ray dashboard
+// rejects the current one. This keeps the client working while the Ray Pods are being recreated after the
+// auth token is rotated.
+func (r *RayDashboardClient) SetPreviousAuthToken(previousAuthToken string) {
+	if previousAuthToken == "" || previousAuthToken == r.authToken {
+		return
+	}
+	base := r.client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	r.client.Transport = &previousAuthTokenTransport{
+		base:              base,
+		previousAuthToken: previousAuthToken,
+	}
+}
+
 func (r *RayDashboardClient) setAuthHeader(req *http.Request) {
 	if r.authToken != "" {
-		req.Header.Set("x-ray-authorization", fmt.Sprintf("Bearer %s", r.authToken))
+		req.Header.Set(authHeaderKey, fmt.Sprintf("Bearer %s", r.authToken))
 	}
 }
 
+// previousAuthTokenTransport retries requests rejected with 401 or 403 using the previous auth token.
+type previousAuthTokenTransport struct {
+	base              http.RoundTripper
+	previousAuthToken string
+}
+
+func (t *previousAuthTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || (resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusForbidden) {
+		return resp, err
+	}
+	// The request body has already been consumed and cannot be replayed.
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	retryReq := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil //nolint:nilerr // Return the original response if the body cannot be replayed.
+		}
+		retryReq.Body = body
+	}
+	retryReq.Header.Set(authHeaderKey, fmt.Sprintf("Bearer %s", t.previousAuthToken))
+	resp.Body.Close()
+	return t.base.RoundTrip(retryReq)
+}
+
 // UpdateDeployments update the deployments in the Ray cluster.
 func (r *RayDashboardClient) UpdateDeployments(ctx context.Context, configJson []byte) error {
 	var req *http.Request
@@ -69,7 +215,7 @@ func (r *RayDashboardClient) UpdateDeployments(ctx context.Context, configJson [
 	req.Header.Set("Content-Type", "application/json")
 	r.setAuthHeader(req)
 
-	resp, err := r.client.Do(req)
+	resp, err := r.do(req)
 	if err != nil {
 		return err
 	}
@@ -112,7 +258,7 @@ func (r *RayDashboardClient) GetServeDetails(ctx context.Context) (*utiltypes.Se
 
 	r.setAuthHeader(req)
 
-	resp, err := r.client.Do(req)
+	resp, err := r.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +305,7 @@ func (r *RayDashboardClient) GetJobInfo(ctx context.Context, jobId string) (*uti
 
 	r.setAuthHeader(req)
 
-	resp, err := r.client.Do(req)
+	resp, err := r.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +337,7 @@ func (r *RayDashboardClient) ListJobs(ctx context.Context) (*[]utiltypes.RayJobI
 
 	r.setAuthHeader(req)
 
-	resp, err := r.client.Do(req)
+	resp, err := r.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +361,57 @@ func (r *RayDashboardClient) ListJobs(ctx context.Context) (*[]utiltypes.RayJobI
 	return &jobInfo, nil
 }
 
+func (r *RayDashboardClient) HasAliveActors(ctx context.Context) (bool, error) {
+	body, err := r.get(ctx, AliveActorsPath)
+	if err != nil {
+		return false, fmt.Errorf("failed to list alive actors: %w", err)
+	}
+
+	var actorList utiltypes.RayActorListResponse
+	if err = json.Unmarshal(body, &actorList); err != nil {
+		return false, fmt.Errorf("HasAliveActors fail: %s", string(body))
+	}
+	return len(actorList.Data.Result.Result) > 0, nil
+}
+
+func (r *RayDashboardClient) GetClusterResourceUsage(ctx context.Context) (map[string][]float64, error) {
+	body, err := r.get(ctx, ClusterStatusPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the cluster status: %w", err)
+	}
+
+	var clusterStatus utiltypes.RayClusterStatusResponse
+	if err = json.Unmarshal(body, &clusterStatus); err != nil {
+		return nil, fmt.Errorf("GetClusterResourceUsage fail: %s", string(body))
+	}
+	return clusterStatus.Data.ClusterStatus.LoadMetricsReport.Usage, nil
+}
+
+// get sends a GET request to the given path of the dashboard and returns the body of a successful response.
+func (r *RayDashboardClient) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.dashboardURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r.setAuthHeader(req)
+
+	resp, err := r.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("status code %d, body: %s", resp.StatusCode, string(body))
+	}
+	return body, nil
+}
+
 func (r *RayDashboardClient) SubmitJob(ctx context.Context, rayJob *rayv1.RayJob) (jobId string, err error) {
 	request, err := ConvertRayJobToReq(rayJob)
 	if err != nil {
@@ -237,7 +434,7 @@ func (r *RayDashboardClient) SubmitJobReq(ctx context.Context, request *utiltype
 	req.Header.Set("Content-Type", "application/json")
 	r.setAuthHeader(req)
 
-	resp, err := r.client.Do(req)
+	resp, err := r.do(req)
 	if err != nil {
 		return
 	}
@@ -274,7 +471,7 @@ func (r *RayDashboardClient) GetJobLog(ctx context.Context, jobName string) (*st
 
 	r.setAuthHeader(req)
 
-	resp, err := r.client.Do(req)
+	resp, err := r.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -309,7 +506,7 @@ func (r *RayDashboardClient) StopJob(ctx context.Context, jobName string) (err e
 	req.Header.Set("Content-Type", "application/json")
 	r.setAuthHeader(req)
 
-	resp, err := r.client.Do(req)
+	resp, err := r.do(req)
 	if err != nil {
 		return err
 	}
@@ -347,7 +544,7 @@ func (r *RayDashboardClient) DeleteJob(ctx context.Context, jobName string) erro
 	req.Header.Set("Content-Type", "application/json")
 	r.setAuthHeader(req)
 
-	resp, err := r.client.Do(req)
+	resp, err := r.do(req)
 	if err != nil {
 		return err
 	}
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	utiltypes "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/types"
@@ -147,6 +149,28 @@ var _ = Describe("RayFrameworkGenerator", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("Test falling back to the previous auth token", func() {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		rayDashboardClient.InitClient(&http.Client{}, rayDashboardClient.dashboardURL, "new-token")
+		rayDashboardClient.SetPreviousAuthToken("old-token")
+
+		var authHeaders []string
+		httpmock.RegisterResponder(http.MethodPost, rayDashboardClient.dashboardURL+JobPath+"stop-job-1/stop",
+			func(req *http.Request) (*http.Response, error) {
+				authHeaders = append(authHeaders, req.Header.Get(authHeaderKey))
+				if req.Header.Get(authHeaderKey) != "Bearer old-token" {
+					return httpmock.NewStringResponse(http.StatusUnauthorized, "unauthorized"), nil
+				}
+				bodyBytes, _ := json.Marshal(&utiltypes.RayJobStopResponse{Stopped: true})
+				return httpmock.NewBytesResponse(200, bodyBytes), nil
+			})
+
+		err := rayDashboardClient.StopJob(context.TODO(), "stop-job-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(authHeaders).To(Equal([]string{"Bearer new-token", "Bearer old-token"}))
+	})
+
 	It("Test stop succeeded job", func() {
 		// StopJob only returns an error when JobStatus is not in terminated states (STOPPED / SUCCEEDED / FAILED)
 		httpmock.Activate()
@@ -298,4 +322,106 @@ var _ = Describe("RayFrameworkGenerator", func() {
 		Expect(err).To(HaveOccurred())
 		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
 	})
+
+	It("Test retrying idempotent requests", func() {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		circuitBreakers := NewCircuitBreakers()
+		rayDashboardClient.SetClientOptions(types.NamespacedName{Name: "raycluster", Namespace: "default"}, ClientOptions{
+			MaxRetries:                     2,
+			InitialBackoff:                 time.Millisecond,
+			MaxBackoff:                     time.Millisecond,
+			CircuitBreakerFailureThreshold: 5,
+			CircuitBreakerOpenDuration:     time.Hour,
+		}, circuitBreakers)
+
+		getCalls := 0
+		httpmock.RegisterResponder(http.MethodGet, rayDashboardClient.dashboardURL+JobPath+expectJobId,
+			func(_ *http.Request) (*http.Response, error) {
+				getCalls++
+				if getCalls < 3 {
+					return httpmock.NewStringResponse(http.StatusServiceUnavailable, "unavailable"), nil
+				}
+				bodyBytes, _ := json.Marshal(utiltypes.RayJobInfo{JobStatus: rayv1.JobStatusRunning})
+				return httpmock.NewBytesResponse(200, bodyBytes), nil
+			})
+		stopCalls := 0
+		httpmock.RegisterResponder(http.MethodPost, rayDashboardClient.dashboardURL+JobPath+"stop-job-1/stop",
+			func(_ *http.Request) (*http.Response, error) {
+				stopCalls++
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, "unavailable"), nil
+			})
+
+		jobInfo, err := rayDashboardClient.GetJobInfo(context.TODO(), expectJobId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(jobInfo.JobStatus).To(Equal(rayv1.JobStatusRunning))
+		Expect(getCalls).To(Equal(3))
+		// The successful request resets the circuit breaker.
+		Expect(circuitBreakers.States()).To(BeEmpty())
+
+		// Non-idempotent requests are not retried.
+		err = rayDashboardClient.StopJob(context.TODO(), "stop-job-1")
+		Expect(err).To(HaveOccurred())
+		Expect(stopCalls).To(Equal(1))
+	})
+
+	It("Test circuit breaker", func() {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		now := time.Now()
+		circuitBreakers := NewCircuitBreakers()
+		circuitBreakers.now = func() time.Time { return now }
+		clusterKey := types.NamespacedName{Name: "raycluster", Namespace: "default"}
+		rayDashboardClient.SetClientOptions(clusterKey, ClientOptions{
+			CircuitBreakerFailureThreshold: 2,
+			CircuitBreakerOpenDuration:     time.Minute,
+		}, circuitBreakers)
+
+		calls := 0
+		healthy := false
+		httpmock.RegisterResponder(http.MethodGet, rayDashboardClient.dashboardURL+JobPath,
+			func(_ *http.Request) (*http.Response, error) {
+				calls++
+				if !healthy {
+					return nil, context.DeadlineExceeded
+				}
+				return httpmock.NewStringResponse(200, "[]"), nil
+			})
+
+		for range 2 {
+			_, err := rayDashboardClient.ListJobs(context.TODO())
+			Expect(err).To(HaveOccurred())
+		}
+		Expect(circuitBreakers.States()).To(Equal(map[types.NamespacedName]CircuitBreakerState{clusterKey: CircuitBreakerOpen}))
+
+		// Requests are short-circuited while the circuit is open.
+		_, err := rayDashboardClient.ListJobs(context.TODO())
+		Expect(errors.Is(err, ErrCircuitOpen)).To(BeTrue())
+		Expect(calls).To(Equal(2))
+
+		// A failed probe request opens the circuit again.
+		now = now.Add(time.Minute)
+		_, err = rayDashboardClient.ListJobs(context.TODO())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(calls).To(Equal(3))
+		_, err = rayDashboardClient.ListJobs(context.TODO())
+		Expect(errors.Is(err, ErrCircuitOpen)).To(BeTrue())
+
+		// A successful probe request closes the circuit.
+		now = now.Add(time.Minute)
+		healthy = true
+		_, err = rayDashboardClient.ListJobs(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(circuitBreakers.States()).To(BeEmpty())
+
+		// The circuit breaker of a deleted RayCluster is removed.
+		healthy = false
+		for range 2 {
+			_, err = rayDashboardClient.ListJobs(context.TODO())
+			Expect(err).To(HaveOccurred())
+		}
+		Expect(circuitBreakers.States()).To(HaveKey(clusterKey))
+		circuitBreakers.Remove(clusterKey)
+		Expect(circuitBreakers.States()).To(BeEmpty())
+	})
 })
@@ -14,8 +14,10 @@ import (
 type FakeRayDashboardClient struct {
 	multiAppStatuses  map[string]*utiltypes.ServeApplicationStatus
 	GetJobInfoMock    atomic.Pointer[func(context.Context, string) (*utiltypes.RayJobInfo, error)]
+	resourceUsage     map[string][]float64
 	serveDetails      utiltypes.ServeDetails
 	LastUpdatedConfig []byte
+	hasAliveActors    bool
 }
 
 var _ dashboardclient.RayDashboardClientInterface = (*FakeRayDashboardClient)(nil)
@@ -79,3 +81,16 @@ func (r *FakeRayDashboardClient) StopJob(_ context.Context, _ string) (err error
 func (r *FakeRayDashboardClient) DeleteJob(_ context.Context, _ string) error {
 	return nil
 }
+
+func (r *FakeRayDashboardClient) HasAliveActors(_ context.Context) (bool, error) {
+	return r.hasAliveActors, nil
+}
+
+func (r *FakeRayDashboardClient) GetClusterResourceUsage(_ context.Context) (map[string][]float64, error) {
+	return r.resourceUsage, nil
+}
+
+func (r *FakeRayDashboardClient) SetClusterActivity(hasAliveActors bool, resourceUsage map[string][]float64) {
+	r.hasAliveActors = hasAliveActors
+	r.resourceUsage = resourceUsage
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	resourcev1 "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// DefaultDeviceClassResourceNames maps the device classes of common DRA drivers to the extended resource names of
+// the equivalent device plugins. Devices claimed through Kubernetes Dynamic Resource Allocation (DRA) are counted
+// as the mapped resources, which KubeRay translates to Ray resources like the container resource limits.
+var DefaultDeviceClassResourceNames = map[string]corev1.ResourceName{
+	"gpu.nvidia.com": "nvidia.com/gpu",
+	"gpu.amd.com":    "amd.com/gpu",
+	"gpu.intel.com":  "gpu.intel.com/i915",
+}
+
+// GetDeviceClassResourceNames returns the default device class mapping, with the entries of `overrides` added.
+// An entry mapped to an empty resource name removes the device class from the mapping.
+func GetDeviceClassResourceNames(overrides map[string]string) map[string]corev1.ResourceName {
+	deviceClassResourceNames := make(map[string]corev1.ResourceName, len(DefaultDeviceClassResourceNames)+len(overrides))
+	maps.Copy(deviceClassResourceNames, DefaultDeviceClassResourceNames)
+	for deviceClassName, resourceName := range overrides {
+		if resourceName == "" {
+			delete(deviceClassResourceNames, deviceClassName)
+			continue
+		}
+		deviceClassResourceNames[deviceClassName] = corev1.ResourceName(resourceName)
+	}
+	return deviceClassResourceNames
+}
+
+// GetRayClusterResourceClaimTemplateNames returns the names of the ResourceClaimTemplates used by the Ray containers
+// of the head group and the worker groups of the RayCluster.
+func GetRayClusterResourceClaimTemplateNames(cluster *rayv1.RayCluster) []string {
+	names := GetResourceClaimTemplateNames(cluster.Spec.HeadGroupSpec.Template.Spec)
+	for _, workerGroup := range cluster.Spec.WorkerGroupSpecs {
+		names = append(names, GetResourceClaimTemplateNames(workerGroup.Template.Spec)...)
+	}
+	return names
+}
+
+// GetResourceClaimTemplateNames returns the names of the ResourceClaimTemplates of the resource claims used by the
+// Ray container of the Pod.
+func GetResourceClaimTemplateNames(podSpec corev1.PodSpec) []string {
+	var names []string
+	for _, podClaim := range getRayContainerPodResourceClaims(podSpec) {
+		if podClaim.ResourceClaimTemplateName != nil {
+			names = append(names, *podClaim.ResourceClaimTemplateName)
+		}
+	}
+	return names
+}
+
+// CalculateClaimedResources returns the devices that the Ray container of the Pod claims through ResourceClaimTemplates,
+// counted as the resources that their device classes are mapped to. Requests for all the devices of a class, and
+// requests with alternative devices, are skipped because the number of allocated devices is unknown.
+func CalculateClaimedResources(podSpec corev1.PodSpec, templates map[string]*resourcev1.ResourceClaimTemplate, deviceClassResourceNames map[string]corev1.ResourceName) corev1.ResourceList {
+	claimedResources := corev1.ResourceList{}
+	if len(podSpec.Containers) == 0 {
+		return claimedResources
+	}
+	containerClaims := podSpec.Containers[RayContainerIndex].Resources.Claims
+	for _, podClaim := range getRayContainerPodResourceClaims(podSpec) {
+		if podClaim.ResourceClaimTemplateName == nil {
+			continue
+		}
+		template, ok := templates[*podClaim.ResourceClaimTemplateName]
+		if !ok {
+			continue
+		}
+		for _, request := range template.Spec.Spec.Devices.Requests {
+			if request.Exactly == nil || !isRequestUsedByContainer(containerClaims, podClaim.Name, request.Name) {
+				continue
+			}
+			resourceName, ok := deviceClassResourceNames[request.Exactly.DeviceClassName]
+			if !ok {
+				continue
+			}
+			if request.Exactly.AllocationMode == resourcev1.DeviceAllocationModeAll {
+				continue
+			}
+			// The count defaults to one device.
+			count := max(request.Exactly.Count, 1)
+			quantity := claimedResources[resourceName]
+			quantity.Add(*resource.NewQuantity(count, resource.DecimalSI))
+			claimedResources[resourceName] = quantity
+		}
+	}
+	return claimedResources
+}
+
+// CalculateDesiredClaimedResources returns the devices claimed by the desired Pods of the RayCluster, like
+// CalculateDesiredResources does for the container resources. They are kept separate from the container resources
+// because schedulers must not require claimed devices as extended resources.
+func CalculateDesiredClaimedResources(cluster *rayv1.RayCluster, templates map[string]*resourcev1.ResourceClaimTemplate, deviceClassResourceNames map[string]corev1.ResourceName) corev1.ResourceList {
+	desiredResourcesList := []corev1.ResourceList{
+		CalculateClaimedResources(cluster.Spec.HeadGroupSpec.Template.Spec, templates, deviceClassResourceNames),
+	}
+	for _, nodeGroup := range cluster.Spec.WorkerGroupSpecs {
+		if nodeGroup.Suspend != nil && *nodeGroup.Suspend {
+			continue
+		}
+		podResource := CalculateClaimedResources(nodeGroup.Template.Spec, templates, deviceClassResourceNames)
+		calculateReplicaResource(&podResource, nodeGroup.NumOfHosts)
+		for i := int32(0); i < ptr.Deref(nodeGroup.Replicas, 0); i++ {
+			desiredResourcesList = append(desiredResourcesList, podResource)
+		}
+	}
+	return SumResourceList(desiredResourcesList)
+}
+
+// getRayContainerPodResourceClaims returns the resource claims of the Pod that are used by its Ray container.
+func getRayContainerPodResourceClaims(podSpec corev1.PodSpec) []corev1.PodResourceClaim {
+	if len(podSpec.Containers) == 0 {
+		return nil
+	}
+	var podClaims []corev1.PodResourceClaim
+	for _, podClaim := range podSpec.ResourceClaims {
+		for _, containerClaim := range podSpec.Containers[RayContainerIndex].Resources.Claims {
+			if containerClaim.Name == podClaim.Name {
+				podClaims = append(podClaims, podClaim)
+				break
+			}
+		}
+	}
+	return podClaims
+}
+
+// isRequestUsedByContainer returns whether the container uses the request of the resource claim, either because it
+// uses the whole claim or because it uses the request.
+func isRequestUsedByContainer(containerClaims []corev1.ResourceClaim, claimName string, requestName string) bool {
+	for _, containerClaim := range containerClaims {
+		if containerClaim.Name == claimName && (containerClaim.Request == "" || containerClaim.Request == requestName) {
+			return true
+		}
+	}
+	return false
+}
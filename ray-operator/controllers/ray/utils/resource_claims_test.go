@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	resourcev1 "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+func newResourceClaimTemplate(name string, requests ...resourcev1.DeviceRequest) *resourcev1.ResourceClaimTemplate {
+	return &resourcev1.ResourceClaimTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: resourcev1.ResourceClaimTemplateSpec{
+			Spec: resourcev1.ResourceClaimSpec{
+				Devices: resourcev1.DeviceClaim{Requests: requests},
+			},
+		},
+	}
+}
+
+func newExactDeviceRequest(name string, deviceClassName string, count int64) resourcev1.DeviceRequest {
+	return resourcev1.DeviceRequest{
+		Name: name,
+		Exactly: &resourcev1.ExactDeviceRequest{
+			DeviceClassName: deviceClassName,
+			AllocationMode:  resourcev1.DeviceAllocationModeExactCount,
+			Count:           count,
+		},
+	}
+}
+
+func TestGetDeviceClassResourceNames(t *testing.T) {
+	deviceClassResourceNames := GetDeviceClassResourceNames(map[string]string{
+		"gpu.example.com": "example.com/gpu",
+		"gpu.amd.com":     "",
+		"gpu.intel.com":   "gpu.intel.com/xe",
+	})
+	assert.Equal(t, map[string]corev1.ResourceName{
+		"gpu.nvidia.com":  "nvidia.com/gpu",
+		"gpu.intel.com":   "gpu.intel.com/xe",
+		"gpu.example.com": "example.com/gpu",
+	}, deviceClassResourceNames)
+	// The defaults aren't modified.
+	assert.Equal(t, corev1.ResourceName("amd.com/gpu"), DefaultDeviceClassResourceNames["gpu.amd.com"])
+}
+
+func TestCalculateClaimedResources(t *testing.T) {
+	templates := map[string]*resourcev1.ResourceClaimTemplate{
+		"two-gpus": newResourceClaimTemplate("two-gpus", newExactDeviceRequest("gpus", "gpu.nvidia.com", 2)),
+		"mixed": newResourceClaimTemplate("mixed",
+			newExactDeviceRequest("gpu", "gpu.nvidia.com", 0),
+			newExactDeviceRequest("nic", "rdma.example.com", 1),
+			resourcev1.DeviceRequest{
+				Name: "all-gpus",
+				Exactly: &resourcev1.ExactDeviceRequest{
+					DeviceClassName: "gpu.nvidia.com",
+					AllocationMode:  resourcev1.DeviceAllocationModeAll,
+				},
+			},
+			resourcev1.DeviceRequest{
+				Name: "any-gpu",
+				FirstAvailable: []resourcev1.DeviceSubRequest{
+					{Name: "amd", DeviceClassName: "gpu.amd.com", Count: 1},
+				},
+			},
+		),
+	}
+
+	newPodSpec := func(podClaims []corev1.PodResourceClaim, containerClaims []corev1.ResourceClaim) corev1.PodSpec {
+		return corev1.PodSpec{
+			ResourceClaims: podClaims,
+			Containers: []corev1.Container{
+				{Name: "ray", Resources: corev1.ResourceRequirements{Claims: containerClaims}},
+				{Name: "sidecar", Resources: corev1.ResourceRequirements{Claims: []corev1.ResourceClaim{{Name: "sidecar-gpus"}}}},
+			},
+		}
+	}
+
+	tests := []struct {
+		expected        corev1.ResourceList
+		name            string
+		podClaims       []corev1.PodResourceClaim
+		containerClaims []corev1.ResourceClaim
+	}{
+		{
+			name:            "claim from a template",
+			podClaims:       []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To("two-gpus")}},
+			containerClaims: []corev1.ResourceClaim{{Name: "gpus"}},
+			expected:        corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+		},
+		{
+			name:            "a request without a count claims one device, and unmapped, all-device, and alternative requests are skipped",
+			podClaims:       []corev1.PodResourceClaim{{Name: "devices", ResourceClaimTemplateName: ptr.To("mixed")}},
+			containerClaims: []corev1.ResourceClaim{{Name: "devices"}},
+			expected:        corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+		},
+		{
+			name: "only the requests used by the Ray container are counted",
+			podClaims: []corev1.PodResourceClaim{
+				{Name: "gpus", ResourceClaimTemplateName: ptr.To("two-gpus")},
+				{Name: "devices", ResourceClaimTemplateName: ptr.To("mixed")},
+			},
+			containerClaims: []corev1.ResourceClaim{{Name: "devices", Request: "nic"}},
+			expected:        corev1.ResourceList{},
+		},
+		{
+			name:            "claims used only by other containers are skipped",
+			podClaims:       []corev1.PodResourceClaim{{Name: "sidecar-gpus", ResourceClaimTemplateName: ptr.To("two-gpus")}},
+			containerClaims: nil,
+			expected:        corev1.ResourceList{},
+		},
+		{
+			name:            "claims without a known template are skipped",
+			podClaims:       []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To("missing")}, {Name: "shared", ResourceClaimName: ptr.To("shared-gpus")}},
+			containerClaims: []corev1.ResourceClaim{{Name: "gpus"}, {Name: "shared"}},
+			expected:        corev1.ResourceList{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			podSpec := newPodSpec(tc.podClaims, tc.containerClaims)
+			claimedResources := CalculateClaimedResources(podSpec, templates, DefaultDeviceClassResourceNames)
+			assert.Len(t, claimedResources, len(tc.expected))
+			for name, quantity := range tc.expected {
+				assert.True(t, quantity.Equal(claimedResources[name]), "expected %s %s, got %s", quantity.String(), name, claimedResources.Name(name, resource.DecimalSI).String())
+			}
+		})
+	}
+}
+
+func TestCalculateDesiredClaimedResources(t *testing.T) {
+	templates := map[string]*resourcev1.ResourceClaimTemplate{
+		"two-gpus": newResourceClaimTemplate("two-gpus", newExactDeviceRequest("gpus", "gpu.nvidia.com", 2)),
+	}
+	podSpec := corev1.PodSpec{
+		ResourceClaims: []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To("two-gpus")}},
+		Containers:     []corev1.Container{{Name: "ray", Resources: corev1.ResourceRequirements{Claims: []corev1.ResourceClaim{{Name: "gpus"}}}}},
+	}
+	cluster := &rayv1.RayCluster{
+		Spec: rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "ray"}}}},
+			},
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:  "multi-host",
+					Replicas:   ptr.To[int32](2),
+					NumOfHosts: 2,
+					Template:   corev1.PodTemplateSpec{Spec: podSpec},
+				},
+				{
+					GroupName: "suspended",
+					Replicas:  ptr.To[int32](3),
+					Suspend:   ptr.To(true),
+					Template:  corev1.PodTemplateSpec{Spec: podSpec},
+				},
+			},
+		},
+	}
+
+	claimedResources := CalculateDesiredClaimedResources(cluster, templates, DefaultDeviceClassResourceNames)
+	assert.Equal(t, int64(8), claimedResources.Name("nvidia.com/gpu", resource.DecimalSI).Value())
+}
@@ -3,8 +3,97 @@ package utils
 import (
 	"regexp"
 	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	NeuronCoreContainerResourceName = "aws.amazon.com/neuroncore"
+	NeuronCoreRayResourceName       = "neuron_cores"
+	TPUContainerResourceName        = "google.com/tpu"
+	TPURayResourceName              = "TPU"
+	// GPURayResourceName is the Ray resource set by the `--num-gpus` option of `ray start`.
+	GPURayResourceName = "GPU"
 )
 
+// AcceleratorType is the type of an accelerator, which determines the desired quantity of the RayCluster status that
+// the accelerator counts toward.
+type AcceleratorType string
+
+const (
+	// AcceleratorTypeGPU accelerators count toward DesiredGPU.
+	AcceleratorTypeGPU AcceleratorType = "GPU"
+	// AcceleratorTypeTPU accelerators count toward DesiredTPU.
+	AcceleratorTypeTPU AcceleratorType = "TPU"
+)
+
+// Accelerator maps an extended resource of the Ray container to a Ray resource.
+type Accelerator struct {
+	// ResourceName is the name of the extended resource, such as "aws.amazon.com/neuroncore".
+	ResourceName corev1.ResourceName
+	// RayResourceName is the name of the Ray resource that the extended resource is exported as, such as
+	// "neuron_cores". GPURayResourceName is exported through `--num-gpus`.
+	RayResourceName string
+	// Type is the type of the accelerator. It's empty for accelerators that count toward neither DesiredGPU nor DesiredTPU.
+	Type AcceleratorType
+}
+
+// DefaultAccelerators are the accelerators that KubeRay exports to Ray without any configuration, in addition to
+// the GPUs matched by IsGPUResourceKey.
+var DefaultAccelerators = []Accelerator{
+	{ResourceName: NeuronCoreContainerResourceName, RayResourceName: NeuronCoreRayResourceName},
+	{ResourceName: TPUContainerResourceName, RayResourceName: TPURayResourceName, Type: AcceleratorTypeTPU},
+}
+
+// Accelerators maps extended resources of the Ray container to the accelerators that they're exported as. A nil
+// Accelerators contains the DefaultAccelerators only.
+type Accelerators map[corev1.ResourceName]Accelerator
+
+var defaultAccelerators = NewAccelerators(nil)
+
+// NewAccelerators returns the accelerators configured for the operator. They are added to DefaultAccelerators, and
+// override the default accelerators and the GPUs matched by IsGPUResourceKey with the same resource names.
+func NewAccelerators(configured []Accelerator) Accelerators {
+	accelerators := make(Accelerators, len(DefaultAccelerators)+len(configured))
+	for _, accelerator := range DefaultAccelerators {
+		accelerators[accelerator.ResourceName] = accelerator
+	}
+	for _, accelerator := range configured {
+		accelerators[accelerator.ResourceName] = accelerator
+	}
+	return accelerators
+}
+
+// Get returns the accelerator that the extended resource is mapped to. Resources that aren't configured but are
+// matched by IsGPUResourceKey are mapped to GPURayResourceName.
+func (a Accelerators) Get(resourceName corev1.ResourceName) (Accelerator, bool) {
+	if a == nil {
+		a = defaultAccelerators
+	}
+	if accelerator, ok := a[resourceName]; ok {
+		return accelerator, true
+	}
+	if IsGPUResourceKey(string(resourceName)) {
+		return Accelerator{ResourceName: resourceName, RayResourceName: GPURayResourceName, Type: AcceleratorTypeGPU}, true
+	}
+	return Accelerator{}, false
+}
+
+// CustomRayResourceNames returns the Ray resources, other than GPURayResourceName, that the accelerators are
+// exported as.
+func (a Accelerators) CustomRayResourceNames() []string {
+	if a == nil {
+		a = defaultAccelerators
+	}
+	var rayResourceNames []string
+	for _, accelerator := range a {
+		if accelerator.RayResourceName != GPURayResourceName {
+			rayResourceNames = append(rayResourceNames, accelerator.RayResourceName)
+		}
+	}
+	return rayResourceNames
+}
+
 func IsGPUResourceKey(key string) bool {
 	// ending with "gpu" like "nvidia.com/gpu"
 	if strings.HasSuffix(key, "gpu") {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestIsGPUResourceKey(t *testing.T) {
@@ -51,3 +52,66 @@ func TestIsGPUResourceKey(t *testing.T) {
 		})
 	}
 }
+
+func TestAcceleratorsGet(t *testing.T) {
+	accelerators := NewAccelerators([]Accelerator{
+		{ResourceName: "example.com/npu", RayResourceName: "NPU"},
+		{ResourceName: "example.com/gpu", RayResourceName: "example_gpu"},
+	})
+
+	tests := []struct {
+		name         string
+		resourceName corev1.ResourceName
+		expected     Accelerator
+		found        bool
+	}{
+		{
+			name:         "configured accelerator",
+			resourceName: "example.com/npu",
+			expected:     Accelerator{ResourceName: "example.com/npu", RayResourceName: "NPU"},
+			found:        true,
+		},
+		{
+			name:         "configured accelerator overrides the GPU detection",
+			resourceName: "example.com/gpu",
+			expected:     Accelerator{ResourceName: "example.com/gpu", RayResourceName: "example_gpu"},
+			found:        true,
+		},
+		{
+			name:         "default accelerator",
+			resourceName: TPUContainerResourceName,
+			expected:     Accelerator{ResourceName: TPUContainerResourceName, RayResourceName: TPURayResourceName, Type: AcceleratorTypeTPU},
+			found:        true,
+		},
+		{
+			name:         "detected GPU",
+			resourceName: "nvidia.com/mig-1g.10gb",
+			expected:     Accelerator{ResourceName: "nvidia.com/mig-1g.10gb", RayResourceName: GPURayResourceName, Type: AcceleratorTypeGPU},
+			found:        true,
+		},
+		{
+			name:         "not an accelerator",
+			resourceName: corev1.ResourceCPU,
+			found:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accelerator, found := accelerators.Get(tt.resourceName)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, accelerator)
+		})
+	}
+
+	assert.ElementsMatch(t, []string{"NPU", "example_gpu", NeuronCoreRayResourceName, TPURayResourceName}, accelerators.CustomRayResourceNames())
+
+	// A nil Accelerators only contains the default accelerators.
+	var defaults Accelerators
+	_, found := defaults.Get("example.com/npu")
+	assert.False(t, found)
+	accelerator, found := defaults.Get(TPUContainerResourceName)
+	assert.True(t, found)
+	assert.Equal(t, TPURayResourceName, accelerator.RayResourceName)
+	assert.ElementsMatch(t, []string{NeuronCoreRayResourceName, TPURayResourceName}, defaults.CustomRayResourceNames())
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// ParseScalingSchedule parses the cron schedule of a scaling schedule and loads the time zone in which it is interpreted.
+func ParseScalingSchedule(scalingSchedule rayv1.ScalingSchedule) (cron.Schedule, *time.Location, error) {
+	schedule, err := cron.ParseStandard(scalingSchedule.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule %q: %w", scalingSchedule.Schedule, err)
+	}
+	location := time.UTC
+	if scalingSchedule.TimeZone != nil {
+		if location, err = time.LoadLocation(*scalingSchedule.TimeZone); err != nil {
+			return nil, nil, fmt.Errorf("invalid time zone %q: %w", *scalingSchedule.TimeZone, err)
+		}
+	}
+	return schedule, location, nil
+}
+
+// GetActiveScalingSchedule returns the first scaling schedule of the worker group whose window contains `now`, along
+// with the start time of the window. It returns nil if no window contains `now`.
+func GetActiveScalingSchedule(worker rayv1.WorkerGroupSpec, now time.Time) (*rayv1.ScalingSchedule, time.Time, error) {
+	for i := range worker.ScalingSchedules {
+		scalingSchedule := &worker.ScalingSchedules[i]
+		schedule, location, err := ParseScalingSchedule(*scalingSchedule)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		// `Next` returns the first start time strictly after the given time, so a window contains `now` if the
+		// first start time after `now - duration` isn't after `now`.
+		duration := time.Duration(scalingSchedule.DurationSeconds) * time.Second
+		if startTime := schedule.Next(now.In(location).Add(-duration)); !startTime.After(now) {
+			return scalingSchedule, startTime, nil
+		}
+	}
+	return nil, time.Time{}, nil
+}
+
+// NewActiveScalingSchedule returns the status of the window of the worker group's scaling schedule that starts at
+// `startTime`, including the bounds of the worker group's replicas during the window.
+func NewActiveScalingSchedule(worker rayv1.WorkerGroupSpec, scalingSchedule rayv1.ScalingSchedule, startTime time.Time) *rayv1.ActiveScalingSchedule {
+	ApplyScalingSchedule(&worker, scalingSchedule)
+	return &rayv1.ActiveScalingSchedule{
+		Name:        scalingSchedule.Name,
+		StartTime:   metav1.NewTime(startTime),
+		EndTime:     metav1.NewTime(startTime.Add(time.Duration(scalingSchedule.DurationSeconds) * time.Second)),
+		MinReplicas: ptr.To(ptr.Deref(worker.MinReplicas, 0)),
+		MaxReplicas: worker.MaxReplicas,
+	}
+}
+
+// GetNextScalingScheduleStartTime returns the earliest start time after `now` of the windows of the worker group's
+// scaling schedules. It returns the zero time if the worker group has no scaling schedules.
+func GetNextScalingScheduleStartTime(worker rayv1.WorkerGroupSpec, now time.Time) (time.Time, error) {
+	var next time.Time
+	for _, scalingSchedule := range worker.ScalingSchedules {
+		schedule, location, err := ParseScalingSchedule(scalingSchedule)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if startTime := schedule.Next(now.In(location)); next.IsZero() || startTime.Before(next) {
+			next = startTime
+		}
+	}
+	return next, nil
+}
+
+// ApplyScalingSchedule overrides the replica settings of the worker group with those of the scaling schedule. It's
+// applied to the in-memory RayCluster during reconciliation, and never written back to the RayCluster spec.
+func ApplyScalingSchedule(worker *rayv1.WorkerGroupSpec, scalingSchedule rayv1.ScalingSchedule) {
+	if scalingSchedule.Replicas != nil {
+		worker.Replicas = ptr.To(*scalingSchedule.Replicas)
+	}
+	if scalingSchedule.MinReplicas != nil {
+		worker.MinReplicas = ptr.To(*scalingSchedule.MinReplicas)
+	}
+	if scalingSchedule.MaxReplicas != nil {
+		worker.MaxReplicas = ptr.To(*scalingSchedule.MaxReplicas)
+	}
+}
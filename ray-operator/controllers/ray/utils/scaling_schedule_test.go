@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+func TestGetActiveScalingSchedule(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// Business hours on weekdays, from 8:00 to 18:00.
+	businessHours := rayv1.ScalingSchedule{
+		Name:            "business-hours",
+		Schedule:        "0 8 * * 1-5",
+		TimeZone:        ptr.To("America/New_York"),
+		DurationSeconds: 10 * 3600,
+		MinReplicas:     ptr.To[int32](4),
+	}
+	// Nightly maintenance, from 23:00 to 1:00.
+	nightly := rayv1.ScalingSchedule{
+		Name:            "nightly",
+		Schedule:        "0 23 * * *",
+		DurationSeconds: 2 * 3600,
+		MaxReplicas:     ptr.To[int32](1),
+	}
+	// An evening window at 20:00 in UTC, which overlaps with the business hours in New York.
+	evening := nightly
+	evening.Name = "evening"
+	evening.Schedule = "0 20 * * *"
+
+	tests := []struct {
+		now               time.Time
+		expectedStartTime time.Time
+		name              string
+		expectedSchedule  string
+		scalingSchedules  []rayv1.ScalingSchedule
+	}{
+		{
+			name:              "inside a window in the schedule's time zone",
+			now:               time.Date(2025, 6, 2, 9, 30, 0, 0, newYork),
+			expectedSchedule:  "business-hours",
+			expectedStartTime: time.Date(2025, 6, 2, 8, 0, 0, 0, newYork),
+		},
+		{
+			name:              "the window includes its start time",
+			now:               time.Date(2025, 6, 2, 8, 0, 0, 0, newYork),
+			expectedSchedule:  "business-hours",
+			expectedStartTime: time.Date(2025, 6, 2, 8, 0, 0, 0, newYork),
+		},
+		{
+			name: "the window excludes its end time",
+			now:  time.Date(2025, 6, 2, 18, 0, 0, 0, newYork),
+		},
+		{
+			name: "outside of the windows on the weekend",
+			now:  time.Date(2025, 6, 7, 12, 0, 0, 0, newYork),
+		},
+		{
+			name:              "a window that spans midnight in UTC",
+			now:               time.Date(2025, 6, 8, 0, 30, 0, 0, time.UTC),
+			expectedSchedule:  "nightly",
+			expectedStartTime: time.Date(2025, 6, 7, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:              "the first active schedule takes effect",
+			now:               time.Date(2025, 6, 3, 16, 30, 0, 0, newYork),
+			scalingSchedules:  []rayv1.ScalingSchedule{businessHours, evening},
+			expectedSchedule:  "business-hours",
+			expectedStartTime: time.Date(2025, 6, 3, 8, 0, 0, 0, newYork),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			worker := rayv1.WorkerGroupSpec{ScalingSchedules: []rayv1.ScalingSchedule{businessHours, nightly}}
+			if tc.scalingSchedules != nil {
+				worker.ScalingSchedules = tc.scalingSchedules
+			}
+			active, startTime, err := GetActiveScalingSchedule(worker, tc.now)
+			require.NoError(t, err)
+			if tc.expectedSchedule == "" {
+				assert.Nil(t, active)
+				return
+			}
+			require.NotNil(t, active)
+			assert.Equal(t, tc.expectedSchedule, active.Name)
+			assert.True(t, tc.expectedStartTime.Equal(startTime), "expected %v, got %v", tc.expectedStartTime, startTime)
+		})
+	}
+}
+
+func TestGetNextScalingScheduleStartTime(t *testing.T) {
+	worker := rayv1.WorkerGroupSpec{ScalingSchedules: []rayv1.ScalingSchedule{
+		{Name: "morning", Schedule: "0 8 * * *", DurationSeconds: 3600, MinReplicas: ptr.To[int32](1)},
+		{Name: "evening", Schedule: "0 20 * * *", DurationSeconds: 3600, MinReplicas: ptr.To[int32](1)},
+	}}
+	next, err := GetNextScalingScheduleStartTime(worker, time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, time.Date(2025, 6, 2, 20, 0, 0, 0, time.UTC).Equal(next))
+
+	next, err = GetNextScalingScheduleStartTime(rayv1.WorkerGroupSpec{}, time.Now())
+	require.NoError(t, err)
+	assert.True(t, next.IsZero())
+}
+
+func TestApplyScalingSchedule(t *testing.T) {
+	worker := rayv1.WorkerGroupSpec{
+		Replicas:    ptr.To[int32](2),
+		MinReplicas: ptr.To[int32](1),
+		MaxReplicas: ptr.To[int32](10),
+	}
+	ApplyScalingSchedule(&worker, rayv1.ScalingSchedule{
+		Name:            "business-hours",
+		DurationSeconds: 3600,
+		MinReplicas:     ptr.To[int32](4),
+		MaxReplicas:     ptr.To[int32](20),
+	})
+
+	// The settings that the scaling schedule doesn't set aren't overridden.
+	assert.Equal(t, int32(2), *worker.Replicas)
+	assert.Equal(t, int32(4), *worker.MinReplicas)
+	assert.Equal(t, int32(20), *worker.MaxReplicas)
+}
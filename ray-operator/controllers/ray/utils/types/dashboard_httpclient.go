@@ -46,3 +46,26 @@ type RayJobStopResponse struct {
 type RayJobLogsResponse struct {
 	Logs string `json:"logs,omitempty"`
 }
+
+// RayActorListResponse is the response of the Ray state API to list actors. Only the listed actors are decoded.
+// Reference to https://docs.ray.io/en/latest/ray-observability/reference/cli.html#ray-list
+type RayActorListResponse struct {
+	Data struct {
+		Result struct {
+			Result []map[string]any `json:"result"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// RayClusterStatusResponse is the response of the Ray dashboard API to get the cluster status. Only the
+// resource usage is decoded.
+type RayClusterStatusResponse struct {
+	Data struct {
+		ClusterStatus struct {
+			LoadMetricsReport struct {
+				// Usage maps each logical resource to its used and total amounts.
+				Usage map[string][]float64 `json:"usage"`
+			} `json:"loadMetricsReport"`
+		} `json:"clusterStatus"`
+	} `json:"data"`
+}
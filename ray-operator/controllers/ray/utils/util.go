@@ -3,7 +3,12 @@ package utils
 import (
 	"context"
 	"crypto/sha1" //nolint:gosec // We are not using this for security purposes
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"net/http"
@@ -12,6 +17,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
@@ -39,6 +45,8 @@ const (
 	ClusterDomainEnvKey = "CLUSTER_DOMAIN"
 	DefaultDomainName   = "cluster.local"
 	ContainersNotReady  = "ContainersNotReady"
+
+	serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
 )
 
 // TODO (kevin85421): Define CRDType here rather than constant.go to avoid circular dependency.
@@ -73,6 +81,16 @@ func GetClusterDomainName() string {
 	return DefaultDomainName
 }
 
+// GetOperatorNamespace returns the namespace that the KubeRay operator runs in. It returns an empty string if the
+// operator doesn't run in a Pod.
+func GetOperatorNamespace() string {
+	namespace, err := os.ReadFile(serviceAccountNamespaceFile)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(namespace))
+}
+
 // IsCreated returns true if pod has been created and is maintained by the API server
 func IsCreated(pod *corev1.Pod) bool {
 	return pod.Status.Phase != ""
@@ -174,6 +192,43 @@ func IsRunningAndReady(pod *corev1.Pod) bool {
 	return false
 }
 
+// IsPodPreempted returns true if the pod is terminated by a scheduler to make room for a higher-priority pod.
+func IsPodPreempted(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.DisruptionTarget && cond.Status == corev1.ConditionTrue && cond.Reason == corev1.PodReasonPreemptionByScheduler {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPodPreemptionCondition returns the DisruptionTarget condition of the Pod if the scheduler preempted it or the
+// kubelet terminated it, such as when the node is reclaimed or shut down. Other disruptions, such as evictions through
+// the eviction API or the taint manager, aren't preemptions. It returns nil if the Pod isn't preempted.
+func GetPodPreemptionCondition(pod *corev1.Pod) *corev1.PodCondition {
+	for i := range pod.Status.Conditions {
+		cond := &pod.Status.Conditions[i]
+		if cond.Type != corev1.DisruptionTarget || cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		if cond.Reason == corev1.PodReasonPreemptionByScheduler || cond.Reason == corev1.PodReasonTerminationByKubelet {
+			return cond
+		}
+	}
+	return nil
+}
+
+// GetPodUnschedulableTime returns the time since which the Pod has been unschedulable, or the zero time if the Pod
+// isn't unschedulable.
+func GetPodUnschedulableTime(pod *corev1.Pod) time.Time {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			return cond.LastTransitionTime.Time
+		}
+	}
+	return time.Time{}
+}
+
 func CheckRouteName(ctx context.Context, s string, n string) string {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -325,6 +380,22 @@ func GenerateHeadServiceName(crdType CRDType, clusterSpec rayv1.RayClusterSpec,
 	}
 }
 
+// GenerateManagedRedisName generates the name of the StatefulSet, Service, and Secret of the Redis that
+// KubeRay manages for the RayCluster's GCS fault tolerance.
+func GenerateManagedRedisName(clusterName string) string {
+	return fmt.Sprintf("%s-redis", clusterName)
+}
+
+// GenerateManagedRedisAddress returns the address of the Redis that KubeRay manages for the RayCluster.
+func GenerateManagedRedisAddress(cluster *rayv1.RayCluster) string {
+	return fmt.Sprintf("%s.%s.svc.%s:%d", GenerateManagedRedisName(cluster.Name), cluster.Namespace, GetClusterDomainName(), ManagedRedisPort)
+}
+
+// IsManagedRedisEnabled returns whether KubeRay manages the Redis used by the RayCluster's GCS fault tolerance.
+func IsManagedRedisEnabled(spec *rayv1.RayClusterSpec) bool {
+	return spec.GcsFaultToleranceOptions != nil && spec.GcsFaultToleranceOptions.ManagedRedis != nil
+}
+
 // GenerateFQDNServiceName generates a Fully Qualified Domain Name.
 func GenerateFQDNServiceName(ctx context.Context, cluster rayv1.RayCluster, namespace string) string {
 	log := ctrl.LoggerFrom(ctx)
@@ -347,6 +418,35 @@ func GenerateServeServiceName(serviceName string) string {
 	return fmt.Sprintf("%s-%s-%s", serviceName, ServeName, "svc")
 }
 
+// GeneratePodDisruptionBudgetName generates the name of the PodDisruptionBudget for a head or worker group.
+func GeneratePodDisruptionBudgetName(clusterName string, groupName string) string {
+	return fmt.Sprintf("%s-%s-%s", clusterName, groupName, "pdb")
+}
+
+// GenerateNetworkPolicyName generates the name of the NetworkPolicy for a RayCluster.
+func GenerateNetworkPolicyName(clusterName string) string {
+	return fmt.Sprintf("%s-%s", clusterName, "network-policy")
+}
+
+// GenerateImagePrePullDaemonSetName generates the name of the DaemonSet that pre-pulls the images of a head or worker group.
+func GenerateImagePrePullDaemonSetName(clusterName string, groupName string) string {
+	return fmt.Sprintf("%s-%s-%s", clusterName, groupName, "image-pre-pull")
+}
+
+// GenerateHeadVolumeClaimName generates the name of the PersistentVolumeClaim of the head Pod created from a volume claim template.
+func GenerateHeadVolumeClaimName(templateName string, clusterName string) string {
+	return fmt.Sprintf("%s-%s-head", templateName, clusterName)
+}
+
+// GenerateWorkerVolumeClaimName generates the name of the PersistentVolumeClaim of a worker Pod created from a volume
+// claim template. The host index is only part of the name for multi-host worker groups.
+func GenerateWorkerVolumeClaimName(templateName string, clusterName string, groupName string, numOfHosts int32, replicaIndex int, hostIndex int) string {
+	if numOfHosts > 1 {
+		return fmt.Sprintf("%s-%s-%s-%d-%d", templateName, clusterName, groupName, replicaIndex, hostIndex)
+	}
+	return fmt.Sprintf("%s-%s-%s-%d", templateName, clusterName, groupName, replicaIndex)
+}
+
 // GenerateServeServiceLabel generates label value for serve service selector.
 func GenerateServeServiceLabel(serviceName string) string {
 	return fmt.Sprintf("%s-%s", serviceName, ServeName)
@@ -367,6 +467,17 @@ func GenerateRayClusterName(serviceName string) string {
 	return fmt.Sprintf("%s-%s", serviceName, rand.String(5))
 }
 
+// GenerateRayServiceServeConfigSecretName generates the name of the Secret that stores the Serve config that a
+// RayService was rolled back to, if the Serve config of the revision was read from a Secret.
+func GenerateRayServiceServeConfigSecretName(serviceName string) string {
+	return fmt.Sprintf("%s-%s", serviceName, "serve-config")
+}
+
+// GenerateRayServiceRevisionName generates the name of a RayService revision from the hash of the recorded spec.
+func GenerateRayServiceRevisionName(serviceName string, revisionHash string) string {
+	return fmt.Sprintf("%s-%s", serviceName, strings.ToLower(revisionHash[:10]))
+}
+
 // GenerateRayJobId generates a ray job id for submission
 func GenerateRayJobId(rayjob string) string {
 	return fmt.Sprintf("%s-%s", rayjob, rand.String(5))
@@ -473,6 +584,109 @@ func CalculateAvailableReplicas(pods corev1.PodList) int32 {
 	return count
 }
 
+// CalculateWorkerGroupStatuses calculates the status of each worker group from the Pods of the cluster.
+// The LastScaleTime of a worker group is carried over from oldStatuses unless its desired replicas changed.
+func CalculateWorkerGroupStatuses(cluster *rayv1.RayCluster, pods corev1.PodList, oldStatuses []rayv1.WorkerGroupStatus, now metav1.Time) []rayv1.WorkerGroupStatus {
+	if len(cluster.Spec.WorkerGroupSpecs) == 0 {
+		return nil
+	}
+
+	oldStatusMap := make(map[string]rayv1.WorkerGroupStatus, len(oldStatuses))
+	for _, oldStatus := range oldStatuses {
+		oldStatusMap[oldStatus.GroupName] = oldStatus
+	}
+	groupPods := make(map[string][]*corev1.Pod)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Labels[RayNodeTypeLabelKey] != string(rayv1.WorkerNode) {
+			continue
+		}
+		groupName := pod.Labels[RayNodeGroupLabelKey]
+		groupPods[groupName] = append(groupPods[groupName], pod)
+	}
+
+	statuses := make([]rayv1.WorkerGroupStatus, 0, len(cluster.Spec.WorkerGroupSpecs))
+	for _, worker := range cluster.Spec.WorkerGroupSpecs {
+		status := rayv1.WorkerGroupStatus{
+			GroupName:       worker.GroupName,
+			DesiredReplicas: GetWorkerGroupDesiredReplicas(worker),
+		}
+		readyPodsPerReplica := make(map[string]int32)
+		for _, pod := range groupPods[worker.GroupName] {
+			if pod.Status.Phase == corev1.PodRunning {
+				status.AvailableReplicas++
+			}
+			if IsRunningAndReady(pod) {
+				status.ReadyReplicas++
+				if replicaName, ok := pod.Labels[RayWorkerReplicaNameKey]; ok {
+					readyPodsPerReplica[replicaName]++
+				}
+			} else if reason := GetPodPendingReason(pod); reason != "" {
+				if status.PendingPodReasons == nil {
+					status.PendingPodReasons = make(map[string]int32)
+				}
+				status.PendingPodReasons[reason]++
+			}
+		}
+		status.ReadyReplicaGroups = calculateReadyReplicaGroups(worker, status.ReadyReplicas, readyPodsPerReplica)
+		// The scaling schedules are validated before the status is calculated, so they can't fail to parse.
+		if active, startTime, _ := GetActiveScalingSchedule(worker, now.Time); active != nil {
+			status.ActiveScalingSchedule = NewActiveScalingSchedule(worker, *active, startTime)
+		}
+
+		oldStatus, hasOldStatus := oldStatusMap[worker.GroupName]
+		if hasOldStatus && oldStatus.DesiredReplicas == status.DesiredReplicas {
+			status.LastScaleTime = oldStatus.LastScaleTime
+		} else {
+			status.LastScaleTime = now.DeepCopy()
+		}
+		// The preemptions and the fallbacks are recorded by the reconciler.
+		status.Preemptions = oldStatus.Preemptions
+		status.LastPreemptionTime = oldStatus.LastPreemptionTime
+		status.RecentPreemptionTimes = oldStatus.RecentPreemptionTimes
+		status.PreemptedPodUIDs = oldStatus.PreemptedPodUIDs
+		status.ActiveFallback = oldStatus.ActiveFallback
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// calculateReadyReplicaGroups returns the number of replicas of a worker group whose Pods are all ready.
+func calculateReadyReplicaGroups(worker rayv1.WorkerGroupSpec, readyPods int32, readyPodsPerReplica map[string]int32) int32 {
+	if worker.NumOfHosts <= 1 {
+		return readyPods
+	}
+	if len(readyPodsPerReplica) == 0 {
+		// The Pods are not labeled with their replica name if the RayMultiHostIndexing feature gate is disabled.
+		return readyPods / worker.NumOfHosts
+	}
+	count := int32(0)
+	for _, ready := range readyPodsPerReplica {
+		if ready >= worker.NumOfHosts {
+			count++
+		}
+	}
+	return count
+}
+
+// GetPodPendingReason returns the reason why a Pod is not running, such as Unschedulable or ImagePullBackOff.
+// It returns an empty string if no reason is reported.
+func GetPodPendingReason(pod *corev1.Pod) string {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason != "" {
+			return cond.Reason
+		}
+	}
+	for _, containerStatuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, containerStatus := range containerStatuses {
+			if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason != "" {
+				return containerStatus.State.Waiting.Reason
+			}
+		}
+	}
+	return ""
+}
+
 func CalculateDesiredResources(cluster *rayv1.RayCluster) corev1.ResourceList {
 	desiredResourcesList := []corev1.ResourceList{}
 	headPodResource := CalculatePodResource(cluster.Spec.HeadGroupSpec.Template.Spec)
@@ -730,7 +944,7 @@ func EnvVarByName(envName string, envVars []corev1.EnvVar) (corev1.EnvVar, bool)
 
 type ClientProvider interface {
 	GetDashboardClient(ctx context.Context, mgr manager.Manager) func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error)
-	GetHttpProxyClient(mgr manager.Manager) func(hostIp, podNamespace, podName string, port int) RayHttpProxyClientInterface
+	GetHttpProxyClient(mgr manager.Manager) func(rayCluster *rayv1.RayCluster, hostIp, podNamespace, podName string, port int) (RayHttpProxyClientInterface, error)
 }
 
 func ManagedByExternalController(controllerName *string) *string {
@@ -760,6 +974,15 @@ func IsAuthEnabled(spec *rayv1.RayClusterSpec) bool {
 	return spec.AuthOptions != nil && spec.AuthOptions.Mode == rayv1.AuthModeToken
 }
 
+func IsTLSEnabled(spec *rayv1.RayClusterSpec) bool {
+	return spec != nil && spec.TLSOptions != nil
+}
+
+// IsHTTPSEnabled returns whether the Ray dashboard and Serve proxy of a RayCluster serve HTTPS.
+func IsHTTPSEnabled(spec *rayv1.RayClusterSpec) bool {
+	return IsTLSEnabled(spec) && spec.TLSOptions.EnableHTTPS != nil && *spec.TLSOptions.EnableHTTPS
+}
+
 func IsK8sAuthEnabled(authOptions *rayv1.AuthOptions) bool {
 	return authOptions != nil && authOptions.EnableK8sTokenAuth != nil && *authOptions.EnableK8sTokenAuth
 }
@@ -834,6 +1057,12 @@ func IsIncrementalUpgradeEnabled(spec *rayv1.RayServiceSpec) bool {
 		*spec.UpgradeStrategy.Type == rayv1.RayServiceNewClusterWithIncrementalUpgrade
 }
 
+// IsSequentialServeRolloutEnabled returns whether the Serve applications of the RayService are rolled out one at a time.
+func IsSequentialServeRolloutEnabled(spec *rayv1.RayServiceSpec) bool {
+	return spec != nil && spec.ServeRolloutStrategy != nil && spec.ServeRolloutStrategy.Type != nil &&
+		*spec.ServeRolloutStrategy.Type == rayv1.ServeRolloutSequential
+}
+
 func GetRayServiceClusterUpgradeOptions(spec *rayv1.RayServiceSpec) *rayv1.ClusterUpgradeOptions {
 	if spec != nil && spec.UpgradeStrategy != nil {
 		return spec.UpgradeStrategy.ClusterUpgradeOptions
@@ -932,10 +1161,10 @@ func FetchHeadServiceURL(ctx context.Context, cli client.Client, rayCluster *ray
 	return headServiceURL, nil
 }
 
-func GetRayDashboardClientFunc(ctx context.Context, mgr manager.Manager, useKubernetesProxy bool) func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error) {
+func GetRayDashboardClientFunc(ctx context.Context, mgr manager.Manager, useKubernetesProxy bool, options dashboardclient.ClientOptions, circuitBreakers *dashboardclient.CircuitBreakers) func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error) {
 	return func(rayCluster *rayv1.RayCluster, url string) (dashboardclient.RayDashboardClientInterface, error) {
 		dashboardClient := &dashboardclient.RayDashboardClient{}
-		var authToken string
+		var authToken, previousAuthToken string
 
 		if rayCluster != nil && rayCluster.Spec.AuthOptions != nil && rayCluster.Spec.AuthOptions.Mode == rayv1.AuthModeToken {
 			secretName := CheckName(rayCluster.Name)
@@ -958,12 +1187,32 @@ func GetRayDashboardClientFunc(ctx context.Context, mgr manager.Manager, useKube
 			}
 
 			authToken = string(tokenBytes)
+			// The previous token is kept in the Secret during the grace period after a rotation.
+			previousAuthToken = string(secret.Data[RAY_PREVIOUS_AUTH_TOKEN_SECRET_KEY])
 		}
 
+		timeout := options.Timeout
+		if timeout == 0 {
+			timeout = rayHTTPClientTimeout(useKubernetesProxy)
+		}
 		httpClient := &http.Client{
-			Timeout: rayHTTPClientTimeout(useKubernetesProxy),
+			Timeout: timeout,
 		}
 		dashboardURL := fmt.Sprintf("http://%s", url)
+		dashboardPortName := "dashboard"
+
+		if rayCluster != nil && IsHTTPSEnabled(&rayCluster.Spec) {
+			dashboardURL = fmt.Sprintf("https://%s", url)
+			dashboardPortName = "https:" + dashboardPortName
+			// When the API server proxies the request, it terminates the client connection itself.
+			if !useKubernetesProxy {
+				transport, err := GetRayClusterTLSTransport(context.Background(), mgr.GetClient(), rayCluster)
+				if err != nil {
+					return nil, fmt.Errorf("failed to construct Ray dashboard client: %w", err)
+				}
+				httpClient.Transport = transport
+			}
+		}
 
 		if useKubernetesProxy {
 			var err error
@@ -977,41 +1226,139 @@ func GetRayDashboardClientFunc(ctx context.Context, mgr manager.Manager, useKube
 			}
 			// Use the manager transport for TLS and API server authentication.
 			httpClient.Transport = mgr.GetHTTPClient().Transport
-			dashboardURL = fmt.Sprintf("%s/api/v1/namespaces/%s/services/%s:dashboard/proxy", mgr.GetConfig().Host, rayCluster.Namespace, headSvcName)
+			// The API server proxies to `https` backends when the Service name is prefixed with the scheme.
+			dashboardURL = fmt.Sprintf("%s/api/v1/namespaces/%s/services/%s:%s/proxy", mgr.GetConfig().Host, rayCluster.Namespace, headSvcName, dashboardPortName)
 		}
 		dashboardClient.InitClient(httpClient, dashboardURL, authToken)
-
-		if features.Enabled(features.AsyncJobInfoQuery) && rayCluster != nil {
-			namespacedName := types.NamespacedName{
-				Name:      rayCluster.Name,
-				Namespace: rayCluster.Namespace,
-			}
-			dashboardCachedClient := &dashboardclient.RayDashboardCacheClient{}
-			dashboardCachedClient.InitClient(ctx, namespacedName, dashboardClient)
-			return dashboardCachedClient, nil
+		dashboardClient.SetPreviousAuthToken(previousAuthToken)
+		if rayCluster != nil {
+			dashboardClient.SetClientOptions(types.NamespacedName{Name: rayCluster.Name, Namespace: rayCluster.Namespace}, options, circuitBreakers)
 		}
 		return dashboardClient, nil
 	}
 }
 
-func GetRayHttpProxyClientFunc(mgr manager.Manager, useKubernetesProxy bool) func(hostIp, podNamespace, podName string, port int) RayHttpProxyClientInterface {
-	return func(hostIp, podNamespace, podName string, port int) RayHttpProxyClientInterface {
+func GetRayHttpProxyClientFunc(mgr manager.Manager, useKubernetesProxy bool) func(rayCluster *rayv1.RayCluster, hostIp, podNamespace, podName string, port int) (RayHttpProxyClientInterface, error) {
+	return func(rayCluster *rayv1.RayCluster, hostIp, podNamespace, podName string, port int) (RayHttpProxyClientInterface, error) {
 		httpClient := &http.Client{
 			Timeout: rayHTTPClientTimeout(useKubernetesProxy),
 		}
 		httpProxyURL := fmt.Sprintf("http://%s:%d/", hostIp, port)
+		proxiedPodName := podName
+
+		if rayCluster != nil && IsHTTPSEnabled(&rayCluster.Spec) {
+			httpProxyURL = fmt.Sprintf("https://%s:%d/", hostIp, port)
+			proxiedPodName = "https:" + podName
+			// When the API server proxies the request, it terminates the client connection itself.
+			if !useKubernetesProxy {
+				transport, err := GetRayClusterTLSTransport(context.Background(), mgr.GetClient(), rayCluster)
+				if err != nil {
+					return nil, fmt.Errorf("failed to construct Ray HTTP proxy client: %w", err)
+				}
+				httpClient.Transport = transport
+			}
+		}
 
 		if useKubernetesProxy {
 			// Use the manager's transport for TLS and API server authentication.
 			httpClient.Transport = mgr.GetHTTPClient().Transport
-			httpProxyURL = fmt.Sprintf("%s/api/v1/namespaces/%s/pods/%s:%d/proxy/", mgr.GetConfig().Host, podNamespace, podName, port)
+			httpProxyURL = fmt.Sprintf("%s/api/v1/namespaces/%s/pods/%s:%d/proxy/", mgr.GetConfig().Host, podNamespace, proxiedPodName, port)
 		}
 
 		return &RayHttpProxyClient{
 			client:       httpClient,
 			httpProxyURL: httpProxyURL,
-		}
+		}, nil
+	}
+}
+
+// rayTLSTransports caches one HTTP transport per TLS Secret, so that the dashboard and Serve proxy clients,
+// which are built for every request, reuse keep-alive connections.
+var rayTLSTransports = newTLSTransportCache()
+
+type tlsTransportCache struct {
+	transports map[types.NamespacedName]cachedTLSTransport
+	mu         sync.Mutex
+}
+
+type cachedTLSTransport struct {
+	transport *http.Transport
+	hash      string
+}
+
+func newTLSTransportCache() *tlsTransportCache {
+	return &tlsTransportCache{transports: map[types.NamespacedName]cachedTLSTransport{}}
+}
+
+// get returns the cached transport for the Secret. If the TLS materials in the Secret changed since the
+// transport was built, it builds a new transport and closes the idle connections of the old one.
+func (c *tlsTransportCache) get(secret *corev1.Secret) (*http.Transport, error) {
+	key := types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}
+	hash := hashTLSSecret(secret)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.transports[key]
+	if ok && cached.hash == hash {
+		return cached.transport, nil
+	}
+	tlsConfig, err := BuildTLSConfigFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	// Clone the default transport to keep its proxy settings, dial timeouts, and connection pool limits.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	if ok {
+		cached.transport.CloseIdleConnections()
+	}
+	c.transports[key] = cachedTLSTransport{transport: transport, hash: hash}
+	return transport, nil
+}
+
+// hashTLSSecret returns a hash of the TLS materials in a Secret.
+func hashTLSSecret(secret *corev1.Secret) string {
+	h := sha256.New()
+	for _, key := range []string{RayTLSCACertKey, corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		data := secret.Data[key]
+		_ = binary.Write(h, binary.BigEndian, int64(len(data)))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// GetRayClusterTLSTransport returns the HTTP transport used to connect to the Ray dashboard and Serve proxy
+// of a RayCluster over HTTPS. The transport presents the certificate in the Secret referenced by the
+// RayCluster's TLSOptions and verifies the server against the CA in the Secret.
+func GetRayClusterTLSTransport(ctx context.Context, c client.Client, rayCluster *rayv1.RayCluster) (*http.Transport, error) {
+	secretName := rayCluster.Spec.TLSOptions.SecretName
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: secretName, Namespace: rayCluster.Namespace}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get TLS secret %s/%s: %w", rayCluster.Namespace, secretName, err)
+	}
+	return rayTLSTransports.get(secret)
+}
+
+// BuildTLSConfigFromSecret builds a mutual TLS client configuration from the `ca.crt`, `tls.crt`,
+// and `tls.key` keys of a Secret.
+func BuildTLSConfigFromSecret(secret *corev1.Secret) (*tls.Config, error) {
+	caCert, ok := secret.Data[RayTLSCACertKey]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in TLS secret %s/%s", RayTLSCACertKey, secret.Namespace, secret.Name)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to parse %q in TLS secret %s/%s", RayTLSCACertKey, secret.Namespace, secret.Name)
+	}
+	keyPair, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the key pair in TLS secret %s/%s: %w", secret.Namespace, secret.Name, err)
 	}
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		RootCAs:      rootCAs,
+		Certificates: []tls.Certificate{keyPair},
+	}, nil
 }
 
 // rayHTTPClientTimeout returns the request deadline for Ray HTTP clients.
@@ -2,9 +2,19 @@ package utils
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"math/big"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -971,6 +981,204 @@ func TestFindHeadPodReadyCondition(t *testing.T) {
 	}
 }
 
+func TestIsPodPreempted(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []corev1.PodCondition
+		expected   bool
+	}{
+		{
+			name:       "no conditions",
+			conditions: nil,
+			expected:   false,
+		},
+		{
+			name: "preempted by the scheduler",
+			conditions: []corev1.PodCondition{
+				{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue, Reason: corev1.PodReasonPreemptionByScheduler},
+			},
+			expected: true,
+		},
+		{
+			name: "evicted by the eviction API",
+			conditions: []corev1.PodCondition{
+				{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue, Reason: "EvictionByEvictionAPI"},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &corev1.Pod{Status: corev1.PodStatus{Conditions: tc.conditions}}
+			assert.Equal(t, tc.expected, IsPodPreempted(pod))
+		})
+	}
+}
+
+func TestGetPodPendingReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   corev1.PodStatus
+		expected string
+	}{
+		{
+			name:     "no reason",
+			status:   corev1.PodStatus{Phase: corev1.PodPending},
+			expected: "",
+		},
+		{
+			name: "unschedulable",
+			status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+				},
+			},
+			expected: corev1.PodReasonUnschedulable,
+		},
+		{
+			name: "image pull back-off",
+			status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}},
+				},
+			},
+			expected: "ImagePullBackOff",
+		},
+		{
+			name: "init container reason takes precedence",
+			status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				InitContainerStatuses: []corev1.ContainerStatus{
+					{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+				},
+				ContainerStatuses: []corev1.ContainerStatus{
+					{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}}},
+				},
+			},
+			expected: "CrashLoopBackOff",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &corev1.Pod{Status: tc.status}
+			assert.Equal(t, tc.expected, GetPodPendingReason(pod))
+		})
+	}
+}
+
+func TestCalculateWorkerGroupStatuses(t *testing.T) {
+	workerPod := func(groupName string, replicaName string, ready bool) corev1.Pod {
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+					RayNodeGroupLabelKey: groupName,
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+				},
+			},
+		}
+		if replicaName != "" {
+			pod.Labels[RayWorkerReplicaNameKey] = replicaName
+		}
+		if ready {
+			pod.Status = corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			}
+		}
+		return pod
+	}
+
+	cluster := &rayv1.RayCluster{
+		Spec: rayv1.RayClusterSpec{
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "cpu-group",
+					Replicas:    ptr.To[int32](2),
+					MinReplicas: ptr.To[int32](1),
+					MaxReplicas: ptr.To[int32](10),
+					NumOfHosts:  1,
+					// A window that started within the last hour is active at any time.
+					ScalingSchedules: []rayv1.ScalingSchedule{{Name: "always", Schedule: "* * * * *", DurationSeconds: 3600, MinReplicas: ptr.To[int32](0)}},
+				},
+				{GroupName: "tpu-group", Replicas: ptr.To[int32](2), NumOfHosts: 2},
+			},
+		},
+	}
+	windowStart := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	windowEnd := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+	pods := corev1.PodList{
+		Items: []corev1.Pod{
+			workerPod("cpu-group", "", true),
+			workerPod("cpu-group", "", false),
+			workerPod("tpu-group", "replica-a", true),
+			workerPod("tpu-group", "replica-a", true),
+			workerPod("tpu-group", "replica-b", true),
+			workerPod("tpu-group", "replica-b", false),
+		},
+	}
+	lastScaleTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	oldStatuses := []rayv1.WorkerGroupStatus{
+		{GroupName: "cpu-group", DesiredReplicas: 2, LastScaleTime: &lastScaleTime},
+		{
+			GroupName:       "tpu-group",
+			DesiredReplicas: 2,
+			LastScaleTime:   &lastScaleTime,
+			ActiveFallback:  &rayv1.ActiveFallback{GroupName: "cpu-group", Reason: "3 Pods were preempted within 10m0s", StartTime: windowStart, EndTime: windowEnd},
+		},
+	}
+	now := metav1.Now()
+
+	statuses := CalculateWorkerGroupStatuses(cluster, pods, oldStatuses, now)
+	require.Len(t, statuses, 2)
+
+	assert.Equal(t, "cpu-group", statuses[0].GroupName)
+	assert.Equal(t, int32(2), statuses[0].DesiredReplicas)
+	assert.Equal(t, int32(1), statuses[0].ReadyReplicas)
+	assert.Equal(t, int32(1), statuses[0].AvailableReplicas)
+	assert.Equal(t, int32(1), statuses[0].ReadyReplicaGroups)
+	assert.Equal(t, map[string]int32{corev1.PodReasonUnschedulable: 1}, statuses[0].PendingPodReasons)
+	assert.Equal(t, lastScaleTime, *statuses[0].LastScaleTime)
+	require.NotNil(t, statuses[0].ActiveScalingSchedule)
+	assert.Equal(t, "always", statuses[0].ActiveScalingSchedule.Name)
+	assert.False(t, statuses[0].ActiveScalingSchedule.StartTime.After(now.Time))
+	assert.Equal(t, time.Hour, statuses[0].ActiveScalingSchedule.EndTime.Sub(statuses[0].ActiveScalingSchedule.StartTime.Time))
+	assert.Equal(t, ptr.To[int32](0), statuses[0].ActiveScalingSchedule.MinReplicas)
+	assert.Equal(t, ptr.To[int32](10), statuses[0].ActiveScalingSchedule.MaxReplicas)
+
+	// Only one of the two multi-host replicas has all of its Pods ready.
+	assert.Equal(t, "tpu-group", statuses[1].GroupName)
+	assert.Equal(t, int32(4), statuses[1].DesiredReplicas)
+	assert.Equal(t, int32(3), statuses[1].ReadyReplicas)
+	assert.Equal(t, int32(1), statuses[1].ReadyReplicaGroups)
+	assert.Equal(t, now, *statuses[1].LastScaleTime)
+	assert.Nil(t, statuses[1].ActiveScalingSchedule)
+	require.NotNil(t, statuses[1].ActiveFallback)
+	assert.Equal(t, "cpu-group", statuses[1].ActiveFallback.GroupName)
+	assert.True(t, windowEnd.Equal(&statuses[1].ActiveFallback.EndTime))
+
+	// The preemptions recorded by the reconciler are kept.
+	oldStatuses[1].Preemptions = 3
+	oldStatuses[1].LastPreemptionTime = &windowStart
+	oldStatuses[1].RecentPreemptionTimes = []metav1.Time{windowStart}
+	oldStatuses[1].PreemptedPodUIDs = []string{"preempted-pod"}
+	statuses = CalculateWorkerGroupStatuses(cluster, pods, oldStatuses, now)
+	require.Len(t, statuses, 2)
+	assert.Equal(t, int32(3), statuses[1].Preemptions)
+	assert.Equal(t, &windowStart, statuses[1].LastPreemptionTime)
+	assert.Equal(t, []metav1.Time{windowStart}, statuses[1].RecentPreemptionTimes)
+	assert.Equal(t, []string{"preempted-pod"}, statuses[1].PreemptedPodUIDs)
+}
+
 func TestFindHeadPodReadyMessage(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1926,3 +2134,126 @@ func TestIsHTTPRouteEqual(t *testing.T) {
 		})
 	}
 }
+
+// generateTestKeyPair returns a self-signed certificate and its private key in PEM format.
+func generateTestKeyPair(t *testing.T) ([]byte, []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ray"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestBuildTLSConfigFromSecret(t *testing.T) {
+	certPEM, keyPEM := generateTestKeyPair(t)
+
+	tests := []struct {
+		data         map[string][]byte
+		name         string
+		errorMessage string
+	}{
+		{
+			name: "valid secret",
+			data: map[string][]byte{
+				RayTLSCACertKey:         certPEM,
+				corev1.TLSCertKey:       certPEM,
+				corev1.TLSPrivateKeyKey: keyPEM,
+			},
+		},
+		{
+			name: "missing CA certificate",
+			data: map[string][]byte{
+				corev1.TLSCertKey:       certPEM,
+				corev1.TLSPrivateKeyKey: keyPEM,
+			},
+			errorMessage: `key "ca.crt" not found in TLS secret default/ray-tls`,
+		},
+		{
+			name: "invalid CA certificate",
+			data: map[string][]byte{
+				RayTLSCACertKey:         []byte("invalid"),
+				corev1.TLSCertKey:       certPEM,
+				corev1.TLSPrivateKeyKey: keyPEM,
+			},
+			errorMessage: `failed to parse "ca.crt" in TLS secret default/ray-tls`,
+		},
+		{
+			name: "missing private key",
+			data: map[string][]byte{
+				RayTLSCACertKey:   certPEM,
+				corev1.TLSCertKey: certPEM,
+			},
+			errorMessage: "failed to load the key pair in TLS secret default/ray-tls",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ray-tls", Namespace: "default"},
+				Data:       tc.data,
+			}
+			tlsConfig, err := BuildTLSConfigFromSecret(secret)
+			if tc.errorMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, tlsConfig.RootCAs)
+			assert.Len(t, tlsConfig.Certificates, 1)
+			assert.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MinVersion)
+		})
+	}
+}
+
+func TestTLSTransportCache(t *testing.T) {
+	certPEM, keyPEM := generateTestKeyPair(t)
+	newCertPEM, newKeyPEM := generateTestKeyPair(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ray-tls", Namespace: "default"},
+		Data: map[string][]byte{
+			RayTLSCACertKey:         certPEM,
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		},
+	}
+	cache := newTLSTransportCache()
+
+	transport, err := cache.get(secret)
+	require.NoError(t, err)
+	assert.NotNil(t, transport.Proxy, "the transport should keep the proxy settings of the default transport")
+	assert.Equal(t, http.DefaultTransport.(*http.Transport).IdleConnTimeout, transport.IdleConnTimeout)
+
+	// The transport is reused while the Secret is unchanged.
+	cachedTransport, err := cache.get(secret.DeepCopy())
+	require.NoError(t, err)
+	assert.Same(t, transport, cachedTransport)
+
+	// The transport is rebuilt when the TLS materials in the Secret change.
+	secret.Data = map[string][]byte{
+		RayTLSCACertKey:         newCertPEM,
+		corev1.TLSCertKey:       newCertPEM,
+		corev1.TLSPrivateKeyKey: newKeyPEM,
+	}
+	reloadedTransport, err := cache.get(secret)
+	require.NoError(t, err)
+	assert.NotSame(t, transport, reloadedTransport)
+
+	// An invalid Secret doesn't replace the cached transport.
+	secret.Data = map[string][]byte{}
+	_, err = cache.get(secret)
+	require.Error(t, err)
+	assert.Len(t, cache.transports, 1)
+}
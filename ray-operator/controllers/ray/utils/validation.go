@@ -3,6 +3,8 @@ package utils
 import (
 	errstd "errors"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/utils/ptr"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
@@ -98,6 +101,18 @@ func validateRayGroupLabels(groupName string, rayStartParams, labels map[string]
 	return nil
 }
 
+// validatePodDisruptionBudget validates that the PodDisruptionBudget of a group sets exactly one of
+// minAvailable and maxUnavailable.
+func validatePodDisruptionBudget(groupName string, pdb *rayv1.PodDisruptionBudgetSpec) error {
+	if pdb == nil {
+		return nil
+	}
+	if (pdb.MinAvailable == nil) == (pdb.MaxUnavailable == nil) {
+		return fmt.Errorf("%s group podDisruptionBudget must set exactly one of minAvailable and maxUnavailable", groupName)
+	}
+	return nil
+}
+
 // Validation for invalid Ray Cluster configurations.
 func ValidateRayClusterSpec(spec *rayv1.RayClusterSpec, annotations map[string]string) error {
 	if len(spec.HeadGroupSpec.Template.Spec.Containers) == 0 {
@@ -110,6 +125,16 @@ func ValidateRayClusterSpec(spec *rayv1.RayClusterSpec, annotations map[string]s
 	if err := validateRayGroupLabels("Head", spec.HeadGroupSpec.RayStartParams, spec.HeadGroupSpec.Labels); err != nil {
 		return err
 	}
+	if err := validatePodDisruptionBudget("Head", spec.HeadGroupSpec.PodDisruptionBudget); err != nil {
+		return err
+	}
+	if err := validateVolumeClaimTemplates("Head", spec.HeadGroupSpec.VolumeClaimTemplates); err != nil {
+		return err
+	}
+
+	if err := validateIdlePolicy(spec.IdlePolicy); err != nil {
+		return err
+	}
 
 	// Check if autoscaling is enabled once to avoid repeated calls
 	isAutoscalingEnabled := IsAutoscalingEnabled(spec)
@@ -144,6 +169,24 @@ func ValidateRayClusterSpec(spec *rayv1.RayClusterSpec, annotations map[string]s
 		if err := validateWorkerGroupIdleTimeout(workerGroup, spec); err != nil {
 			return err
 		}
+		if err := validatePodDisruptionBudget(workerGroup.GroupName, workerGroup.PodDisruptionBudget); err != nil {
+			return err
+		}
+		if err := validateScalingSchedules(workerGroup, isAutoscalingEnabled); err != nil {
+			return err
+		}
+		if err := validateVolumeClaimTemplates(workerGroup.GroupName, workerGroup.VolumeClaimTemplates); err != nil {
+			return err
+		}
+		if len(workerGroup.VolumeClaimTemplates) > 0 && !features.Enabled(features.RayMultiHostIndexing) {
+			return fmt.Errorf("worker group %s sets volumeClaimTemplates, which require the RayMultiHostIndexing feature gate", workerGroup.GroupName)
+		}
+		if err := validateWorkerGroupTopology(workerGroup); err != nil {
+			return err
+		}
+		if err := validateWorkerGroupFallback(workerGroup, spec); err != nil {
+			return err
+		}
 	}
 
 	if annotations[RayFTEnabledAnnotationKey] != "" && spec.GcsFaultToleranceOptions != nil {
@@ -161,6 +204,10 @@ func ValidateRayClusterSpec(spec *rayv1.RayClusterSpec, annotations map[string]s
 
 	headContainer := spec.HeadGroupSpec.Template.Spec.Containers[RayContainerIndex]
 	if spec.GcsFaultToleranceOptions != nil {
+		if err := validateGcsStorageBackend(spec.GcsFaultToleranceOptions); err != nil {
+			return err
+		}
+
 		if redisPassword := spec.HeadGroupSpec.RayStartParams["redis-password"]; redisPassword != "" {
 			return fmt.Errorf("cannot set `redis-password` in rayStartParams when " +
 				"GcsFaultToleranceOptions is enabled - use GcsFaultToleranceOptions.RedisPassword instead")
@@ -235,6 +282,10 @@ func ValidateRayClusterSpec(spec *rayv1.RayClusterSpec, annotations map[string]s
 		}
 	}
 
+	if IsTLSEnabled(spec) && spec.TLSOptions.SecretName == "" {
+		return fmt.Errorf("tlsOptions.secretName is required when tlsOptions is set")
+	}
+
 	if IsAuthEnabled(spec) {
 		if spec.RayVersion == "" {
 			return fmt.Errorf("authOptions.mode is 'token' but RayVersion was not specified. Ray version 2.52.0 or later is required")
@@ -260,10 +311,17 @@ func ValidateRayClusterSpec(spec *rayv1.RayClusterSpec, annotations map[string]s
 				return fmt.Errorf("authOptions.enableK8sTokenAuth is enabled and authOptions.secretName is also set")
 			}
 		}
+
+		if err := validateAuthTokenRotation(spec.AuthOptions); err != nil {
+			return err
+		}
 	} else {
 		if IsK8sAuthEnabled(spec.AuthOptions) {
 			return fmt.Errorf("authOptions.enableK8sTokenAuth is enabled but authOptions.mode not set to 'token'")
 		}
+		if spec.AuthOptions != nil && spec.AuthOptions.TokenRotationPeriodSeconds != nil {
+			return fmt.Errorf("authOptions.tokenRotationPeriodSeconds is set but authOptions.mode not set to 'token'")
+		}
 	}
 
 	return nil
@@ -323,6 +381,17 @@ func ValidateRayJobSpec(rayJob *rayv1.RayJob) error {
 		}
 	}
 
+	// The Kueue batch scheduler suspends a RayJob to queue it, which is only supported for RayJobs that create their
+	// RayCluster and delete it when they finish.
+	if rayJob.Labels[KueueQueueNameLabelKey] != "" {
+		if isClusterSelectorMode {
+			return fmt.Errorf("The RayJob spec is invalid: a RayJob with the %s label can't use the ClusterSelector mode", KueueQueueNameLabelKey)
+		}
+		if !rayJob.Spec.ShutdownAfterJobFinishes {
+			return fmt.Errorf("The RayJob spec is invalid: a RayJob with the %s label must set shutdownAfterJobFinishes to true", KueueQueueNameLabelKey)
+		}
+	}
+
 	// InteractiveMode does not support backoffLimit > 1.
 	// When a RayJob fails (e.g., due to a missing script) and retries,
 	// spec.JobId remains set, causing the new job to incorrectly transition
@@ -352,11 +421,33 @@ func ValidateRayJobSpec(rayJob *rayv1.RayJob) error {
 		if IsK8sAuthEnabled(rayJob.Spec.RayClusterSpec.AuthOptions) {
 			return fmt.Errorf("The RayJob spec is invalid: K8s token auth mode is currently not supported for RayJob")
 		}
+		if rayJob.Spec.RayClusterSpec.IdlePolicy != nil {
+			return fmt.Errorf("The RayJob spec is invalid: rayClusterSpec.idlePolicy is not supported for RayJob")
+		}
+		if hasScalingSchedules(rayJob.Spec.RayClusterSpec) {
+			return fmt.Errorf("The RayJob spec is invalid: scalingSchedules are not supported for RayJob worker groups")
+		}
+		if hasWorkerGroupFallbacks(rayJob.Spec.RayClusterSpec) {
+			return fmt.Errorf("The RayJob spec is invalid: fallback is not supported for RayJob worker groups")
+		}
 		if err := ValidateRayClusterSpec(rayJob.Spec.RayClusterSpec, rayJob.Annotations); err != nil {
 			return fmt.Errorf("The RayJob spec is invalid: %w", err)
 		}
 	}
 
+	if rayJob.Spec.WaitForImagePrePull {
+		if !features.Enabled(features.RayClusterStatusConditions) {
+			return fmt.Errorf("The RayJob spec is invalid: waitForImagePrePull requires the RayClusterStatusConditions feature gate to be enabled")
+		}
+		// The RayJob doesn't own the RayCluster selected by ClusterSelector, so it can't make it pre-pull the images.
+		if len(rayJob.Spec.ClusterSelector) > 0 {
+			return fmt.Errorf("The RayJob spec is invalid: waitForImagePrePull can't be used with clusterSelector")
+		}
+		if rayJob.Spec.RayClusterSpec != nil && rayJob.Spec.RayClusterSpec.ImagePrePull == nil {
+			return fmt.Errorf("The RayJob spec is invalid: waitForImagePrePull requires rayClusterSpec.imagePrePull to be set")
+		}
+	}
+
 	// Validate whether RuntimeEnvYAML is a valid YAML string. Note that this only checks its validity
 	// as a YAML string, not its adherence to the runtime environment schema.
 	if _, err := dashboardclient.UnmarshalRuntimeEnvYAML(rayJob.Spec.RuntimeEnvYAML); err != nil {
@@ -427,11 +518,29 @@ func ValidateRayServiceSpec(rayService *rayv1.RayService) error {
 	if IsK8sAuthEnabled(rayService.Spec.RayClusterSpec.AuthOptions) {
 		return fmt.Errorf("The RayService spec is invalid: K8s token auth mode is currently not supported for RayService")
 	}
+	if rayService.Spec.RayClusterSpec.IdlePolicy != nil {
+		return fmt.Errorf("The RayService spec is invalid: rayClusterConfig.idlePolicy is not supported for RayService")
+	}
+	if hasScalingSchedules(&rayService.Spec.RayClusterSpec) {
+		return fmt.Errorf("The RayService spec is invalid: scalingSchedules are not supported for RayService worker groups")
+	}
+	if hasWorkerGroupFallbacks(&rayService.Spec.RayClusterSpec) {
+		return fmt.Errorf("The RayService spec is invalid: fallback is not supported for RayService worker groups")
+	}
 
 	if err := ValidateRayClusterSpec(&rayService.Spec.RayClusterSpec, rayService.Annotations); err != nil {
 		return fmt.Errorf("The RayService spec is invalid: %w", err)
 	}
 
+	if source := rayService.Spec.ServeConfigV2From; source != nil {
+		if rayService.Spec.ServeConfigV2 != "" {
+			return fmt.Errorf("The RayService spec is invalid: spec.serveConfigV2 and spec.serveConfigV2From are mutually exclusive")
+		}
+		if (source.ConfigMapKeyRef == nil) == (source.SecretKeyRef == nil) {
+			return fmt.Errorf("The RayService spec is invalid: exactly one of spec.serveConfigV2From.configMapKeyRef and spec.serveConfigV2From.secretKeyRef must be set")
+		}
+	}
+
 	if headSvc := rayService.Spec.RayClusterSpec.HeadGroupSpec.HeadService; headSvc != nil && headSvc.Name != "" {
 		return fmt.Errorf("The RayService spec is invalid: spec.rayClusterConfig.headGroupSpec.headService.metadata.name should not be set")
 	}
@@ -445,11 +554,28 @@ func ValidateRayServiceSpec(rayService *rayv1.RayService) error {
 		return fmt.Errorf("The RayService spec is invalid: Spec.UpgradeStrategy.Type value %s is invalid, valid options are %s, %s, or %s", *rayService.Spec.UpgradeStrategy.Type, rayv1.RayServiceNewClusterWithIncrementalUpgrade, rayv1.RayServiceNewCluster, rayv1.RayServiceUpgradeNone)
 	}
 
+	// only AllAtOnce and Sequential are valid serve rollout types
+	if rayService.Spec.ServeRolloutStrategy != nil &&
+		rayService.Spec.ServeRolloutStrategy.Type != nil &&
+		*rayService.Spec.ServeRolloutStrategy.Type != rayv1.ServeRolloutAllAtOnce &&
+		*rayService.Spec.ServeRolloutStrategy.Type != rayv1.ServeRolloutSequential {
+		return fmt.Errorf("The RayService spec is invalid: Spec.ServeRolloutStrategy.Type value %s is invalid, valid options are %s or %s", *rayService.Spec.ServeRolloutStrategy.Type, rayv1.ServeRolloutAllAtOnce, rayv1.ServeRolloutSequential)
+	}
+
+	if IsSequentialServeRolloutEnabled(&rayService.Spec) && IsIncrementalUpgradeEnabled(&rayService.Spec) {
+		return fmt.Errorf("The RayService spec is invalid: Spec.ServeRolloutStrategy.Type %s is not supported with Spec.UpgradeStrategy.Type %s", rayv1.ServeRolloutSequential, rayv1.RayServiceNewClusterWithIncrementalUpgrade)
+	}
+
 	if rayService.Spec.RayClusterDeletionDelaySeconds != nil &&
 		*rayService.Spec.RayClusterDeletionDelaySeconds < 0 {
 		return fmt.Errorf("The RayService spec is invalid: Spec.RayClusterDeletionDelaySeconds should be a non-negative integer, got %d", *rayService.Spec.RayClusterDeletionDelaySeconds)
 	}
 
+	if rayService.Spec.RevisionHistoryLimit != nil &&
+		*rayService.Spec.RevisionHistoryLimit < 0 {
+		return fmt.Errorf("The RayService spec is invalid: Spec.RevisionHistoryLimit should be a non-negative integer, got %d", *rayService.Spec.RevisionHistoryLimit)
+	}
+
 	// If type is NewClusterWithIncrementalUpgrade, validate the ClusterUpgradeOptions
 	if IsIncrementalUpgradeEnabled(&rayService.Spec) {
 		if err := ValidateClusterUpgradeOptions(rayService); err != nil {
@@ -730,6 +856,220 @@ func ValidateRayCronJobSpec(rayCronJob *rayv1.RayCronJob) error {
 	return nil
 }
 
+// validateGcsStorageBackend validates that the storage backend of GCS fault tolerance is supported.
+func validateGcsStorageBackend(options *rayv1.GcsFaultToleranceOptions) error {
+	backendType := rayv1.GcsStorageBackendRedis
+	if options.StorageBackend != nil {
+		backendType = *options.StorageBackend
+	}
+	switch backendType {
+	case rayv1.GcsStorageBackendRedis:
+		if options.ManagedRedis != nil && (options.RedisAddress != "" || options.RedisUsername != nil || options.RedisPassword != nil) {
+			return fmt.Errorf("GcsFaultToleranceOptions.ManagedRedis can't be used together with RedisAddress, RedisUsername, or RedisPassword")
+		}
+	default:
+		return fmt.Errorf("unsupported GcsFaultToleranceOptions.StorageBackend %q", backendType)
+	}
+	return nil
+}
+
+func validateVolumeClaimTemplates(groupName string, templates []rayv1.VolumeClaimTemplate) error {
+	names := map[string]bool{}
+	for _, template := range templates {
+		if errs := validation.IsDNS1123Label(template.Name); len(errs) > 0 {
+			return fmt.Errorf("%s group volumeClaimTemplate name %q is invalid: %s", groupName, template.Name, strings.Join(errs, ", "))
+		}
+		if names[template.Name] {
+			return fmt.Errorf("%s group volumeClaimTemplate name %q is duplicated", groupName, template.Name)
+		}
+		names[template.Name] = true
+	}
+	return nil
+}
+
+func validateWorkerGroupTopology(workerGroup rayv1.WorkerGroupSpec) error {
+	if workerGroup.Topology == nil {
+		return nil
+	}
+	if workerGroup.NumOfHosts <= 1 {
+		return fmt.Errorf("worker group %s sets topology, which requires numOfHosts greater than 1", workerGroup.GroupName)
+	}
+	if !features.Enabled(features.RayMultiHostIndexing) {
+		return fmt.Errorf("worker group %s sets topology, which requires the RayMultiHostIndexing feature gate", workerGroup.GroupName)
+	}
+	if errs := validation.IsQualifiedName(workerGroup.Topology.RequiredTopologyKey); len(errs) > 0 {
+		return fmt.Errorf("worker group %s topology.requiredTopologyKey %q is invalid: %s", workerGroup.GroupName, workerGroup.Topology.RequiredTopologyKey, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// validateIdlePolicy validates the idle policy of a RayCluster.
+func validateIdlePolicy(idlePolicy *rayv1.IdlePolicy) error {
+	if idlePolicy == nil {
+		return nil
+	}
+	if idlePolicy.IdleTimeoutSeconds <= 0 {
+		return fmt.Errorf("idlePolicy.idleTimeoutSeconds must be positive, got %d", idlePolicy.IdleTimeoutSeconds)
+	}
+	if idlePolicy.WarningPeriodSeconds != nil && *idlePolicy.WarningPeriodSeconds < 0 {
+		return fmt.Errorf("idlePolicy.warningPeriodSeconds must be non-negative, got %d", *idlePolicy.WarningPeriodSeconds)
+	}
+	if idlePolicy.Action != nil && *idlePolicy.Action != rayv1.IdleActionSuspend && *idlePolicy.Action != rayv1.IdleActionDelete {
+		return fmt.Errorf("idlePolicy.action must be %s or %s, got %s", rayv1.IdleActionSuspend, rayv1.IdleActionDelete, *idlePolicy.Action)
+	}
+	return nil
+}
+
+// validateScalingSchedules validates the scaling schedules of a worker group.
+func validateScalingSchedules(workerGroup rayv1.WorkerGroupSpec, isAutoscalingEnabled bool) error {
+	names := make(map[string]bool, len(workerGroup.ScalingSchedules))
+	for _, scalingSchedule := range workerGroup.ScalingSchedules {
+		if scalingSchedule.Name == "" {
+			return fmt.Errorf("worker group %s has a scaling schedule without a name", workerGroup.GroupName)
+		}
+		if names[scalingSchedule.Name] {
+			return fmt.Errorf("worker group %s has duplicate scaling schedule %s", workerGroup.GroupName, scalingSchedule.Name)
+		}
+		names[scalingSchedule.Name] = true
+		if _, _, err := ParseScalingSchedule(scalingSchedule); err != nil {
+			return fmt.Errorf("scaling schedule %s of worker group %s has an %w", scalingSchedule.Name, workerGroup.GroupName, err)
+		}
+		if scalingSchedule.DurationSeconds <= 0 {
+			return fmt.Errorf("scaling schedule %s of worker group %s must have a positive durationSeconds, got %d",
+				scalingSchedule.Name, workerGroup.GroupName, scalingSchedule.DurationSeconds)
+		}
+		// The autoscaler sets the replicas of the worker group, so the scaling schedules can only override its bounds.
+		if isAutoscalingEnabled && scalingSchedule.Replicas != nil {
+			return fmt.Errorf("scaling schedule %s of worker group %s can't set replicas when autoscaling is enabled",
+				scalingSchedule.Name, workerGroup.GroupName)
+		}
+		if scalingSchedule.Replicas == nil && scalingSchedule.MinReplicas == nil && scalingSchedule.MaxReplicas == nil {
+			return fmt.Errorf("scaling schedule %s of worker group %s must set at least one of replicas, minReplicas, and maxReplicas",
+				scalingSchedule.Name, workerGroup.GroupName)
+		}
+		for _, field := range []struct {
+			value *int32
+			name  string
+		}{
+			{scalingSchedule.Replicas, "replicas"},
+			{scalingSchedule.MinReplicas, "minReplicas"},
+			{scalingSchedule.MaxReplicas, "maxReplicas"},
+		} {
+			if field.value != nil && *field.value < 0 {
+				return fmt.Errorf("scaling schedule %s of worker group %s has negative %s %d", scalingSchedule.Name, workerGroup.GroupName, field.name, *field.value)
+			}
+		}
+		minReplicas := ptr.Deref(scalingSchedule.MinReplicas, ptr.Deref(workerGroup.MinReplicas, 0))
+		maxReplicas := ptr.Deref(scalingSchedule.MaxReplicas, ptr.Deref(workerGroup.MaxReplicas, math.MaxInt32))
+		if minReplicas > maxReplicas {
+			return fmt.Errorf("scaling schedule %s of worker group %s results in minReplicas %d greater than maxReplicas %d",
+				scalingSchedule.Name, workerGroup.GroupName, minReplicas, maxReplicas)
+		}
+	}
+	return nil
+}
+
+// hasScalingSchedules returns whether any worker group of the RayCluster spec has scaling schedules.
+func hasScalingSchedules(spec *rayv1.RayClusterSpec) bool {
+	for _, workerGroup := range spec.WorkerGroupSpecs {
+		if len(workerGroup.ScalingSchedules) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// validateWorkerGroupFallback validates the fallback of a worker group. The replica settings of both the worker group
+// and its fallback group are overridden while the fallback is active, so neither of them can have scaling schedules,
+// and a worker group can't be the fallback of more than one worker group.
+func validateWorkerGroupFallback(workerGroup rayv1.WorkerGroupSpec, spec *rayv1.RayClusterSpec) error {
+	fallback := workerGroup.Fallback
+	if fallback == nil {
+		return nil
+	}
+	if fallback.GroupName == workerGroup.GroupName {
+		return fmt.Errorf("worker group %s can't fall back to itself", workerGroup.GroupName)
+	}
+	fallbackIndex := slices.IndexFunc(spec.WorkerGroupSpecs, func(group rayv1.WorkerGroupSpec) bool { return group.GroupName == fallback.GroupName })
+	if fallbackIndex == -1 {
+		return fmt.Errorf("worker group %s falls back to worker group %s, which doesn't exist", workerGroup.GroupName, fallback.GroupName)
+	}
+	if spec.WorkerGroupSpecs[fallbackIndex].Fallback != nil {
+		return fmt.Errorf("worker group %s falls back to worker group %s, which has its own fallback", workerGroup.GroupName, fallback.GroupName)
+	}
+	for _, group := range spec.WorkerGroupSpecs {
+		if group.GroupName != workerGroup.GroupName && group.Fallback != nil && group.Fallback.GroupName == fallback.GroupName {
+			return fmt.Errorf("worker group %s is the fallback of both worker groups %s and %s", fallback.GroupName, workerGroup.GroupName, group.GroupName)
+		}
+	}
+	if len(workerGroup.ScalingSchedules) > 0 || len(spec.WorkerGroupSpecs[fallbackIndex].ScalingSchedules) > 0 {
+		return fmt.Errorf("worker group %s has a fallback, which can't be combined with scalingSchedules on the worker group or its fallback group", workerGroup.GroupName)
+	}
+	for _, field := range []struct {
+		value *int32
+		name  string
+	}{
+		{fallback.UnschedulableTimeoutSeconds, "unschedulableTimeoutSeconds"},
+		{fallback.MaxPreemptions, "maxPreemptions"},
+		{fallback.PreemptionWindowSeconds, "preemptionWindowSeconds"},
+		{fallback.DurationSeconds, "durationSeconds"},
+	} {
+		if field.value != nil && *field.value <= 0 {
+			return fmt.Errorf("worker group %s fallback.%s must be positive, got %d", workerGroup.GroupName, field.name, *field.value)
+		}
+	}
+	return nil
+}
+
+// hasWorkerGroupFallbacks returns whether any worker group of the RayCluster spec has a fallback.
+func hasWorkerGroupFallbacks(spec *rayv1.RayClusterSpec) bool {
+	for _, workerGroup := range spec.WorkerGroupSpecs {
+		if workerGroup.Fallback != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAuthTokenRotation validates the token rotation options of a token-mode RayCluster.
+func validateAuthTokenRotation(authOptions *rayv1.AuthOptions) error {
+	if authOptions.TokenRotationGracePeriodSeconds != nil {
+		if authOptions.TokenRotationPeriodSeconds == nil {
+			return fmt.Errorf("authOptions.tokenRotationGracePeriodSeconds is set but authOptions.tokenRotationPeriodSeconds is not")
+		}
+		if *authOptions.TokenRotationGracePeriodSeconds < 0 {
+			return fmt.Errorf("authOptions.tokenRotationGracePeriodSeconds must be non-negative, got %d", *authOptions.TokenRotationGracePeriodSeconds)
+		}
+	}
+	if authOptions.TokenRotationPeriodSeconds == nil {
+		return nil
+	}
+	if *authOptions.TokenRotationPeriodSeconds <= 0 {
+		return fmt.Errorf("authOptions.tokenRotationPeriodSeconds must be positive, got %d", *authOptions.TokenRotationPeriodSeconds)
+	}
+	if IsK8sAuthEnabled(authOptions) {
+		return fmt.Errorf("authOptions.tokenRotationPeriodSeconds is not supported when authOptions.enableK8sTokenAuth is enabled")
+	}
+	if authOptions.SecretName != nil && *authOptions.SecretName != "" {
+		return fmt.Errorf("authOptions.tokenRotationPeriodSeconds is not supported when authOptions.secretName is set")
+	}
+	return nil
+}
+
+// ValidateRayWorkerGroupScalerSpec validates the RayWorkerGroupScaler specification
+func ValidateRayWorkerGroupScalerSpec(scaler *rayv1.RayWorkerGroupScaler) error {
+	if scaler.Spec.RayClusterName == "" {
+		return fmt.Errorf("rayClusterName is required")
+	}
+	if scaler.Spec.GroupName == "" {
+		return fmt.Errorf("groupName is required")
+	}
+	if scaler.Spec.Replicas != nil && *scaler.Spec.Replicas < 0 {
+		return fmt.Errorf("replicas must be non-negative, got %d", *scaler.Spec.Replicas)
+	}
+	return nil
+}
+
 // validateWorkerGroupIdleTimeout validates the idleTimeoutSeconds field in a worker group spec
 func validateWorkerGroupIdleTimeout(workerGroup rayv1.WorkerGroupSpec, spec *rayv1.RayClusterSpec) error {
 	idleTimeoutSeconds := workerGroup.IdleTimeoutSeconds
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -203,6 +204,38 @@ func TestValidateRayClusterSpecGcsFaultToleranceOptions(t *testing.T) {
 			expectError:              true,
 			errorMessage:             errorMessageExternalStorageNamespaceConflict,
 		},
+		{
+			name: "gcsFaultToleranceOptions uses the Redis storage backend",
+			gcsFaultToleranceOptions: &rayv1.GcsFaultToleranceOptions{
+				StorageBackend: ptr.To(rayv1.GcsStorageBackendRedis),
+				RedisAddress:   "redis:6379",
+			},
+			expectError: false,
+		},
+		{
+			name: "gcsFaultToleranceOptions uses managed Redis",
+			gcsFaultToleranceOptions: &rayv1.GcsFaultToleranceOptions{
+				ManagedRedis: &rayv1.ManagedRedisOptions{},
+			},
+			expectError: false,
+		},
+		{
+			name: "gcsFaultToleranceOptions sets both managed Redis and RedisAddress",
+			gcsFaultToleranceOptions: &rayv1.GcsFaultToleranceOptions{
+				ManagedRedis: &rayv1.ManagedRedisOptions{},
+				RedisAddress: "redis:6379",
+			},
+			expectError:  true,
+			errorMessage: "GcsFaultToleranceOptions.ManagedRedis can't be used together with RedisAddress, RedisUsername, or RedisPassword",
+		},
+		{
+			name: "gcsFaultToleranceOptions uses an unsupported storage backend",
+			gcsFaultToleranceOptions: &rayv1.GcsFaultToleranceOptions{
+				StorageBackend: ptr.To(rayv1.GcsStorageBackendType("Unknown")),
+			},
+			expectError:  true,
+			errorMessage: "unsupported GcsFaultToleranceOptions.StorageBackend \"Unknown\"",
+		},
 	}
 
 	for _, tt := range tests {
@@ -712,57 +745,556 @@ func TestValidateRayClusterSpec_Resources(t *testing.T) {
 		expectError  bool
 	}{
 		{
-			name: "Invalid: Head group has resources in both rayStartParams and top-level Resources",
-			spec: func() rayv1.RayClusterSpec {
-				s := createSpec()
-				s.HeadGroupSpec.RayStartParams = map[string]string{"num-cpus": "1"}
-				s.HeadGroupSpec.Resources = map[string]string{"CPU": "1"}
+			name: "Invalid: Head group has resources in both rayStartParams and top-level Resources",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.HeadGroupSpec.RayStartParams = map[string]string{"num-cpus": "1"}
+				s.HeadGroupSpec.Resources = map[string]string{"CPU": "1"}
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "resource fields should not be set in both rayStartParams and Resources for Head group; please use only one",
+		},
+		{
+			name: "Invalid: Worker group has resources in both rayStartParams and .Resources",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].RayStartParams = map[string]string{"num-gpus": "1"}
+				s.WorkerGroupSpecs[0].Resources = map[string]string{"GPU": "1"}
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "resource fields should not be set in both rayStartParams and Resources for worker-group group; please use only one",
+		},
+		{
+			name: "Valid: Only rayStartParams resources are set for head",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.HeadGroupSpec.RayStartParams = map[string]string{
+					"num-cpus":  "2",
+					"memory":    "4G",
+					"resources": "{\"TPU\": \"8\"}",
+				}
+				return s
+			}(),
+			expectError: false,
+		},
+		{
+			name: "Valid: Only .Resources field is set for worker",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].Resources = map[string]string{"CPU": "2", "memory": "4G", "TPU": "8"}
+				return s
+			}(),
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRayClusterSpec(&tt.spec, nil)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpec_PodDisruptionBudget(t *testing.T) {
+	// Util function to create a RayCluster spec.
+	createSpec := func() rayv1.RayClusterSpec {
+		return rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: podTemplateSpec(nil, nil),
+			},
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "worker-group",
+					Template:    podTemplateSpec(nil, nil),
+					MinReplicas: ptr.To(int32(0)),
+					MaxReplicas: ptr.To(int32(5)),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		errorMessage string
+		spec         rayv1.RayClusterSpec
+		expectError  bool
+	}{
+		{
+			name: "Valid: Head group sets minAvailable and worker group sets maxUnavailable",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.HeadGroupSpec.PodDisruptionBudget = &rayv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt32(1))}
+				s.WorkerGroupSpecs[0].PodDisruptionBudget = &rayv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromString("25%"))}
+				return s
+			}(),
+			expectError: false,
+		},
+		{
+			name: "Invalid: Head group sets both minAvailable and maxUnavailable",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.HeadGroupSpec.PodDisruptionBudget = &rayv1.PodDisruptionBudgetSpec{
+					MinAvailable:   ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+				}
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "Head group podDisruptionBudget must set exactly one of minAvailable and maxUnavailable",
+		},
+		{
+			name: "Invalid: Worker group sets neither minAvailable nor maxUnavailable",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].PodDisruptionBudget = &rayv1.PodDisruptionBudgetSpec{}
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "worker-group group podDisruptionBudget must set exactly one of minAvailable and maxUnavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRayClusterSpec(&tt.spec, nil)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpec_VolumeClaimTemplates(t *testing.T) {
+	createSpec := func() rayv1.RayClusterSpec {
+		return rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: podTemplateSpec(nil, nil),
+			},
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "worker-group",
+					Template:    podTemplateSpec(nil, nil),
+					MinReplicas: ptr.To(int32(0)),
+					MaxReplicas: ptr.To(int32(5)),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		errorMessage          string
+		spec                  rayv1.RayClusterSpec
+		disableMultiHostIndex bool
+		expectError           bool
+	}{
+		{
+			name: "Valid: head and worker groups set volume claim templates",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.HeadGroupSpec.VolumeClaimTemplates = []rayv1.VolumeClaimTemplate{{Name: "spill"}}
+				s.WorkerGroupSpecs[0].VolumeClaimTemplates = []rayv1.VolumeClaimTemplate{{Name: "spill"}, {Name: "cache"}}
+				return s
+			}(),
+			expectError: false,
+		},
+		{
+			name: "Invalid: volume claim template name isn't a DNS label",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.HeadGroupSpec.VolumeClaimTemplates = []rayv1.VolumeClaimTemplate{{Name: "Spill_Dir"}}
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: `Head group volumeClaimTemplate name "Spill_Dir" is invalid`,
+		},
+		{
+			name: "Invalid: duplicated volume claim template names",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].VolumeClaimTemplates = []rayv1.VolumeClaimTemplate{{Name: "spill"}, {Name: "spill"}}
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: `worker-group group volumeClaimTemplate name "spill" is duplicated`,
+		},
+		{
+			name: "Invalid: worker group volume claim templates without replica indices",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].VolumeClaimTemplates = []rayv1.VolumeClaimTemplate{{Name: "spill"}}
+				return s
+			}(),
+			disableMultiHostIndex: true,
+			expectError:           true,
+			errorMessage:          "worker group worker-group sets volumeClaimTemplates, which require the RayMultiHostIndexing feature gate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.RayMultiHostIndexing, !tt.disableMultiHostIndex)
+			err := ValidateRayClusterSpec(&tt.spec, nil)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpec_WorkerGroupTopology(t *testing.T) {
+	createSpec := func() rayv1.RayClusterSpec {
+		return rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: podTemplateSpec(nil, nil),
+			},
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "worker-group",
+					Template:    podTemplateSpec(nil, nil),
+					MinReplicas: ptr.To(int32(0)),
+					MaxReplicas: ptr.To(int32(4)),
+					NumOfHosts:  4,
+					Topology:    &rayv1.WorkerGroupTopology{RequiredTopologyKey: "cloud.google.com/gke-nodepool"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		errorMessage          string
+		spec                  rayv1.RayClusterSpec
+		disableMultiHostIndex bool
+		expectError           bool
+	}{
+		{
+			name:        "Valid: multi-host worker group sets topology",
+			spec:        createSpec(),
+			expectError: false,
+		},
+		{
+			name: "Invalid: single-host worker group sets topology",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].NumOfHosts = 1
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "worker group worker-group sets topology, which requires numOfHosts greater than 1",
+		},
+		{
+			name:                  "Invalid: topology without replica names",
+			spec:                  createSpec(),
+			disableMultiHostIndex: true,
+			expectError:           true,
+			errorMessage:          "worker group worker-group sets topology, which requires the RayMultiHostIndexing feature gate",
+		},
+		{
+			name: "Invalid: topology key isn't a label key",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].Topology.RequiredTopologyKey = "node pool"
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: `worker group worker-group topology.requiredTopologyKey "node pool" is invalid`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.RayMultiHostIndexing, !tt.disableMultiHostIndex)
+			err := ValidateRayClusterSpec(&tt.spec, nil)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpec_WorkerGroupFallback(t *testing.T) {
+	createSpec := func() rayv1.RayClusterSpec {
+		return rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: podTemplateSpec(nil, nil),
+			},
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "spot",
+					Template:    podTemplateSpec(nil, nil),
+					MinReplicas: ptr.To(int32(0)),
+					MaxReplicas: ptr.To(int32(5)),
+					Fallback:    &rayv1.WorkerGroupFallback{GroupName: "on-demand"},
+				},
+				{
+					GroupName:   "on-demand",
+					Template:    podTemplateSpec(nil, nil),
+					MinReplicas: ptr.To(int32(0)),
+					MaxReplicas: ptr.To(int32(5)),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		errorMessage string
+		spec         rayv1.RayClusterSpec
+		expectError  bool
+	}{
+		{
+			name:        "Valid: worker group falls back to another worker group",
+			spec:        createSpec(),
+			expectError: false,
+		},
+		{
+			name: "Invalid: worker group falls back to itself",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].Fallback.GroupName = "spot"
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "worker group spot can't fall back to itself",
+		},
+		{
+			name: "Invalid: fallback group doesn't exist",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].Fallback.GroupName = "reserved"
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "worker group spot falls back to worker group reserved, which doesn't exist",
+		},
+		{
+			name: "Invalid: fallback group has its own fallback",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[1].Fallback = &rayv1.WorkerGroupFallback{GroupName: "spot"}
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "worker group spot falls back to worker group on-demand, which has its own fallback",
+		},
+		{
+			name: "Invalid: worker group is the fallback of two worker groups",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				second := *s.WorkerGroupSpecs[0].DeepCopy()
+				second.GroupName = "spot-2"
+				s.WorkerGroupSpecs = append(s.WorkerGroupSpecs, second)
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "worker group on-demand is the fallback of both worker groups spot and spot-2",
+		},
+		{
+			name: "Invalid: fallback group has scaling schedules",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[1].ScalingSchedules = []rayv1.ScalingSchedule{{Name: "nightly", Schedule: "0 0 * * *", DurationSeconds: 60, MinReplicas: ptr.To[int32](1)}}
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "worker group spot has a fallback, which can't be combined with scalingSchedules",
+		},
+		{
+			name: "Invalid: non-positive maxPreemptions",
+			spec: func() rayv1.RayClusterSpec {
+				s := createSpec()
+				s.WorkerGroupSpecs[0].Fallback.MaxPreemptions = ptr.To[int32](0)
+				return s
+			}(),
+			expectError:  true,
+			errorMessage: "worker group spot fallback.maxPreemptions must be positive, got 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRayClusterSpec(&tt.spec, nil)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpec_IdlePolicy(t *testing.T) {
+	tests := []struct {
+		idlePolicy   *rayv1.IdlePolicy
+		name         string
+		errorMessage string
+	}{
+		{
+			name:       "Valid: idle policy with the default action",
+			idlePolicy: &rayv1.IdlePolicy{IdleTimeoutSeconds: 3600},
+		},
+		{
+			name: "Valid: delete action without a warning period",
+			idlePolicy: &rayv1.IdlePolicy{
+				Action:               ptr.To(rayv1.IdleActionDelete),
+				IdleTimeoutSeconds:   3600,
+				WarningPeriodSeconds: ptr.To[int32](0),
+			},
+		},
+		{
+			name:         "Invalid: idleTimeoutSeconds is not positive",
+			idlePolicy:   &rayv1.IdlePolicy{IdleTimeoutSeconds: 0},
+			errorMessage: "idlePolicy.idleTimeoutSeconds must be positive, got 0",
+		},
+		{
+			name:         "Invalid: warningPeriodSeconds is negative",
+			idlePolicy:   &rayv1.IdlePolicy{IdleTimeoutSeconds: 3600, WarningPeriodSeconds: ptr.To[int32](-1)},
+			errorMessage: "idlePolicy.warningPeriodSeconds must be non-negative, got -1",
+		},
+		{
+			name:         "Invalid: unknown action",
+			idlePolicy:   &rayv1.IdlePolicy{IdleTimeoutSeconds: 3600, Action: ptr.To(rayv1.IdleAction("Scale"))},
+			errorMessage: "idlePolicy.action must be Suspend or Delete, got Scale",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := createBasicRayClusterSpec()
+			spec.IdlePolicy = tt.idlePolicy
+			err := ValidateRayClusterSpec(spec, nil)
+			if tt.errorMessage != "" {
+				require.EqualError(t, err, tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpec_ScalingSchedules(t *testing.T) {
+	validSchedule := func() rayv1.ScalingSchedule {
+		return rayv1.ScalingSchedule{
+			Name:            "business-hours",
+			Schedule:        "0 8 * * 1-5",
+			TimeZone:        ptr.To("Europe/Paris"),
+			DurationSeconds: 36000,
+			MinReplicas:     ptr.To[int32](2),
+		}
+	}
+
+	tests := []struct {
+		name              string
+		errorMessage      string
+		scalingSchedules  []rayv1.ScalingSchedule
+		enableAutoscaling bool
+	}{
+		{
+			name:             "Valid: scaling schedule with a time zone",
+			scalingSchedules: []rayv1.ScalingSchedule{validSchedule()},
+		},
+		{
+			name: "Valid: replicas override without autoscaling",
+			scalingSchedules: []rayv1.ScalingSchedule{func() rayv1.ScalingSchedule {
+				s := validSchedule()
+				s.MinReplicas = nil
+				s.Replicas = ptr.To[int32](3)
+				return s
+			}()},
+		},
+		{
+			name:             "Invalid: duplicate names",
+			scalingSchedules: []rayv1.ScalingSchedule{validSchedule(), validSchedule()},
+			errorMessage:     "worker group worker-group has duplicate scaling schedule business-hours",
+		},
+		{
+			name: "Invalid: cron schedule",
+			scalingSchedules: []rayv1.ScalingSchedule{func() rayv1.ScalingSchedule {
+				s := validSchedule()
+				s.Schedule = "every morning"
+				return s
+			}()},
+			errorMessage: "scaling schedule business-hours of worker group worker-group has an invalid schedule \"every morning\": " +
+				"expected exactly 5 fields, found 2: [every morning]",
+		},
+		{
+			name: "Invalid: time zone",
+			scalingSchedules: []rayv1.ScalingSchedule{func() rayv1.ScalingSchedule {
+				s := validSchedule()
+				s.TimeZone = ptr.To("Mars/Olympus_Mons")
 				return s
-			}(),
-			expectError:  true,
-			errorMessage: "resource fields should not be set in both rayStartParams and Resources for Head group; please use only one",
+			}()},
+			errorMessage: "scaling schedule business-hours of worker group worker-group has an invalid time zone \"Mars/Olympus_Mons\": " +
+				"unknown time zone Mars/Olympus_Mons",
 		},
 		{
-			name: "Invalid: Worker group has resources in both rayStartParams and .Resources",
-			spec: func() rayv1.RayClusterSpec {
-				s := createSpec()
-				s.WorkerGroupSpecs[0].RayStartParams = map[string]string{"num-gpus": "1"}
-				s.WorkerGroupSpecs[0].Resources = map[string]string{"GPU": "1"}
+			name: "Invalid: no override",
+			scalingSchedules: []rayv1.ScalingSchedule{func() rayv1.ScalingSchedule {
+				s := validSchedule()
+				s.MinReplicas = nil
 				return s
-			}(),
-			expectError:  true,
-			errorMessage: "resource fields should not be set in both rayStartParams and Resources for worker-group group; please use only one",
+			}()},
+			errorMessage: "scaling schedule business-hours of worker group worker-group must set at least one of replicas, minReplicas, and maxReplicas",
 		},
 		{
-			name: "Valid: Only rayStartParams resources are set for head",
-			spec: func() rayv1.RayClusterSpec {
-				s := createSpec()
-				s.HeadGroupSpec.RayStartParams = map[string]string{
-					"num-cpus":  "2",
-					"memory":    "4G",
-					"resources": "{\"TPU\": \"8\"}",
-				}
+			name: "Invalid: minReplicas override greater than maxReplicas",
+			scalingSchedules: []rayv1.ScalingSchedule{func() rayv1.ScalingSchedule {
+				s := validSchedule()
+				s.MinReplicas = ptr.To[int32](10)
 				return s
-			}(),
-			expectError: false,
+			}()},
+			errorMessage: "scaling schedule business-hours of worker group worker-group results in minReplicas 10 greater than maxReplicas 5",
 		},
 		{
-			name: "Valid: Only .Resources field is set for worker",
-			spec: func() rayv1.RayClusterSpec {
-				s := createSpec()
-				s.WorkerGroupSpecs[0].Resources = map[string]string{"CPU": "2", "memory": "4G", "TPU": "8"}
+			name:              "Valid: bounds override with autoscaling",
+			scalingSchedules:  []rayv1.ScalingSchedule{validSchedule()},
+			enableAutoscaling: true,
+		},
+		{
+			name: "Invalid: replicas override with autoscaling",
+			scalingSchedules: []rayv1.ScalingSchedule{func() rayv1.ScalingSchedule {
+				s := validSchedule()
+				s.Replicas = ptr.To[int32](3)
 				return s
-			}(),
-			expectError: false,
+			}()},
+			enableAutoscaling: true,
+			errorMessage:      "scaling schedule business-hours of worker group worker-group can't set replicas when autoscaling is enabled",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := ValidateRayClusterSpec(&tt.spec, nil)
-			if tt.expectError {
-				require.Error(t, err)
-				assert.EqualError(t, err, tt.errorMessage)
+			spec := createBasicRayClusterSpec()
+			spec.EnableInTreeAutoscaling = ptr.To(tt.enableAutoscaling)
+			spec.WorkerGroupSpecs = []rayv1.WorkerGroupSpec{{
+				GroupName:        "worker-group",
+				Template:         podTemplateSpec(nil, nil),
+				MinReplicas:      ptr.To[int32](0),
+				MaxReplicas:      ptr.To[int32](5),
+				ScalingSchedules: tt.scalingSchedules,
+			}}
+			err := ValidateRayClusterSpec(spec, nil)
+			if tt.errorMessage != "" {
+				require.EqualError(t, err, tt.errorMessage)
 			} else {
 				require.NoError(t, err)
 			}
@@ -770,6 +1302,20 @@ func TestValidateRayClusterSpec_Resources(t *testing.T) {
 	}
 }
 
+func TestValidateRayClusterSpec_TLSOptions(t *testing.T) {
+	spec := rayv1.RayClusterSpec{
+		HeadGroupSpec: rayv1.HeadGroupSpec{
+			Template: podTemplateSpec(nil, nil),
+		},
+		TLSOptions: &rayv1.TLSOptions{SecretName: "ray-tls"},
+	}
+	require.NoError(t, ValidateRayClusterSpec(&spec, nil))
+
+	spec.TLSOptions.SecretName = ""
+	err := ValidateRayClusterSpec(&spec, nil)
+	require.EqualError(t, err, "tlsOptions.secretName is required when tlsOptions is set")
+}
+
 func TestValidateRayClusterSpec_Labels(t *testing.T) {
 	// Util function to create a RayCluster spec.
 	createSpec := func() rayv1.RayClusterSpec {
@@ -1038,6 +1584,34 @@ func TestValidateRayJobSpec(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "the idle policy is not supported for RayJob",
+			spec: rayv1.RayJobSpec{
+				RayClusterSpec: func() *rayv1.RayClusterSpec {
+					spec := createBasicRayClusterSpec()
+					spec.IdlePolicy = &rayv1.IdlePolicy{IdleTimeoutSeconds: 3600}
+					return spec
+				}(),
+			},
+			expectError: true,
+		},
+		{
+			name: "scaling schedules are not supported for RayJob",
+			spec: rayv1.RayJobSpec{
+				RayClusterSpec: func() *rayv1.RayClusterSpec {
+					spec := createBasicRayClusterSpec()
+					spec.WorkerGroupSpecs = []rayv1.WorkerGroupSpec{{
+						GroupName:        "worker-group",
+						Template:         podTemplateSpec(nil, nil),
+						MinReplicas:      ptr.To[int32](0),
+						MaxReplicas:      ptr.To[int32](5),
+						ScalingSchedules: []rayv1.ScalingSchedule{{Name: "nightly", Schedule: "0 0 * * *", DurationSeconds: 3600, MaxReplicas: ptr.To[int32](0)}},
+					}}
+					return spec
+				}(),
+			},
+			expectError: true,
+		},
 		{
 			name: "backoffLimit must be a positive integer",
 			spec: rayv1.RayJobSpec{
@@ -1205,6 +1779,51 @@ func TestValidateRayJobSpec(t *testing.T) {
 	}
 }
 
+func TestValidateRayJobSpecWithKueueQueueName(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         rayv1.RayJobSpec
+		errorMessage string
+	}{
+		{
+			name: "valid RayJob",
+			spec: rayv1.RayJobSpec{
+				ShutdownAfterJobFinishes: true,
+				RayClusterSpec:           createBasicRayClusterSpec(),
+			},
+		},
+		{
+			name: "RayJob without shutdownAfterJobFinishes",
+			spec: rayv1.RayJobSpec{
+				RayClusterSpec: createBasicRayClusterSpec(),
+			},
+			errorMessage: "must set shutdownAfterJobFinishes to true",
+		},
+		{
+			name: "RayJob with ClusterSelector",
+			spec: rayv1.RayJobSpec{
+				ShutdownAfterJobFinishes: true,
+				ClusterSelector:          map[string]string{RayJobClusterSelectorKey: "raycluster"},
+			},
+			errorMessage: "can't use the ClusterSelector mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRayJobSpec(&rayv1.RayJob{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{KueueQueueNameLabelKey: "user-queue"}},
+				Spec:       tt.spec,
+			})
+			if tt.errorMessage != "" {
+				require.ErrorContains(t, err, tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateRayJobSpecWithFeatureGate(t *testing.T) {
 	headGroupSpecWithOneContainer := rayv1.HeadGroupSpec{
 		Template: podTemplateSpec(nil, nil),
@@ -1780,6 +2399,54 @@ func TestValidateRayJobSpecWithFeatureGate(t *testing.T) {
 	}
 }
 
+func TestValidateRayJobSpec_WaitForImagePrePull(t *testing.T) {
+	withImagePrePull := func() *rayv1.RayClusterSpec {
+		spec := createBasicRayClusterSpec()
+		spec.ImagePrePull = &rayv1.ImagePrePull{}
+		return spec
+	}
+
+	tests := []struct {
+		spec                  rayv1.RayJobSpec
+		name                  string
+		disableConditionsGate bool
+		expectError           bool
+	}{
+		{
+			name: "valid RayJob that waits for the images to be pre-pulled",
+			spec: rayv1.RayJobSpec{WaitForImagePrePull: true, RayClusterSpec: withImagePrePull()},
+		},
+		{
+			name:        "waitForImagePrePull with ClusterSelector",
+			spec:        rayv1.RayJobSpec{WaitForImagePrePull: true, ClusterSelector: map[string]string{RayJobClusterSelectorKey: "raycluster"}},
+			expectError: true,
+		},
+		{
+			name:        "waitForImagePrePull without imagePrePull",
+			spec:        rayv1.RayJobSpec{WaitForImagePrePull: true, RayClusterSpec: createBasicRayClusterSpec()},
+			expectError: true,
+		},
+		{
+			name:                  "waitForImagePrePull without the RayClusterStatusConditions feature gate",
+			spec:                  rayv1.RayJobSpec{WaitForImagePrePull: true, RayClusterSpec: withImagePrePull()},
+			disableConditionsGate: true,
+			expectError:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.RayClusterStatusConditions, !tt.disableConditionsGate)
+			err := ValidateRayJobSpec(&rayv1.RayJob{Spec: tt.spec})
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateRayJobMetadata(t *testing.T) {
 	err := ValidateRayJobMetadata(metav1.ObjectMeta{
 		Name: strings.Repeat("j", MaxRayJobNameLength+1),
@@ -1828,6 +2495,34 @@ func TestValidateRayServiceSpec(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "spec.rayClusterConfig.idlePolicy is not supported",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec: func() rayv1.RayClusterSpec {
+					spec := createBasicRayClusterSpec()
+					spec.IdlePolicy = &rayv1.IdlePolicy{IdleTimeoutSeconds: 3600}
+					return *spec
+				}(),
+			},
+			expectError: true,
+		},
+		{
+			name: "scaling schedules are not supported",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec: func() rayv1.RayClusterSpec {
+					spec := createBasicRayClusterSpec()
+					spec.WorkerGroupSpecs = []rayv1.WorkerGroupSpec{{
+						GroupName:        "worker-group",
+						Template:         podTemplateSpec(nil, nil),
+						MinReplicas:      ptr.To[int32](0),
+						MaxReplicas:      ptr.To[int32](5),
+						ScalingSchedules: []rayv1.ScalingSchedule{{Name: "nightly", Schedule: "0 0 * * *", DurationSeconds: 3600, MaxReplicas: ptr.To[int32](0)}},
+					}}
+					return *spec
+				}(),
+			},
+			expectError: true,
+		},
 		{
 			name: "spec.UpgradeSpec.Type is invalid",
 			spec: rayv1.RayServiceSpec{
@@ -1851,6 +2546,90 @@ func TestValidateRayServiceSpec(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "Spec.RevisionHistoryLimit is negative",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec:       *createBasicRayClusterSpec(),
+				RevisionHistoryLimit: ptr.To[int32](-1),
+			},
+			expectError: true,
+		},
+		{
+			name: "Spec.ServeRolloutStrategy.Type is Sequential",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec:       *createBasicRayClusterSpec(),
+				ServeRolloutStrategy: &rayv1.ServeRolloutStrategy{Type: ptr.To(rayv1.ServeRolloutSequential)},
+			},
+			expectError: false,
+		},
+		{
+			name: "Spec.ServeRolloutStrategy.Type is invalid",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec:       *createBasicRayClusterSpec(),
+				ServeRolloutStrategy: &rayv1.ServeRolloutStrategy{Type: ptr.To(rayv1.ServeRolloutStrategyType("invalid"))},
+			},
+			expectError: true,
+		},
+		{
+			name: "Spec.RevisionHistoryLimit is 0",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec:       *createBasicRayClusterSpec(),
+				RevisionHistoryLimit: ptr.To[int32](0),
+			},
+			expectError: false,
+		},
+		{
+			name: "spec.serveConfigV2From references a ConfigMap",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec: *createBasicRayClusterSpec(),
+				ServeConfigV2From: &rayv1.ServeConfigV2Source{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+						Key:                  "serveConfigV2",
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "spec.serveConfigV2 and spec.serveConfigV2From are both set",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec: *createBasicRayClusterSpec(),
+				ServeConfigV2:  "applications: []",
+				ServeConfigV2From: &rayv1.ServeConfigV2Source{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+						Key:                  "serveConfigV2",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "spec.serveConfigV2From references both a ConfigMap and a Secret",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec: *createBasicRayClusterSpec(),
+				ServeConfigV2From: &rayv1.ServeConfigV2Source{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+						Key:                  "serveConfigV2",
+					},
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+						Key:                  "serveConfigV2",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "spec.serveConfigV2From references neither a ConfigMap nor a Secret",
+			spec: rayv1.RayServiceSpec{
+				RayClusterSpec:    *createBasicRayClusterSpec(),
+				ServeConfigV2From: &rayv1.ServeConfigV2Source{},
+			},
+			expectError: true,
+		},
 		{
 			name: "RayService does not support K8s token auth mode",
 			spec: rayv1.RayServiceSpec{
@@ -2072,6 +2851,31 @@ func TestValidateClusterUpgradeOptions(t *testing.T) {
 	}
 }
 
+func TestValidateRayServiceSpec_SequentialServeRolloutWithIncrementalUpgrade(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.RayServiceIncrementalUpgrade, true)
+
+	rayClusterSpec := *createBasicRayClusterSpec()
+	rayClusterSpec.EnableInTreeAutoscaling = ptr.To(true)
+	rayService := &rayv1.RayService{
+		Spec: rayv1.RayServiceSpec{
+			RayClusterSpec: rayClusterSpec,
+			UpgradeStrategy: &rayv1.RayServiceUpgradeStrategy{
+				Type: ptr.To(rayv1.RayServiceNewClusterWithIncrementalUpgrade),
+				ClusterUpgradeOptions: &rayv1.ClusterUpgradeOptions{
+					MaxSurgePercent:  ptr.To(int32(50)),
+					StepSizePercent:  ptr.To(int32(50)),
+					IntervalSeconds:  ptr.To(int32(10)),
+					GatewayClassName: "istio",
+				},
+			},
+		},
+	}
+	require.NoError(t, ValidateRayServiceSpec(rayService))
+
+	rayService.Spec.ServeRolloutStrategy = &rayv1.ServeRolloutStrategy{Type: ptr.To(rayv1.ServeRolloutSequential)}
+	require.ErrorContains(t, ValidateRayServiceSpec(rayService), "is not supported with Spec.UpgradeStrategy.Type")
+}
+
 func TestValidateRayClusterSpec_IdleTimeoutSeconds(t *testing.T) {
 	// Util function to create a RayCluster spec.
 	createSpec := func() rayv1.RayClusterSpec {
@@ -2429,6 +3233,63 @@ func TestValidateRayCronJobSpec(t *testing.T) {
 	}
 }
 
+func TestValidateRayWorkerGroupScalerSpec(t *testing.T) {
+	tests := []struct {
+		spec        rayv1.RayWorkerGroupScalerSpec
+		name        string
+		errorMsg    string
+		expectError bool
+	}{
+		{
+			name: "Valid spec",
+			spec: rayv1.RayWorkerGroupScalerSpec{
+				RayClusterName: "raycluster-sample",
+				GroupName:      "small-group",
+				Replicas:       ptr.To[int32](3),
+			},
+			expectError: false,
+		},
+		{
+			name: "Missing rayClusterName",
+			spec: rayv1.RayWorkerGroupScalerSpec{
+				GroupName: "small-group",
+			},
+			expectError: true,
+			errorMsg:    "rayClusterName is required",
+		},
+		{
+			name: "Missing groupName",
+			spec: rayv1.RayWorkerGroupScalerSpec{
+				RayClusterName: "raycluster-sample",
+			},
+			expectError: true,
+			errorMsg:    "groupName is required",
+		},
+		{
+			name: "Negative replicas",
+			spec: rayv1.RayWorkerGroupScalerSpec{
+				RayClusterName: "raycluster-sample",
+				GroupName:      "small-group",
+				Replicas:       ptr.To[int32](-1),
+			},
+			expectError: true,
+			errorMsg:    "replicas must be non-negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRayWorkerGroupScalerSpec(&rayv1.RayWorkerGroupScaler{Spec: tc.spec})
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateRayClusterUpgradeOptions(t *testing.T) {
 	tests := []struct {
 		upgradeStrategy   *rayv1.RayClusterUpgradeStrategy
@@ -2726,6 +3587,72 @@ func TestValidateRayClusterSpec_Auth(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "token rotation with generated secret",
+			authOptions: &rayv1.AuthOptions{
+				Mode:                            rayv1.AuthModeToken,
+				TokenRotationPeriodSeconds:      ptr.To[int32](86400),
+				TokenRotationGracePeriodSeconds: ptr.To[int32](600),
+			},
+			expectError: false,
+		},
+		{
+			name: "token rotation period is not positive",
+			authOptions: &rayv1.AuthOptions{
+				Mode:                       rayv1.AuthModeToken,
+				TokenRotationPeriodSeconds: ptr.To[int32](0),
+			},
+			expectError: true,
+			errorMsg:    "authOptions.tokenRotationPeriodSeconds must be positive, got 0",
+		},
+		{
+			name: "token rotation grace period without rotation period",
+			authOptions: &rayv1.AuthOptions{
+				Mode:                            rayv1.AuthModeToken,
+				TokenRotationGracePeriodSeconds: ptr.To[int32](600),
+			},
+			expectError: true,
+			errorMsg:    "authOptions.tokenRotationGracePeriodSeconds is set but authOptions.tokenRotationPeriodSeconds is not",
+		},
+		{
+			name: "token rotation with negative grace period",
+			authOptions: &rayv1.AuthOptions{
+				Mode:                            rayv1.AuthModeToken,
+				TokenRotationPeriodSeconds:      ptr.To[int32](86400),
+				TokenRotationGracePeriodSeconds: ptr.To[int32](-1),
+			},
+			expectError: true,
+			errorMsg:    "authOptions.tokenRotationGracePeriodSeconds must be non-negative, got -1",
+		},
+		{
+			name: "token rotation with user-provided secret",
+			authOptions: &rayv1.AuthOptions{
+				Mode:                       rayv1.AuthModeToken,
+				SecretName:                 ptr.To("my-secret"),
+				TokenRotationPeriodSeconds: ptr.To[int32](86400),
+			},
+			expectError: true,
+			errorMsg:    "authOptions.tokenRotationPeriodSeconds is not supported when authOptions.secretName is set",
+		},
+		{
+			name: "token rotation with K8s token auth",
+			authOptions: &rayv1.AuthOptions{
+				Mode:                       rayv1.AuthModeToken,
+				EnableK8sTokenAuth:         ptr.To(true),
+				TokenRotationPeriodSeconds: ptr.To[int32](86400),
+			},
+			expectError: true,
+			errorMsg:    "authOptions.tokenRotationPeriodSeconds is not supported when authOptions.enableK8sTokenAuth is enabled",
+		},
+		{
+			name: "token rotation without token mode",
+			authOptions: &rayv1.AuthOptions{
+				Mode:                       rayv1.AuthModeDisabled,
+				TokenRotationPeriodSeconds: ptr.To[int32](86400),
+			},
+			expectError: true,
+			errorMsg:    "authOptions.tokenRotationPeriodSeconds is set but authOptions.mode not set to 'token'",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+const (
+	DefaultFallbackUnschedulableTimeoutSeconds = 300
+	DefaultFallbackMaxPreemptions              = 3
+	DefaultFallbackPreemptionWindowSeconds     = 600
+	DefaultFallbackDurationSeconds             = 1800
+)
+
+// GetFallbackUnschedulableTimeout returns how long a Pod of a worker group can stay unschedulable before it falls back.
+func GetFallbackUnschedulableTimeout(fallback rayv1.WorkerGroupFallback) time.Duration {
+	return time.Duration(ptr.Deref(fallback.UnschedulableTimeoutSeconds, DefaultFallbackUnschedulableTimeoutSeconds)) * time.Second
+}
+
+// GetFallbackMaxPreemptions returns the number of preemptions within the preemption window that trigger the fallback.
+func GetFallbackMaxPreemptions(fallback rayv1.WorkerGroupFallback) int32 {
+	return ptr.Deref(fallback.MaxPreemptions, DefaultFallbackMaxPreemptions)
+}
+
+// GetFallbackPreemptionWindow returns the length of the window in which preemptions count toward the fallback.
+func GetFallbackPreemptionWindow(fallback rayv1.WorkerGroupFallback) time.Duration {
+	return time.Duration(ptr.Deref(fallback.PreemptionWindowSeconds, DefaultFallbackPreemptionWindowSeconds)) * time.Second
+}
+
+// GetFallbackDuration returns how long the fallback of a worker group lasts.
+func GetFallbackDuration(fallback rayv1.WorkerGroupFallback) time.Duration {
+	return time.Duration(ptr.Deref(fallback.DurationSeconds, DefaultFallbackDurationSeconds)) * time.Second
+}
+
+// NewActiveFallback returns the status of a fallback of the worker group to its fallback group that starts at `now`.
+func NewActiveFallback(worker rayv1.WorkerGroupSpec, reason string, now time.Time) *rayv1.ActiveFallback {
+	return &rayv1.ActiveFallback{
+		GroupName: worker.Fallback.GroupName,
+		Reason:    reason,
+		StartTime: metav1.NewTime(now),
+		EndTime:   metav1.NewTime(now.Add(GetFallbackDuration(*worker.Fallback))),
+	}
+}
+
+// ApplyFallback moves the replica settings of the worker group to its fallback group. It must only be applied to the
+// in-memory RayCluster, so that the replica settings of both worker groups are used again once the fallback ends.
+func ApplyFallback(worker *rayv1.WorkerGroupSpec, fallbackWorker *rayv1.WorkerGroupSpec) {
+	replicas := GetWorkerGroupDesiredReplicas(*worker) / max(worker.NumOfHosts, 1)
+	fallbackWorker.Replicas = ptr.To(addReplicas(ptr.Deref(fallbackWorker.Replicas, 0), replicas))
+	fallbackWorker.MinReplicas = ptr.To(addReplicas(ptr.Deref(fallbackWorker.MinReplicas, 0), ptr.Deref(worker.MinReplicas, 0)))
+	// A worker group without maxReplicas has no maximum.
+	if fallbackWorker.MaxReplicas != nil {
+		fallbackWorker.MaxReplicas = ptr.To(addReplicas(*fallbackWorker.MaxReplicas, ptr.Deref(worker.MaxReplicas, math.MaxInt32)))
+	}
+	worker.Replicas = ptr.To[int32](0)
+	worker.MinReplicas = ptr.To[int32](0)
+	worker.MaxReplicas = ptr.To[int32](0)
+}
+
+// addReplicas adds two replica settings without overflowing int32.
+func addReplicas(a, b int32) int32 {
+	return int32(min(int64(a)+int64(b), math.MaxInt32))
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+func TestNewActiveFallback(t *testing.T) {
+	worker := rayv1.WorkerGroupSpec{
+		GroupName: "spot",
+		Fallback:  &rayv1.WorkerGroupFallback{GroupName: "on-demand", DurationSeconds: ptr.To[int32](600)},
+	}
+	now := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+	active := NewActiveFallback(worker, "spot capacity is unavailable", now)
+	assert.Equal(t, "on-demand", active.GroupName)
+	assert.Equal(t, "spot capacity is unavailable", active.Reason)
+	assert.Equal(t, metav1.NewTime(now), active.StartTime)
+	assert.Equal(t, metav1.NewTime(now.Add(10*time.Minute)), active.EndTime)
+}
+
+func TestApplyFallback(t *testing.T) {
+	newWorkers := func() (rayv1.WorkerGroupSpec, rayv1.WorkerGroupSpec) {
+		worker := rayv1.WorkerGroupSpec{
+			GroupName:   "spot",
+			Replicas:    ptr.To[int32](3),
+			MinReplicas: ptr.To[int32](1),
+			MaxReplicas: ptr.To[int32](10),
+			NumOfHosts:  1,
+			Fallback:    &rayv1.WorkerGroupFallback{GroupName: "on-demand"},
+		}
+		fallbackWorker := rayv1.WorkerGroupSpec{
+			GroupName:   "on-demand",
+			Replicas:    ptr.To[int32](1),
+			MinReplicas: ptr.To[int32](1),
+			MaxReplicas: ptr.To[int32](math.MaxInt32),
+			NumOfHosts:  1,
+		}
+		return worker, fallbackWorker
+	}
+
+	worker, fallbackWorker := newWorkers()
+	ApplyFallback(&worker, &fallbackWorker)
+	assert.Equal(t, int32(0), *worker.Replicas)
+	assert.Equal(t, int32(0), *worker.MinReplicas)
+	assert.Equal(t, int32(0), *worker.MaxReplicas)
+	assert.Equal(t, int32(4), *fallbackWorker.Replicas)
+	assert.Equal(t, int32(2), *fallbackWorker.MinReplicas)
+	// The maxReplicas don't overflow.
+	assert.Equal(t, int32(math.MaxInt32), *fallbackWorker.MaxReplicas)
+
+	// Unset replica settings keep their meaning.
+	worker, fallbackWorker = newWorkers()
+	worker.MinReplicas = nil
+	worker.MaxReplicas = nil
+	fallbackWorker.MinReplicas = nil
+	fallbackWorker.MaxReplicas = nil
+	ApplyFallback(&worker, &fallbackWorker)
+	assert.Equal(t, int32(4), *fallbackWorker.Replicas)
+	assert.Equal(t, int32(0), *fallbackWorker.MinReplicas)
+	assert.Nil(t, fallbackWorker.MaxReplicas)
+}
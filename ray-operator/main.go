@@ -35,6 +35,7 @@ import (
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/metrics"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils/dashboardclient"
 	"github.com/ray-project/kuberay/ray-operator/pkg/features"
 	webhooks "github.com/ray-project/kuberay/ray-operator/pkg/webhooks/v1"
 )
@@ -58,6 +59,7 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var leaderElectionNamespace string
+	var operatorNamespace string
 	var probeAddr string
 	var reconcileConcurrency int
 	var watchNamespace string
@@ -81,6 +83,8 @@ func main() {
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	flag.StringVar(&leaderElectionNamespace, "leader-election-namespace", "",
 		"Namespace where the leader election resource lives. Defaults to the pod namespace if not set.")
+	flag.StringVar(&operatorNamespace, "operator-namespace", "",
+		"Namespace that the operator runs in, which NetworkPolicies allow to reach the dashboard. Defaults to the pod namespace if not set.")
 	flag.IntVar(&reconcileConcurrency, "reconcile-concurrency", configapi.DefaultReconcileConcurrency, "max concurrency for reconciling")
 	flag.StringVar(
 		&watchNamespace,
@@ -126,6 +130,7 @@ func main() {
 		config.ProbeAddr = probeAddr
 		config.EnableLeaderElection = &enableLeaderElection
 		config.LeaderElectionNamespace = leaderElectionNamespace
+		config.OperatorNamespace = operatorNamespace
 		config.ReconcileConcurrency = reconcileConcurrency
 		config.WatchNamespace = watchNamespace
 		config.LogFile = logFile
@@ -183,10 +188,22 @@ func main() {
 
 	// validate the batch scheduler configs,
 	// exit with error if the configs is invalid.
-	if err := configapi.ValidateBatchSchedulerConfig(setupLog, config); err != nil {
+	if err := configapi.ValidateBatchSchedulerConfig(setupLog, config, batchscheduler.RegisteredBatchSchedulers()); err != nil {
 		exitOnError(err, "batch scheduler configs validation failed")
 	}
 
+	if err := configapi.ValidateDashboardClientConfig(config); err != nil {
+		exitOnError(err, "dashboard client configs validation failed")
+	}
+
+	if err := configapi.ValidateAsyncJobInfoQueryConfig(config); err != nil {
+		exitOnError(err, "async job info query configs validation failed")
+	}
+
+	if err := configapi.ValidateAcceleratorsConfig(config); err != nil {
+		exitOnError(err, "accelerators configs validation failed")
+	}
+
 	if err := utilfeature.DefaultMutableFeatureGate.Set(featureGates); err != nil {
 		exitOnError(err, "Unable to set flag gates for known features")
 	}
@@ -250,6 +267,13 @@ func main() {
 	var rayJobMetricsManager *metrics.RayJobMetricsManager
 	var rayServiceMetricsManager *metrics.RayServiceMetricsManager
 	var batchSchedulerManager *batchscheduler.SchedulerManager
+	var dashboardCache *dashboardclient.RayDashboardCache
+	clientProvider := configapi.NewClientProvider(config)
+	if features.Enabled(features.AsyncJobInfoQuery) {
+		dashboardCache, err = dashboardclient.NewRayDashboardCache(config.GetRayDashboardCacheOptions())
+		exitOnError(err, "unable to create dashboard cache")
+		exitOnError(mgr.Add(dashboardCache), "unable to add dashboard cache to manager")
+	}
 	if config.EnableMetrics {
 		mgrClient := mgr.GetClient()
 		rayClusterMetricsManager = metrics.NewRayClusterMetricsManager(ctx, mgrClient)
@@ -259,7 +283,11 @@ func main() {
 			rayClusterMetricsManager,
 			rayJobMetricsManager,
 			rayServiceMetricsManager,
+			metrics.NewDashboardClientMetricsManager(clientProvider.CircuitBreakers),
 		)
+		if dashboardCache != nil {
+			ctrlmetrics.Registry.MustRegister(metrics.NewDashboardCacheMetricsManager(dashboardCache))
+		}
 	}
 
 	batchSchedulerManager, err = batchscheduler.NewSchedulerManager(ctx, config, restConfig, mgr.GetClient())
@@ -272,6 +300,13 @@ func main() {
 	isOpenShift, err := utils.IsOpenShiftCluster(restConfig)
 	exitOnError(err, "unable to detect cluster type (OpenShift vs Kubernetes)")
 
+	if config.OperatorNamespace == "" {
+		config.OperatorNamespace = utils.GetOperatorNamespace()
+	}
+	if config.OperatorNamespace == "" {
+		setupLog.Info("The operator namespace is unknown, so RayClusters with network isolation don't get a NetworkPolicy. Set operatorNamespace in the operator configuration.")
+	}
+
 	rayClusterOptions := ray.RayClusterReconcilerOptions{
 		HeadSidecarContainers:    config.HeadSidecarContainers,
 		WorkerSidecarContainers:  config.WorkerSidecarContainers,
@@ -280,18 +315,23 @@ func main() {
 		RayClusterMetricsManager: rayClusterMetricsManager,
 		BatchSchedulerManager:    batchSchedulerManager,
 		DefaultContainerEnvs:     config.DefaultContainerEnvs,
+		DeviceClassResourceNames: utils.GetDeviceClassResourceNames(config.DeviceClassResourceNames),
+		Accelerators:             utils.NewAccelerators(config.GetAccelerators()),
+		DashboardCircuitBreakers: clientProvider.CircuitBreakers,
+		OperatorNamespace:        config.OperatorNamespace,
 	}
-	exitOnError(ray.NewReconciler(mgr, rayClusterOptions).SetupWithManager(mgr, config.ReconcileConcurrency),
+	exitOnError(ray.NewReconciler(ctx, mgr, rayClusterOptions, clientProvider).SetupWithManager(mgr, config.ReconcileConcurrency),
 		"unable to create controller", "controller", "RayCluster")
 
-	exitOnError(ray.NewRayServiceReconciler(ctx, mgr, config).SetupWithManager(mgr, config.ReconcileConcurrency),
+	exitOnError(ray.NewRayServiceReconciler(ctx, mgr, clientProvider).SetupWithManager(mgr, config.ReconcileConcurrency),
 		"unable to create controller", "controller", "RayService")
 
 	rayJobOptions := ray.RayJobReconcilerOptions{
 		RayJobMetricsManager:  rayJobMetricsManager,
 		BatchSchedulerManager: batchSchedulerManager,
+		DashboardCache:        dashboardCache,
 	}
-	exitOnError(ray.NewRayJobReconciler(ctx, mgr, rayJobOptions, config).SetupWithManager(mgr, config.ReconcileConcurrency),
+	exitOnError(ray.NewRayJobReconciler(ctx, mgr, rayJobOptions, clientProvider).SetupWithManager(mgr, config.ReconcileConcurrency),
 		"unable to create controller", "controller", "RayJob")
 
 	if os.Getenv("ENABLE_WEBHOOKS") == "true" {
@@ -306,6 +346,14 @@ func main() {
 	} else {
 		setupLog.Info("RayCronJob feature gate is disabled, skipping RayCronJob controller setup")
 	}
+
+	if features.Enabled(features.RayWorkerGroupScaler) {
+		setupLog.Info("RayWorkerGroupScaler feature gate is enabled, starting RayWorkerGroupScaler controller")
+		exitOnError(ray.NewRayWorkerGroupScalerReconciler(mgr).SetupWithManager(mgr, config.ReconcileConcurrency),
+			"unable to create controller", "controller", "RayWorkerGroupScaler")
+	} else {
+		setupLog.Info("RayWorkerGroupScaler feature gate is disabled, skipping RayWorkerGroupScaler controller setup")
+	}
 	// +kubebuilder:scaffold:builder
 
 	exitOnError(mgr.AddHealthzCheck("healthz", healthz.Ping), "unable to set up health check")
@@ -0,0 +1,60 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ActiveFallbackApplyConfiguration represents a declarative configuration of the ActiveFallback type for use
+// with apply.
+//
+// ActiveFallback describes a fallback of a worker group to another worker group.
+type ActiveFallbackApplyConfiguration struct {
+	// GroupName is the name of the worker group that took over the replicas.
+	GroupName *string `json:"groupName,omitempty"`
+	// Reason is the reason why the worker group fell back.
+	Reason *string `json:"reason,omitempty"`
+	// StartTime is the time at which the fallback started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time at which the fallback ends.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+// ActiveFallbackApplyConfiguration constructs a declarative configuration of the ActiveFallback type for use with
+// apply.
+func ActiveFallback() *ActiveFallbackApplyConfiguration {
+	return &ActiveFallbackApplyConfiguration{}
+}
+
+// WithGroupName sets the GroupName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GroupName field is set to the value of the last call.
+func (b *ActiveFallbackApplyConfiguration) WithGroupName(value string) *ActiveFallbackApplyConfiguration {
+	b.GroupName = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *ActiveFallbackApplyConfiguration) WithReason(value string) *ActiveFallbackApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *ActiveFallbackApplyConfiguration) WithStartTime(value metav1.Time) *ActiveFallbackApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithEndTime sets the EndTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EndTime field is set to the value of the last call.
+func (b *ActiveFallbackApplyConfiguration) WithEndTime(value metav1.Time) *ActiveFallbackApplyConfiguration {
+	b.EndTime = &value
+	return b
+}
@@ -0,0 +1,72 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ActiveScalingScheduleApplyConfiguration represents a declarative configuration of the ActiveScalingSchedule type for use
+// with apply.
+//
+// ActiveScalingSchedule describes the window of a scaling schedule that is applied to a worker group.
+type ActiveScalingScheduleApplyConfiguration struct {
+	// Name is the name of the scaling schedule.
+	Name *string `json:"name,omitempty"`
+	// StartTime is the time at which the window started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time at which the window ends.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// MinReplicas is the minimum number of replicas of the worker group during the window. Autoscalers must not
+	// scale the worker group below it.
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of replicas of the worker group during the window. Autoscalers must not
+	// scale the worker group above it. It is unset if the worker group has no maximum.
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// ActiveScalingScheduleApplyConfiguration constructs a declarative configuration of the ActiveScalingSchedule type for use with
+// apply.
+func ActiveScalingSchedule() *ActiveScalingScheduleApplyConfiguration {
+	return &ActiveScalingScheduleApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ActiveScalingScheduleApplyConfiguration) WithName(value string) *ActiveScalingScheduleApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *ActiveScalingScheduleApplyConfiguration) WithStartTime(value metav1.Time) *ActiveScalingScheduleApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithEndTime sets the EndTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EndTime field is set to the value of the last call.
+func (b *ActiveScalingScheduleApplyConfiguration) WithEndTime(value metav1.Time) *ActiveScalingScheduleApplyConfiguration {
+	b.EndTime = &value
+	return b
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *ActiveScalingScheduleApplyConfiguration) WithMinReplicas(value int32) *ActiveScalingScheduleApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *ActiveScalingScheduleApplyConfiguration) WithMaxReplicas(value int32) *ActiveScalingScheduleApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
@@ -29,6 +29,15 @@ type AuthOptionsApplyConfiguration struct {
 	// Supported values are "disabled" and "token".
 	// Defaults to "token".
 	Mode *rayv1.AuthMode `json:"mode,omitempty"`
+	// TokenRotationPeriodSeconds is the period after which KubeRay rotates the auth token it generates.
+	// Ray accepts a single token, so KubeRay recreates all the Ray Pods at once after a rotation, starting
+	// with the head Pod, which interrupts the workloads running on the RayCluster.
+	// Rotation is not supported when SecretName is set or EnableK8sTokenAuth is true.
+	TokenRotationPeriodSeconds *int32 `json:"tokenRotationPeriodSeconds,omitempty"`
+	// TokenRotationGracePeriodSeconds is the minimum time the previous token is kept in the Secret after a
+	// rotation, so that clients that still use it, including KubeRay itself, can fall back to it. The previous
+	// token is kept longer while any Ray Pod still uses it. Defaults to 600.
+	TokenRotationGracePeriodSeconds *int32 `json:"tokenRotationGracePeriodSeconds,omitempty"`
 }
 
 // AuthOptionsApplyConfiguration constructs a declarative configuration of the AuthOptions type for use with
@@ -60,3 +69,19 @@ func (b *AuthOptionsApplyConfiguration) WithMode(value rayv1.AuthMode) *AuthOpti
 	b.Mode = &value
 	return b
 }
+
+// WithTokenRotationPeriodSeconds sets the TokenRotationPeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenRotationPeriodSeconds field is set to the value of the last call.
+func (b *AuthOptionsApplyConfiguration) WithTokenRotationPeriodSeconds(value int32) *AuthOptionsApplyConfiguration {
+	b.TokenRotationPeriodSeconds = &value
+	return b
+}
+
+// WithTokenRotationGracePeriodSeconds sets the TokenRotationGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenRotationGracePeriodSeconds field is set to the value of the last call.
+func (b *AuthOptionsApplyConfiguration) WithTokenRotationGracePeriodSeconds(value int32) *AuthOptionsApplyConfiguration {
+	b.TokenRotationGracePeriodSeconds = &value
+	return b
+}
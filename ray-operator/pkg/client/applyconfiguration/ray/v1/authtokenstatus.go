@@ -0,0 +1,51 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AuthTokenStatusApplyConfiguration represents a declarative configuration of the AuthTokenStatus type for use
+// with apply.
+//
+// AuthTokenStatus records the rotation of the auth token generated by KubeRay.
+type AuthTokenStatusApplyConfiguration struct {
+	// LastRotationTime is the time when the auth token was last rotated.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// NextRotationTime is the time when the auth token will be rotated next.
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+	// PreviousTokenExpirationTime is the time after which the previous auth token is removed from the Secret
+	// once no Ray Pod uses it. It is unset when there is no previous token.
+	PreviousTokenExpirationTime *metav1.Time `json:"previousTokenExpirationTime,omitempty"`
+}
+
+// AuthTokenStatusApplyConfiguration constructs a declarative configuration of the AuthTokenStatus type for use with
+// apply.
+func AuthTokenStatus() *AuthTokenStatusApplyConfiguration {
+	return &AuthTokenStatusApplyConfiguration{}
+}
+
+// WithLastRotationTime sets the LastRotationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRotationTime field is set to the value of the last call.
+func (b *AuthTokenStatusApplyConfiguration) WithLastRotationTime(value metav1.Time) *AuthTokenStatusApplyConfiguration {
+	b.LastRotationTime = &value
+	return b
+}
+
+// WithNextRotationTime sets the NextRotationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextRotationTime field is set to the value of the last call.
+func (b *AuthTokenStatusApplyConfiguration) WithNextRotationTime(value metav1.Time) *AuthTokenStatusApplyConfiguration {
+	b.NextRotationTime = &value
+	return b
+}
+
+// WithPreviousTokenExpirationTime sets the PreviousTokenExpirationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreviousTokenExpirationTime field is set to the value of the last call.
+func (b *AuthTokenStatusApplyConfiguration) WithPreviousTokenExpirationTime(value metav1.Time) *AuthTokenStatusApplyConfiguration {
+	b.PreviousTokenExpirationTime = &value
+	return b
+}
@@ -2,15 +2,28 @@
 
 package v1
 
+import (
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
 // GcsFaultToleranceOptionsApplyConfiguration represents a declarative configuration of the GcsFaultToleranceOptions type for use
 // with apply.
 //
 // GcsFaultToleranceOptions contains configs for GCS FT
 type GcsFaultToleranceOptionsApplyConfiguration struct {
+	// StorageBackend is the type of external storage used for GCS fault tolerance.
+	// Defaults to Redis.
+	StorageBackend           *rayv1.GcsStorageBackendType       `json:"storageBackend,omitempty"`
 	RedisUsername            *RedisCredentialApplyConfiguration `json:"redisUsername,omitempty"`
 	RedisPassword            *RedisCredentialApplyConfiguration `json:"redisPassword,omitempty"`
 	ExternalStorageNamespace *string                            `json:"externalStorageNamespace,omitempty"`
-	RedisAddress             *string                            `json:"redisAddress,omitempty"`
+	// RedisAddress is the address of the Redis server. It is required when the storage backend is Redis,
+	// unless ManagedRedis is set.
+	RedisAddress *string `json:"redisAddress,omitempty"`
+	// ManagedRedis makes KubeRay create a Redis StatefulSet, Service, and password Secret owned by the
+	// RayCluster, and connect the head Pod to it. It can't be used together with RedisAddress,
+	// RedisUsername, or RedisPassword.
+	ManagedRedis *ManagedRedisOptionsApplyConfiguration `json:"managedRedis,omitempty"`
 }
 
 // GcsFaultToleranceOptionsApplyConfiguration constructs a declarative configuration of the GcsFaultToleranceOptions type for use with
@@ -19,6 +32,14 @@ func GcsFaultToleranceOptions() *GcsFaultToleranceOptionsApplyConfiguration {
 	return &GcsFaultToleranceOptionsApplyConfiguration{}
 }
 
+// WithStorageBackend sets the StorageBackend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageBackend field is set to the value of the last call.
+func (b *GcsFaultToleranceOptionsApplyConfiguration) WithStorageBackend(value rayv1.GcsStorageBackendType) *GcsFaultToleranceOptionsApplyConfiguration {
+	b.StorageBackend = &value
+	return b
+}
+
 // WithRedisUsername sets the RedisUsername field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RedisUsername field is set to the value of the last call.
@@ -50,3 +71,11 @@ func (b *GcsFaultToleranceOptionsApplyConfiguration) WithRedisAddress(value stri
 	b.RedisAddress = &value
 	return b
 }
+
+// WithManagedRedis sets the ManagedRedis field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ManagedRedis field is set to the value of the last call.
+func (b *GcsFaultToleranceOptionsApplyConfiguration) WithManagedRedis(value *ManagedRedisOptionsApplyConfiguration) *GcsFaultToleranceOptionsApplyConfiguration {
+	b.ManagedRedis = value
+	return b
+}
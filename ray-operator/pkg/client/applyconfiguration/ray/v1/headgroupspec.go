@@ -30,6 +30,12 @@ type HeadGroupSpecApplyConfiguration struct {
 	RayStartParams map[string]string `json:"rayStartParams,omitempty"`
 	// ServiceType is Kubernetes service type of the head service. it will be used by the workers to connect to the head pod
 	ServiceType *apicorev1.ServiceType `json:"serviceType,omitempty"`
+	// PodDisruptionBudget configures the PodDisruptionBudget that KubeRay creates for the head Pod.
+	// No PodDisruptionBudget is created unless this field is set. Note that `minAvailable: 1` prevents
+	// the head Pod from ever being evicted, which blocks node drains until the RayCluster is deleted.
+	PodDisruptionBudget *PodDisruptionBudgetSpecApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	// VolumeClaimTemplates are templates of the PersistentVolumeClaims that KubeRay creates for the head Pod.
+	VolumeClaimTemplates []VolumeClaimTemplateApplyConfiguration `json:"volumeClaimTemplates,omitempty"`
 }
 
 // HeadGroupSpecApplyConfiguration constructs a declarative configuration of the HeadGroupSpec type for use with
@@ -111,3 +117,24 @@ func (b *HeadGroupSpecApplyConfiguration) WithServiceType(value apicorev1.Servic
 	b.ServiceType = &value
 	return b
 }
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *HeadGroupSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetSpecApplyConfiguration) *HeadGroupSpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
+// WithVolumeClaimTemplates adds the given value to the VolumeClaimTemplates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeClaimTemplates field.
+func (b *HeadGroupSpecApplyConfiguration) WithVolumeClaimTemplates(values ...*VolumeClaimTemplateApplyConfiguration) *HeadGroupSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumeClaimTemplates")
+		}
+		b.VolumeClaimTemplates = append(b.VolumeClaimTemplates, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,55 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// IdlePolicyApplyConfiguration represents a declarative configuration of the IdlePolicy type for use
+// with apply.
+//
+// IdlePolicy defines when and how KubeRay reclaims an idle RayCluster. A RayCluster is idle when it has no
+// pending or running Ray jobs, no alive actors, and no logical CPU, GPU, or custom resources in use, as reported
+// by the Ray dashboard.
+type IdlePolicyApplyConfiguration struct {
+	// Action is the action taken once the RayCluster has been idle for IdleTimeoutSeconds. Suspend sets
+	// `spec.suspend` to true, so that the RayCluster can be resumed later. Delete deletes the RayCluster.
+	// Defaults to Suspend.
+	Action *rayv1.IdleAction `json:"action,omitempty"`
+	// IdleTimeoutSeconds is how long the RayCluster must be idle before the action is taken.
+	IdleTimeoutSeconds *int32 `json:"idleTimeoutSeconds,omitempty"`
+	// WarningPeriodSeconds is how long before the action a Warning event is emitted on the RayCluster.
+	// Defaults to 300.
+	WarningPeriodSeconds *int32 `json:"warningPeriodSeconds,omitempty"`
+}
+
+// IdlePolicyApplyConfiguration constructs a declarative configuration of the IdlePolicy type for use with
+// apply.
+func IdlePolicy() *IdlePolicyApplyConfiguration {
+	return &IdlePolicyApplyConfiguration{}
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *IdlePolicyApplyConfiguration) WithAction(value rayv1.IdleAction) *IdlePolicyApplyConfiguration {
+	b.Action = &value
+	return b
+}
+
+// WithIdleTimeoutSeconds sets the IdleTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleTimeoutSeconds field is set to the value of the last call.
+func (b *IdlePolicyApplyConfiguration) WithIdleTimeoutSeconds(value int32) *IdlePolicyApplyConfiguration {
+	b.IdleTimeoutSeconds = &value
+	return b
+}
+
+// WithWarningPeriodSeconds sets the WarningPeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WarningPeriodSeconds field is set to the value of the last call.
+func (b *IdlePolicyApplyConfiguration) WithWarningPeriodSeconds(value int32) *IdlePolicyApplyConfiguration {
+	b.WarningPeriodSeconds = &value
+	return b
+}
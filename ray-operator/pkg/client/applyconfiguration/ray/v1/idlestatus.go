@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IdleStatusApplyConfiguration represents a declarative configuration of the IdleStatus type for use
+// with apply.
+//
+// IdleStatus records the activity of a RayCluster with an idle policy.
+type IdleStatusApplyConfiguration struct {
+	// LastActivityTime is the last time the RayCluster was observed to be in use.
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty"`
+	// WarningTime is the time the Warning event about the upcoming idle action was emitted. It's reset
+	// when the RayCluster is in use again.
+	WarningTime *metav1.Time `json:"warningTime,omitempty"`
+}
+
+// IdleStatusApplyConfiguration constructs a declarative configuration of the IdleStatus type for use with
+// apply.
+func IdleStatus() *IdleStatusApplyConfiguration {
+	return &IdleStatusApplyConfiguration{}
+}
+
+// WithLastActivityTime sets the LastActivityTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastActivityTime field is set to the value of the last call.
+func (b *IdleStatusApplyConfiguration) WithLastActivityTime(value metav1.Time) *IdleStatusApplyConfiguration {
+	b.LastActivityTime = &value
+	return b
+}
+
+// WithWarningTime sets the WarningTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WarningTime field is set to the value of the last call.
+func (b *IdleStatusApplyConfiguration) WithWarningTime(value metav1.Time) *IdleStatusApplyConfiguration {
+	b.WarningTime = &value
+	return b
+}
@@ -0,0 +1,43 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ImagePrePullApplyConfiguration represents a declarative configuration of the ImagePrePull type for use
+// with apply.
+//
+// ImagePrePull configures the DaemonSets that pre-pull the images of a RayCluster. The DaemonSet Pods of a group use its
+// nodeSelector, node affinity, tolerations, and image pull secrets. The containers of the images run a static binary
+// from the helper image, so the images don't need to provide any command.
+type ImagePrePullApplyConfiguration struct {
+	// Resources are the compute resources of the containers of the DaemonSet Pods. Defaults to 10m CPU and 32Mi memory.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// HelperImage is the image whose statically linked `/bin/busybox` binary is copied into the DaemonSet Pods and run
+	// from the images of the group. Defaults to busybox:1.36.
+	HelperImage *string `json:"helperImage,omitempty"`
+}
+
+// ImagePrePullApplyConfiguration constructs a declarative configuration of the ImagePrePull type for use with
+// apply.
+func ImagePrePull() *ImagePrePullApplyConfiguration {
+	return &ImagePrePullApplyConfiguration{}
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ImagePrePullApplyConfiguration) WithResources(value corev1.ResourceRequirements) *ImagePrePullApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithHelperImage sets the HelperImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HelperImage field is set to the value of the last call.
+func (b *ImagePrePullApplyConfiguration) WithHelperImage(value string) *ImagePrePullApplyConfiguration {
+	b.HelperImage = &value
+	return b
+}
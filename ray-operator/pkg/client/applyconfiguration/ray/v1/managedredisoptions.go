@@ -0,0 +1,62 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ManagedRedisOptionsApplyConfiguration represents a declarative configuration of the ManagedRedisOptions type for use
+// with apply.
+//
+// ManagedRedisOptions configures the Redis that KubeRay creates for GCS fault tolerance.
+type ManagedRedisOptionsApplyConfiguration struct {
+	// StorageSize is the size of the PersistentVolumeClaim that stores the Redis data. If it is not set,
+	// the data is stored in an emptyDir volume and is lost when the Redis Pod is deleted.
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+	// StorageClassName is the StorageClass of the PersistentVolumeClaim that stores the Redis data.
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Resources are the compute resources of the Redis container.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Image is the Redis container image. Defaults to redis:7.4.
+	Image *string `json:"image,omitempty"`
+}
+
+// ManagedRedisOptionsApplyConfiguration constructs a declarative configuration of the ManagedRedisOptions type for use with
+// apply.
+func ManagedRedisOptions() *ManagedRedisOptionsApplyConfiguration {
+	return &ManagedRedisOptionsApplyConfiguration{}
+}
+
+// WithStorageSize sets the StorageSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageSize field is set to the value of the last call.
+func (b *ManagedRedisOptionsApplyConfiguration) WithStorageSize(value resource.Quantity) *ManagedRedisOptionsApplyConfiguration {
+	b.StorageSize = &value
+	return b
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *ManagedRedisOptionsApplyConfiguration) WithStorageClassName(value string) *ManagedRedisOptionsApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ManagedRedisOptionsApplyConfiguration) WithResources(value corev1.ResourceRequirements) *ManagedRedisOptionsApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ManagedRedisOptionsApplyConfiguration) WithImage(value string) *ManagedRedisOptionsApplyConfiguration {
+	b.Image = &value
+	return b
+}
@@ -0,0 +1,34 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// NetworkIsolationApplyConfiguration represents a declarative configuration of the NetworkIsolation type for use
+// with apply.
+//
+// NetworkIsolation configures the NetworkPolicy that KubeRay creates for a RayCluster.
+type NetworkIsolationApplyConfiguration struct {
+	// IngressFrom are the sources that are allowed to reach the ports of the head service, such as the client,
+	// dashboard, and serve ports. If empty, these ports are only reachable from the RayCluster, the KubeRay operator,
+	// and the submitter Pods of the RayJob in K8sJobMode that created the RayCluster.
+	IngressFrom []networkingv1.NetworkPolicyPeer `json:"ingressFrom,omitempty"`
+}
+
+// NetworkIsolationApplyConfiguration constructs a declarative configuration of the NetworkIsolation type for use with
+// apply.
+func NetworkIsolation() *NetworkIsolationApplyConfiguration {
+	return &NetworkIsolationApplyConfiguration{}
+}
+
+// WithIngressFrom adds the given value to the IngressFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IngressFrom field.
+func (b *NetworkIsolationApplyConfiguration) WithIngressFrom(values ...networkingv1.NetworkPolicyPeer) *NetworkIsolationApplyConfiguration {
+	for i := range values {
+		b.IngressFrom = append(b.IngressFrom, values[i])
+	}
+	return b
+}
@@ -13,9 +13,18 @@ type RayClusterSpecApplyConfiguration struct {
 	UpgradeStrategy *RayClusterUpgradeStrategyApplyConfiguration `json:"upgradeStrategy,omitempty"`
 	// AuthOptions specifies the authentication options for the RayCluster.
 	AuthOptions *AuthOptionsApplyConfiguration `json:"authOptions,omitempty"`
+	// TLSOptions specifies the TLS options for the RayCluster. When set, KubeRay mounts the
+	// certificates into every Ray Pod and connects to the Ray dashboard and Serve proxy over HTTPS.
+	TLSOptions *TLSOptionsApplyConfiguration `json:"tlsOptions,omitempty"`
 	// Suspend indicates whether a RayCluster should be suspended.
 	// A suspended RayCluster will have head pods and worker pods deleted.
 	Suspend *bool `json:"suspend,omitempty"`
+	// IdlePolicy suspends or deletes the RayCluster once it has been idle for a while. It's intended for standalone
+	// RayClusters used interactively and isn't supported for RayClusters created by a RayJob or a RayService.
+	IdlePolicy *IdlePolicyApplyConfiguration `json:"idlePolicy,omitempty"`
+	// PriorityClassName is the priority class of the Ray Pods whose template doesn't set one. It's also propagated
+	// to the batch scheduler, for example to the Volcano PodGroup.
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
 	// ManagedBy is an optional configuration for the controller or entity that manages a RayCluster.
 	// The value must be either 'ray.io/kuberay-operator' or 'kueue.x-k8s.io/multikueue'.
 	// The kuberay-operator reconciles a RayCluster which doesn't have this field at all or
@@ -36,6 +45,17 @@ type RayClusterSpecApplyConfiguration struct {
 	RayVersion *string `json:"rayVersion,omitempty"`
 	// WorkerGroupSpecs are the specs for the worker pods
 	WorkerGroupSpecs []WorkerGroupSpecApplyConfiguration `json:"workerGroupSpecs,omitempty"`
+	// VolumeClaimRetentionPolicy specifies whether the PersistentVolumeClaims created from the volume claim
+	// templates of the head and worker groups are deleted. By default, they are retained.
+	VolumeClaimRetentionPolicy *VolumeClaimRetentionPolicyApplyConfiguration `json:"volumeClaimRetentionPolicy,omitempty"`
+	// NetworkIsolation makes KubeRay create a NetworkPolicy that restricts the ingress traffic to the Pods of the
+	// RayCluster. Traffic between the Pods of the RayCluster, and from the KubeRay operator and the submitter Pods of
+	// the RayJob in K8sJobMode that created the RayCluster to the dashboard port, is always allowed. The KubeRay
+	// operator can also reach the serving port of the RayClusters of a RayService, to check the health of Serve.
+	NetworkIsolation *NetworkIsolationApplyConfiguration `json:"networkIsolation,omitempty"`
+	// ImagePrePull makes KubeRay create a DaemonSet for the head group and each worker group that pulls the images of
+	// the group on the nodes that its Pods can be scheduled on, so that new Pods don't wait for the images to be pulled.
+	ImagePrePull *ImagePrePullApplyConfiguration `json:"imagePrePull,omitempty"`
 }
 
 // RayClusterSpecApplyConfiguration constructs a declarative configuration of the RayClusterSpec type for use with
@@ -60,6 +80,14 @@ func (b *RayClusterSpecApplyConfiguration) WithAuthOptions(value *AuthOptionsApp
 	return b
 }
 
+// WithTLSOptions sets the TLSOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSOptions field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithTLSOptions(value *TLSOptionsApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.TLSOptions = value
+	return b
+}
+
 // WithSuspend sets the Suspend field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Suspend field is set to the value of the last call.
@@ -68,6 +96,22 @@ func (b *RayClusterSpecApplyConfiguration) WithSuspend(value bool) *RayClusterSp
 	return b
 }
 
+// WithIdlePolicy sets the IdlePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdlePolicy field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithIdlePolicy(value *IdlePolicyApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.IdlePolicy = value
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithPriorityClassName(value string) *RayClusterSpecApplyConfiguration {
+	b.PriorityClassName = &value
+	return b
+}
+
 // WithManagedBy sets the ManagedBy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ManagedBy field is set to the value of the last call.
@@ -142,3 +186,27 @@ func (b *RayClusterSpecApplyConfiguration) WithWorkerGroupSpecs(values ...*Worke
 	}
 	return b
 }
+
+// WithVolumeClaimRetentionPolicy sets the VolumeClaimRetentionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeClaimRetentionPolicy field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithVolumeClaimRetentionPolicy(value *VolumeClaimRetentionPolicyApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.VolumeClaimRetentionPolicy = value
+	return b
+}
+
+// WithNetworkIsolation sets the NetworkIsolation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkIsolation field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithNetworkIsolation(value *NetworkIsolationApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.NetworkIsolation = value
+	return b
+}
+
+// WithImagePrePull sets the ImagePrePull field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePrePull field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithImagePrePull(value *ImagePrePullApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.ImagePrePull = value
+	return b
+}
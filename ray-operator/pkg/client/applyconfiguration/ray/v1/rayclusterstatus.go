@@ -28,6 +28,9 @@ type RayClusterStatusApplyConfiguration struct {
 	DesiredGPU *resource.Quantity `json:"desiredGPU,omitempty"`
 	// DesiredTPU indicates total desired TPUs for the cluster
 	DesiredTPU *resource.Quantity `json:"desiredTPU,omitempty"`
+	// DesiredAccelerators indicates total desired accelerators for the cluster, keyed by the Ray resources
+	// that they are exported as, such as "GPU", "TPU", or "neuron_cores".
+	DesiredAccelerators map[string]resource.Quantity `json:"desiredAccelerators,omitempty"`
 	// LastUpdateTime indicates last update timestamp for this cluster status.
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
 	// StateTransitionTimes indicates the time of the last state transition for each state.
@@ -58,6 +61,12 @@ type RayClusterStatusApplyConfiguration struct {
 	// calculated as the sum of `maxReplicas * numOfHosts` for each worker group.
 	// It is named "replicas" to maintain backward compatibility.
 	MaxWorkerReplicas *int32 `json:"maxWorkerReplicas,omitempty"`
+	// WorkerGroupStatuses indicates the observed state of each worker group.
+	WorkerGroupStatuses []WorkerGroupStatusApplyConfiguration `json:"workerGroupStatuses,omitempty"`
+	// AuthToken records the rotation of the auth token generated by KubeRay.
+	AuthToken *AuthTokenStatusApplyConfiguration `json:"authToken,omitempty"`
+	// Idle records the activity of a RayCluster with an idle policy.
+	Idle *IdleStatusApplyConfiguration `json:"idle,omitempty"`
 	// observedGeneration is the most recent generation observed for this RayCluster. It corresponds to the
 	// RayCluster's generation, which is updated on mutation by the API Server.
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
@@ -109,6 +118,20 @@ func (b *RayClusterStatusApplyConfiguration) WithDesiredTPU(value resource.Quant
 	return b
 }
 
+// WithDesiredAccelerators puts the entries into the DesiredAccelerators field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the DesiredAccelerators field,
+// overwriting an existing map entries in DesiredAccelerators field with the same key.
+func (b *RayClusterStatusApplyConfiguration) WithDesiredAccelerators(entries map[string]resource.Quantity) *RayClusterStatusApplyConfiguration {
+	if b.DesiredAccelerators == nil && len(entries) > 0 {
+		b.DesiredAccelerators = make(map[string]resource.Quantity, len(entries))
+	}
+	for k, v := range entries {
+		b.DesiredAccelerators[k] = v
+	}
+	return b
+}
+
 // WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the LastUpdateTime field is set to the value of the last call.
@@ -214,6 +237,35 @@ func (b *RayClusterStatusApplyConfiguration) WithMaxWorkerReplicas(value int32)
 	return b
 }
 
+// WithWorkerGroupStatuses adds the given value to the WorkerGroupStatuses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkerGroupStatuses field.
+func (b *RayClusterStatusApplyConfiguration) WithWorkerGroupStatuses(values ...*WorkerGroupStatusApplyConfiguration) *RayClusterStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkerGroupStatuses")
+		}
+		b.WorkerGroupStatuses = append(b.WorkerGroupStatuses, *values[i])
+	}
+	return b
+}
+
+// WithAuthToken sets the AuthToken field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuthToken field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithAuthToken(value *AuthTokenStatusApplyConfiguration) *RayClusterStatusApplyConfiguration {
+	b.AuthToken = value
+	return b
+}
+
+// WithIdle sets the Idle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Idle field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithIdle(value *IdleStatusApplyConfiguration) *RayClusterStatusApplyConfiguration {
+	b.Idle = value
+	return b
+}
+
 // WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ObservedGeneration field is set to the value of the last call.
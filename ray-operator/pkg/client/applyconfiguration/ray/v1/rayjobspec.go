@@ -28,6 +28,11 @@ type RayJobSpecApplyConfiguration struct {
 	ClusterSelector map[string]string `json:"clusterSelector,omitempty"`
 	// Configurations of submitter k8s job.
 	SubmitterConfig *SubmitterConfigApplyConfiguration `json:"submitterConfig,omitempty"`
+	// PriorityClassName is the priority class of the RayJob. It's propagated to the RayCluster and the submitter
+	// Pod, and to the batch scheduler, for example to the Volcano PodGroup or the Kueue Workload.
+	// If the RayCluster of a RayJob is preempted, the RayJob is retried without counting toward `backoffLimit`,
+	// or suspended if it's queued by a batch scheduler that admits suspended RayJobs.
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
 	// ManagedBy is an optional configuration for the controller or entity that manages a RayJob.
 	// The value must be either 'ray.io/kuberay-operator' or 'kueue.x-k8s.io/multikueue'.
 	// The kuberay-operator reconciles a RayJob which doesn't have this field at all or
@@ -79,6 +84,9 @@ type RayJobSpecApplyConfiguration struct {
 	// If the RayCluster is already created, it will be deleted.
 	// In case of transition to false a new RayCluster will be created.
 	Suspend *bool `json:"suspend,omitempty"`
+	// WaitForImagePrePull makes the RayJob wait for the ImagesPrePulled condition of the RayCluster to be true before
+	// it submits the job. The RayCluster must set imagePrePull. It can't be used with ClusterSelector.
+	WaitForImagePrePull *bool `json:"waitForImagePrePull,omitempty"`
 }
 
 // RayJobSpecApplyConfiguration constructs a declarative configuration of the RayJobSpec type for use with
@@ -155,6 +163,14 @@ func (b *RayJobSpecApplyConfiguration) WithSubmitterConfig(value *SubmitterConfi
 	return b
 }
 
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithPriorityClassName(value string) *RayJobSpecApplyConfiguration {
+	b.PriorityClassName = &value
+	return b
+}
+
 // WithManagedBy sets the ManagedBy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ManagedBy field is set to the value of the last call.
@@ -258,3 +274,11 @@ func (b *RayJobSpecApplyConfiguration) WithSuspend(value bool) *RayJobSpecApplyC
 	b.Suspend = &value
 	return b
 }
+
+// WithWaitForImagePrePull sets the WaitForImagePrePull field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WaitForImagePrePull field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithWaitForImagePrePull(value bool) *RayJobSpecApplyConfiguration {
+	b.WaitForImagePrePull = &value
+	return b
+}
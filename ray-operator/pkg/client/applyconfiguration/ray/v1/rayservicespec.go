@@ -14,6 +14,10 @@ type RayServiceSpecApplyConfiguration struct {
 	// RayClusterDeletionDelaySeconds specifies the delay, in seconds, before deleting old RayClusters.
 	// The default value is 60 seconds.
 	RayClusterDeletionDelaySeconds *int32 `json:"rayClusterDeletionDelaySeconds,omitempty"`
+	// RevisionHistoryLimit is the number of revisions of the RayCluster spec and Serve config to retain as
+	// ControllerRevisions. A retained revision can be restored with the `ray.io/rollback-to-revision` annotation.
+	// The default value is 10.
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 	// Deprecated: This field is not used anymore. ref: https://github.com/ray-project/kuberay/issues/1685
 	ServiceUnhealthySecondThreshold *int32 `json:"serviceUnhealthySecondThreshold,omitempty"`
 	// Deprecated: This field is not used anymore. ref: https://github.com/ray-project/kuberay/issues/1685
@@ -22,6 +26,8 @@ type RayServiceSpecApplyConfiguration struct {
 	ServeService *corev1.Service `json:"serveService,omitempty"`
 	// UpgradeStrategy defines the scaling policy used when upgrading the RayService.
 	UpgradeStrategy *RayServiceUpgradeStrategyApplyConfiguration `json:"upgradeStrategy,omitempty"`
+	// ServeRolloutStrategy defines how the Serve applications are submitted to a RayCluster.
+	ServeRolloutStrategy *ServeRolloutStrategyApplyConfiguration `json:"serveRolloutStrategy,omitempty"`
 	// ManagedBy is an optional configuration for the controller or entity that manages a RayService.
 	// The value must be either 'ray.io/kuberay-operator' or 'kueue.x-k8s.io/multikueue'.
 	// The kuberay-operator reconciles a RayService which doesn't have this field at all or
@@ -31,8 +37,12 @@ type RayServiceSpecApplyConfiguration struct {
 	ManagedBy *string `json:"managedBy,omitempty"`
 	// Important: Run "make" to regenerate code after modifying this file
 	// Defines the applications and deployments to deploy, should be a YAML multi-line scalar string.
-	ServeConfigV2  *string                           `json:"serveConfigV2,omitempty"`
-	RayClusterSpec *RayClusterSpecApplyConfiguration `json:"rayClusterConfig,omitempty"`
+	ServeConfigV2 *string `json:"serveConfigV2,omitempty"`
+	// ServeConfigV2From references a ConfigMap or Secret key that contains the Serve config.
+	// KubeRay watches the referenced object and resubmits the Serve applications when its data changes.
+	// ServeConfigV2From and ServeConfigV2 are mutually exclusive.
+	ServeConfigV2From *ServeConfigV2SourceApplyConfiguration `json:"serveConfigV2From,omitempty"`
+	RayClusterSpec    *RayClusterSpecApplyConfiguration      `json:"rayClusterConfig,omitempty"`
 	// If the field is set to true, the value of the label `ray.io/serve` on the head Pod should always be false.
 	// Therefore, the head Pod's endpoint will not be added to the Kubernetes Serve service.
 	ExcludeHeadPodFromServeSvc *bool `json:"excludeHeadPodFromServeSvc,omitempty"`
@@ -52,6 +62,14 @@ func (b *RayServiceSpecApplyConfiguration) WithRayClusterDeletionDelaySeconds(va
 	return b
 }
 
+// WithRevisionHistoryLimit sets the RevisionHistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RevisionHistoryLimit field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithRevisionHistoryLimit(value int32) *RayServiceSpecApplyConfiguration {
+	b.RevisionHistoryLimit = &value
+	return b
+}
+
 // WithServiceUnhealthySecondThreshold sets the ServiceUnhealthySecondThreshold field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServiceUnhealthySecondThreshold field is set to the value of the last call.
@@ -84,6 +102,14 @@ func (b *RayServiceSpecApplyConfiguration) WithUpgradeStrategy(value *RayService
 	return b
 }
 
+// WithServeRolloutStrategy sets the ServeRolloutStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServeRolloutStrategy field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithServeRolloutStrategy(value *ServeRolloutStrategyApplyConfiguration) *RayServiceSpecApplyConfiguration {
+	b.ServeRolloutStrategy = value
+	return b
+}
+
 // WithManagedBy sets the ManagedBy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ManagedBy field is set to the value of the last call.
@@ -100,6 +126,14 @@ func (b *RayServiceSpecApplyConfiguration) WithServeConfigV2(value string) *RayS
 	return b
 }
 
+// WithServeConfigV2From sets the ServeConfigV2From field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServeConfigV2From field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithServeConfigV2From(value *ServeConfigV2SourceApplyConfiguration) *RayServiceSpecApplyConfiguration {
+	b.ServeConfigV2From = value
+	return b
+}
+
 // WithRayClusterSpec sets the RayClusterSpec field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RayClusterSpec field is set to the value of the last call.
@@ -0,0 +1,48 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RayWorkerGroupScalerSpecApplyConfiguration represents a declarative configuration of the RayWorkerGroupScalerSpec type for use
+// with apply.
+//
+// RayWorkerGroupScalerSpec defines the desired state of RayWorkerGroupScaler
+type RayWorkerGroupScalerSpecApplyConfiguration struct {
+	// Replicas is the desired number of replicas of the target worker group. It is usually
+	// managed by a HorizontalPodAutoscaler or KEDA through the scale subresource, and it is
+	// clamped to the worker group's MinReplicas and MaxReplicas before being applied.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// RayClusterName is the name of the RayCluster in the same namespace that owns the worker group.
+	RayClusterName *string `json:"rayClusterName,omitempty"`
+	// GroupName is the name of the worker group to scale.
+	GroupName *string `json:"groupName,omitempty"`
+}
+
+// RayWorkerGroupScalerSpecApplyConfiguration constructs a declarative configuration of the RayWorkerGroupScalerSpec type for use with
+// apply.
+func RayWorkerGroupScalerSpec() *RayWorkerGroupScalerSpecApplyConfiguration {
+	return &RayWorkerGroupScalerSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *RayWorkerGroupScalerSpecApplyConfiguration) WithReplicas(value int32) *RayWorkerGroupScalerSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithRayClusterName sets the RayClusterName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RayClusterName field is set to the value of the last call.
+func (b *RayWorkerGroupScalerSpecApplyConfiguration) WithRayClusterName(value string) *RayWorkerGroupScalerSpecApplyConfiguration {
+	b.RayClusterName = &value
+	return b
+}
+
+// WithGroupName sets the GroupName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GroupName field is set to the value of the last call.
+func (b *RayWorkerGroupScalerSpecApplyConfiguration) WithGroupName(value string) *RayWorkerGroupScalerSpecApplyConfiguration {
+	b.GroupName = &value
+	return b
+}
@@ -0,0 +1,66 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// RayWorkerGroupScalerStatusApplyConfiguration represents a declarative configuration of the RayWorkerGroupScalerStatus type for use
+// with apply.
+//
+// RayWorkerGroupScalerStatus defines the observed state of RayWorkerGroupScaler
+type RayWorkerGroupScalerStatusApplyConfiguration struct {
+	// Selector is the label selector of the worker group's Pods in string form, used by
+	// the HorizontalPodAutoscaler to collect Pod metrics.
+	Selector *string `json:"selector,omitempty"`
+	// Represents the latest available observations of the RayWorkerGroupScaler's current state.
+	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// Replicas is the number of replicas of the worker group that currently exist.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// observedGeneration is the most recent generation observed for this RayWorkerGroupScaler.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+}
+
+// RayWorkerGroupScalerStatusApplyConfiguration constructs a declarative configuration of the RayWorkerGroupScalerStatus type for use with
+// apply.
+func RayWorkerGroupScalerStatus() *RayWorkerGroupScalerStatusApplyConfiguration {
+	return &RayWorkerGroupScalerStatusApplyConfiguration{}
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *RayWorkerGroupScalerStatusApplyConfiguration) WithSelector(value string) *RayWorkerGroupScalerStatusApplyConfiguration {
+	b.Selector = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *RayWorkerGroupScalerStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *RayWorkerGroupScalerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *RayWorkerGroupScalerStatusApplyConfiguration) WithReplicas(value int32) *RayWorkerGroupScalerStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *RayWorkerGroupScalerStatusApplyConfiguration) WithObservedGeneration(value int64) *RayWorkerGroupScalerStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
@@ -0,0 +1,88 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ScalingScheduleApplyConfiguration represents a declarative configuration of the ScalingSchedule type for use
+// with apply.
+//
+// ScalingSchedule defines a recurring time window during which the replica settings of a worker group are overridden.
+type ScalingScheduleApplyConfiguration struct {
+	// Name identifies the scaling schedule within the worker group.
+	Name *string `json:"name,omitempty"`
+	// Schedule is the cron schedule, in the standard five-field format, on which the window starts.
+	Schedule *string `json:"schedule,omitempty"`
+	// TimeZone is the name of the IANA time zone in which the schedule is interpreted, such as "America/New_York".
+	// Defaults to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+	// DurationSeconds is the length of the window after each start time.
+	DurationSeconds *int32 `json:"durationSeconds,omitempty"`
+	// Replicas overrides the number of desired Pods for the worker group during the window.
+	// It can't be set if autoscaling is enabled, since the autoscaler sets the replicas of the worker group.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// MinReplicas overrides the minimum number of desired Pods for the worker group during the window.
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas overrides the maximum number of desired Pods for the worker group during the window.
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// ScalingScheduleApplyConfiguration constructs a declarative configuration of the ScalingSchedule type for use with
+// apply.
+func ScalingSchedule() *ScalingScheduleApplyConfiguration {
+	return &ScalingScheduleApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithName(value string) *ScalingScheduleApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithSchedule(value string) *ScalingScheduleApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
+// WithTimeZone sets the TimeZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeZone field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithTimeZone(value string) *ScalingScheduleApplyConfiguration {
+	b.TimeZone = &value
+	return b
+}
+
+// WithDurationSeconds sets the DurationSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DurationSeconds field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithDurationSeconds(value int32) *ScalingScheduleApplyConfiguration {
+	b.DurationSeconds = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithReplicas(value int32) *ScalingScheduleApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithMinReplicas(value int32) *ScalingScheduleApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithMaxReplicas(value int32) *ScalingScheduleApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
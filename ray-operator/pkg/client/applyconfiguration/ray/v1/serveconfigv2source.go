@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ServeConfigV2SourceApplyConfiguration represents a declarative configuration of the ServeConfigV2Source type for use
+// with apply.
+//
+// ServeConfigV2Source references a key of a ConfigMap or Secret that contains the Serve config.
+// Exactly one of ConfigMapKeyRef and SecretKeyRef must be set.
+type ServeConfigV2SourceApplyConfiguration struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the RayService's namespace.
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// SecretKeyRef selects a key of a Secret in the RayService's namespace.
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// ServeConfigV2SourceApplyConfiguration constructs a declarative configuration of the ServeConfigV2Source type for use with
+// apply.
+func ServeConfigV2Source() *ServeConfigV2SourceApplyConfiguration {
+	return &ServeConfigV2SourceApplyConfiguration{}
+}
+
+// WithConfigMapKeyRef sets the ConfigMapKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapKeyRef field is set to the value of the last call.
+func (b *ServeConfigV2SourceApplyConfiguration) WithConfigMapKeyRef(value corev1.ConfigMapKeySelector) *ServeConfigV2SourceApplyConfiguration {
+	b.ConfigMapKeyRef = &value
+	return b
+}
+
+// WithSecretKeyRef sets the SecretKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretKeyRef field is set to the value of the last call.
+func (b *ServeConfigV2SourceApplyConfiguration) WithSecretKeyRef(value corev1.SecretKeySelector) *ServeConfigV2SourceApplyConfiguration {
+	b.SecretKeyRef = &value
+	return b
+}
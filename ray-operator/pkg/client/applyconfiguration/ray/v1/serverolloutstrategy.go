@@ -0,0 +1,46 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// ServeRolloutStrategyApplyConfiguration represents a declarative configuration of the ServeRolloutStrategy type for use
+// with apply.
+type ServeRolloutStrategyApplyConfiguration struct {
+	// Type represents the strategy used when submitting the Serve applications to a RayCluster. Currently supports
+	// `AllAtOnce` and `Sequential`. Defaults to `AllAtOnce`.
+	// If an application fails to deploy with `Sequential`, the rollout stops, and the applications after it keep
+	// their previously submitted config until the Serve config changes. The status message of the failed application
+	// records that the rollout is stopped.
+	Type *rayv1.ServeRolloutStrategyType `json:"type,omitempty"`
+	// ApplicationOrder is the order in which the Serve applications are rolled out with the `Sequential` type, for
+	// example to deploy an application before the applications that depend on it. Applications that are not listed
+	// are rolled out after the listed ones, in the order of the Serve config.
+	ApplicationOrder []string `json:"applicationOrder,omitempty"`
+}
+
+// ServeRolloutStrategyApplyConfiguration constructs a declarative configuration of the ServeRolloutStrategy type for use with
+// apply.
+func ServeRolloutStrategy() *ServeRolloutStrategyApplyConfiguration {
+	return &ServeRolloutStrategyApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ServeRolloutStrategyApplyConfiguration) WithType(value rayv1.ServeRolloutStrategyType) *ServeRolloutStrategyApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithApplicationOrder adds the given value to the ApplicationOrder field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ApplicationOrder field.
+func (b *ServeRolloutStrategyApplyConfiguration) WithApplicationOrder(values ...string) *ServeRolloutStrategyApplyConfiguration {
+	for i := range values {
+		b.ApplicationOrder = append(b.ApplicationOrder, values[i])
+	}
+	return b
+}
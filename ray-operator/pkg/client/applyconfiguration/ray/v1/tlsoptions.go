@@ -0,0 +1,44 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TLSOptionsApplyConfiguration represents a declarative configuration of the TLSOptions type for use
+// with apply.
+//
+// TLSOptions defines the TLS options for a RayCluster.
+type TLSOptionsApplyConfiguration struct {
+	// SecretName is the name of the Secret in the RayCluster's namespace that contains the
+	// TLS materials. The Secret must have the data keys `tls.crt` and `tls.key` with the
+	// certificate and private key presented by Ray Pods and by KubeRay as a client, and
+	// `ca.crt` with the CA used to verify peers.
+	// KubeRay sets RAY_USE_TLS, RAY_TLS_SERVER_CERT, RAY_TLS_SERVER_KEY, and RAY_TLS_CA_CERT
+	// in Ray containers unless they are already set. These only secure Ray's gRPC traffic.
+	SecretName *string `json:"secretName,omitempty"`
+	// EnableHTTPS makes KubeRay, including the RayJob submitter, connect to the Ray dashboard and
+	// Serve proxy over HTTPS with the certificates in the Secret. Ray serves these endpoints over
+	// plain HTTP, so only set this if the Ray containers are configured to serve them over HTTPS.
+	// Defaults to false.
+	EnableHTTPS *bool `json:"enableHTTPS,omitempty"`
+}
+
+// TLSOptionsApplyConfiguration constructs a declarative configuration of the TLSOptions type for use with
+// apply.
+func TLSOptions() *TLSOptionsApplyConfiguration {
+	return &TLSOptionsApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *TLSOptionsApplyConfiguration) WithSecretName(value string) *TLSOptionsApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithEnableHTTPS sets the EnableHTTPS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnableHTTPS field is set to the value of the last call.
+func (b *TLSOptionsApplyConfiguration) WithEnableHTTPS(value bool) *TLSOptionsApplyConfiguration {
+	b.EnableHTTPS = &value
+	return b
+}
@@ -0,0 +1,42 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// VolumeClaimRetentionPolicyApplyConfiguration represents a declarative configuration of the VolumeClaimRetentionPolicy type for use
+// with apply.
+//
+// VolumeClaimRetentionPolicy specifies when the PersistentVolumeClaims created from volume claim templates are deleted.
+type VolumeClaimRetentionPolicyApplyConfiguration struct {
+	// WhenDeleted specifies what happens to the claims when the RayCluster is deleted. Defaults to Retain.
+	WhenDeleted *rayv1.VolumeClaimRetentionPolicyType `json:"whenDeleted,omitempty"`
+	// WhenScaled specifies what happens to the claims of a worker group that are no longer used by a Pod once
+	// the worker group is scaled down below their replica index, or the worker group or the volume claim template
+	// is removed. Defaults to Retain.
+	WhenScaled *rayv1.VolumeClaimRetentionPolicyType `json:"whenScaled,omitempty"`
+}
+
+// VolumeClaimRetentionPolicyApplyConfiguration constructs a declarative configuration of the VolumeClaimRetentionPolicy type for use with
+// apply.
+func VolumeClaimRetentionPolicy() *VolumeClaimRetentionPolicyApplyConfiguration {
+	return &VolumeClaimRetentionPolicyApplyConfiguration{}
+}
+
+// WithWhenDeleted sets the WhenDeleted field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WhenDeleted field is set to the value of the last call.
+func (b *VolumeClaimRetentionPolicyApplyConfiguration) WithWhenDeleted(value rayv1.VolumeClaimRetentionPolicyType) *VolumeClaimRetentionPolicyApplyConfiguration {
+	b.WhenDeleted = &value
+	return b
+}
+
+// WithWhenScaled sets the WhenScaled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WhenScaled field is set to the value of the last call.
+func (b *VolumeClaimRetentionPolicyApplyConfiguration) WithWhenScaled(value rayv1.VolumeClaimRetentionPolicyType) *VolumeClaimRetentionPolicyApplyConfiguration {
+	b.WhenScaled = &value
+	return b
+}
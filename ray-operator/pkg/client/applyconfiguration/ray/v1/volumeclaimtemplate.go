@@ -0,0 +1,43 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// VolumeClaimTemplateApplyConfiguration represents a declarative configuration of the VolumeClaimTemplate type for use
+// with apply.
+//
+// VolumeClaimTemplate is a template of the PersistentVolumeClaims that KubeRay creates for each Pod of a group.
+// The claim of a Pod is named after the template, the RayCluster, the group, and the replica and host indices
+// of the Pod, so a Pod that replaces another one mounts the same claim.
+type VolumeClaimTemplateApplyConfiguration struct {
+	// Name is the name of the volume that mounts the claim in the Pods. Containers mount it with a volumeMount
+	// of the same name. It replaces a volume of the same name in the Pod template.
+	Name *string `json:"name,omitempty"`
+	// Spec is the spec of the PersistentVolumeClaims.
+	Spec *corev1.PersistentVolumeClaimSpec `json:"spec,omitempty"`
+}
+
+// VolumeClaimTemplateApplyConfiguration constructs a declarative configuration of the VolumeClaimTemplate type for use with
+// apply.
+func VolumeClaimTemplate() *VolumeClaimTemplateApplyConfiguration {
+	return &VolumeClaimTemplateApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *VolumeClaimTemplateApplyConfiguration) WithName(value string) *VolumeClaimTemplateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *VolumeClaimTemplateApplyConfiguration) WithSpec(value corev1.PersistentVolumeClaimSpec) *VolumeClaimTemplateApplyConfiguration {
+	b.Spec = &value
+	return b
+}
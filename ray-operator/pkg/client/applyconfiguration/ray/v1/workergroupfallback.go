@@ -0,0 +1,74 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// WorkerGroupFallbackApplyConfiguration represents a declarative configuration of the WorkerGroupFallback type for use
+// with apply.
+//
+// WorkerGroupFallback configures when a worker group falls back to another worker group, and for how long.
+// While the fallback is active, KubeRay creates the replicas of the worker group in the fallback group instead, and
+// adds the minReplicas and maxReplicas of the worker group to those of the fallback group. The RayCluster spec isn't
+// changed, so the Ray autoscaler keeps scaling the worker group, and its replicas move back once the fallback ends.
+// The active fallback is reported in the worker group status.
+type WorkerGroupFallbackApplyConfiguration struct {
+	// GroupName is the name of the worker group to fall back to.
+	GroupName *string `json:"groupName,omitempty"`
+	// UnschedulableTimeoutSeconds is how long a Pod of the worker group can stay unschedulable before KubeRay falls
+	// back. Defaults to 300.
+	UnschedulableTimeoutSeconds *int32 `json:"unschedulableTimeoutSeconds,omitempty"`
+	// MaxPreemptions is the number of Pods of the worker group that can be preempted within PreemptionWindowSeconds
+	// before KubeRay falls back. Defaults to 3.
+	MaxPreemptions *int32 `json:"maxPreemptions,omitempty"`
+	// PreemptionWindowSeconds is the length of the window in which MaxPreemptions preemptions trigger the fallback.
+	// Defaults to 600.
+	PreemptionWindowSeconds *int32 `json:"preemptionWindowSeconds,omitempty"`
+	// DurationSeconds is how long the fallback lasts before KubeRay restores the replicas of the worker group and
+	// tries it again. Defaults to 1800.
+	DurationSeconds *int32 `json:"durationSeconds,omitempty"`
+}
+
+// WorkerGroupFallbackApplyConfiguration constructs a declarative configuration of the WorkerGroupFallback type for use with
+// apply.
+func WorkerGroupFallback() *WorkerGroupFallbackApplyConfiguration {
+	return &WorkerGroupFallbackApplyConfiguration{}
+}
+
+// WithGroupName sets the GroupName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GroupName field is set to the value of the last call.
+func (b *WorkerGroupFallbackApplyConfiguration) WithGroupName(value string) *WorkerGroupFallbackApplyConfiguration {
+	b.GroupName = &value
+	return b
+}
+
+// WithUnschedulableTimeoutSeconds sets the UnschedulableTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UnschedulableTimeoutSeconds field is set to the value of the last call.
+func (b *WorkerGroupFallbackApplyConfiguration) WithUnschedulableTimeoutSeconds(value int32) *WorkerGroupFallbackApplyConfiguration {
+	b.UnschedulableTimeoutSeconds = &value
+	return b
+}
+
+// WithMaxPreemptions sets the MaxPreemptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxPreemptions field is set to the value of the last call.
+func (b *WorkerGroupFallbackApplyConfiguration) WithMaxPreemptions(value int32) *WorkerGroupFallbackApplyConfiguration {
+	b.MaxPreemptions = &value
+	return b
+}
+
+// WithPreemptionWindowSeconds sets the PreemptionWindowSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreemptionWindowSeconds field is set to the value of the last call.
+func (b *WorkerGroupFallbackApplyConfiguration) WithPreemptionWindowSeconds(value int32) *WorkerGroupFallbackApplyConfiguration {
+	b.PreemptionWindowSeconds = &value
+	return b
+}
+
+// WithDurationSeconds sets the DurationSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DurationSeconds field is set to the value of the last call.
+func (b *WorkerGroupFallbackApplyConfiguration) WithDurationSeconds(value int32) *WorkerGroupFallbackApplyConfiguration {
+	b.DurationSeconds = &value
+	return b
+}
@@ -42,6 +42,26 @@ type WorkerGroupSpecApplyConfiguration struct {
 	ScaleStrategy *ScaleStrategyApplyConfiguration `json:"scaleStrategy,omitempty"`
 	// NumOfHosts denotes the number of hosts to create per replica. The default value is 1.
 	NumOfHosts *int32 `json:"numOfHosts,omitempty"`
+	// PodDisruptionBudget configures the PodDisruptionBudget that KubeRay creates for the Pods of this worker group.
+	// If the RayCluster is created by a RayService and this field is not set, the worker group is protected with
+	// `maxUnavailable: 1`. Otherwise, no PodDisruptionBudget is created unless this field is set.
+	PodDisruptionBudget *PodDisruptionBudgetSpecApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	// ScalingSchedules override the replicas, minReplicas, or maxReplicas of this worker group during recurring time
+	// windows. While a window is active, KubeRay reconciles the worker group with the overridden fields without
+	// changing the RayCluster spec, and reports the window in the worker group status. If several windows are active
+	// at the same time, the first one in the list takes effect. If autoscaling is enabled, the scaling schedules can
+	// only override minReplicas and maxReplicas, and KubeRay keeps the Pods of the worker group within the overridden
+	// bounds. The bounds are reported in the worker group status for the autoscaler.
+	ScalingSchedules []ScalingScheduleApplyConfiguration `json:"scalingSchedules,omitempty"`
+	// VolumeClaimTemplates are templates of the PersistentVolumeClaims that KubeRay creates for each Pod of this
+	// worker group. They require the RayMultiHostIndexing feature gate, which assigns the replica indices.
+	VolumeClaimTemplates []VolumeClaimTemplateApplyConfiguration `json:"volumeClaimTemplates,omitempty"`
+	// Topology configures the placement of the hosts of each replica of this worker group. It requires NumOfHosts
+	// greater than 1 and the RayMultiHostIndexing feature gate.
+	Topology *WorkerGroupTopologyApplyConfiguration `json:"topology,omitempty"`
+	// Fallback configures another worker group that takes over the replicas of this worker group while its Pods can't
+	// be scheduled or are repeatedly preempted, such as when spot capacity is unavailable. Only supported for RayClusters.
+	Fallback *WorkerGroupFallbackApplyConfiguration `json:"fallback,omitempty"`
 }
 
 // WorkerGroupSpecApplyConfiguration constructs a declarative configuration of the WorkerGroupSpec type for use with
@@ -163,3 +183,53 @@ func (b *WorkerGroupSpecApplyConfiguration) WithNumOfHosts(value int32) *WorkerG
 	b.NumOfHosts = &value
 	return b
 }
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *WorkerGroupSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetSpecApplyConfiguration) *WorkerGroupSpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
+// WithScalingSchedules adds the given value to the ScalingSchedules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ScalingSchedules field.
+func (b *WorkerGroupSpecApplyConfiguration) WithScalingSchedules(values ...*ScalingScheduleApplyConfiguration) *WorkerGroupSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithScalingSchedules")
+		}
+		b.ScalingSchedules = append(b.ScalingSchedules, *values[i])
+	}
+	return b
+}
+
+// WithVolumeClaimTemplates adds the given value to the VolumeClaimTemplates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeClaimTemplates field.
+func (b *WorkerGroupSpecApplyConfiguration) WithVolumeClaimTemplates(values ...*VolumeClaimTemplateApplyConfiguration) *WorkerGroupSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumeClaimTemplates")
+		}
+		b.VolumeClaimTemplates = append(b.VolumeClaimTemplates, *values[i])
+	}
+	return b
+}
+
+// WithTopology sets the Topology field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Topology field is set to the value of the last call.
+func (b *WorkerGroupSpecApplyConfiguration) WithTopology(value *WorkerGroupTopologyApplyConfiguration) *WorkerGroupSpecApplyConfiguration {
+	b.Topology = value
+	return b
+}
+
+// WithFallback sets the Fallback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Fallback field is set to the value of the last call.
+func (b *WorkerGroupSpecApplyConfiguration) WithFallback(value *WorkerGroupFallbackApplyConfiguration) *WorkerGroupSpecApplyConfiguration {
+	b.Fallback = value
+	return b
+}
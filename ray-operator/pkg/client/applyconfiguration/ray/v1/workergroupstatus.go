@@ -0,0 +1,166 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkerGroupStatusApplyConfiguration represents a declarative configuration of the WorkerGroupStatus type for use
+// with apply.
+//
+// WorkerGroupStatus describes the observed state of a worker group.
+type WorkerGroupStatusApplyConfiguration struct {
+	// GroupName is the name of the worker group.
+	GroupName *string `json:"groupName,omitempty"`
+	// DesiredReplicas indicates the desired number of worker Pods in the worker group,
+	// calculated as `replicas * numOfHosts`.
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+	// ReadyReplicas indicates the number of worker Pods in the worker group that are in the Ready state.
+	ReadyReplicas *int32 `json:"readyReplicas,omitempty"`
+	// AvailableReplicas indicates the number of worker Pods in the worker group that are running.
+	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
+	// ReadyReplicaGroups indicates the number of replicas of the worker group whose `numOfHosts` Pods are all
+	// in the Ready state. It only differs from ReadyReplicas for multi-host worker groups.
+	ReadyReplicaGroups *int32 `json:"readyReplicaGroups,omitempty"`
+	// PendingPodReasons maps the reasons why worker Pods are not running, such as Unschedulable or
+	// ImagePullBackOff, to the number of Pods in the worker group that are pending for that reason.
+	PendingPodReasons map[string]int32 `json:"pendingPodReasons,omitempty"`
+	// LastScaleTime is the last time the desired number of worker Pods in the worker group changed.
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+	// ActiveScalingSchedule is the scaling schedule whose window is currently applied to the worker group.
+	ActiveScalingSchedule *ActiveScalingScheduleApplyConfiguration `json:"activeScalingSchedule,omitempty"`
+	// Preemptions is the number of Pods of the worker group that have been preempted, that is, disrupted by
+	// Kubernetes because the scheduler preempted them or the kubelet terminated them, such as on node reclamation.
+	Preemptions *int32 `json:"preemptions,omitempty"`
+	// LastPreemptionTime is the last time a Pod of the worker group was preempted.
+	LastPreemptionTime *metav1.Time `json:"lastPreemptionTime,omitempty"`
+	// RecentPreemptionTimes are the times of the most recent preemptions that count toward the fallback of the worker
+	// group, up to `fallback.maxPreemptions` of them.
+	RecentPreemptionTimes []metav1.Time `json:"recentPreemptionTimes,omitempty"`
+	// PreemptedPodUIDs are the UIDs of the preempted Pods of the worker group that still exist and are already
+	// counted in Preemptions, so that each Pod is counted once.
+	PreemptedPodUIDs []string `json:"preemptedPodUIDs,omitempty"`
+	// ActiveFallback is the fallback that is currently applied to the worker group.
+	ActiveFallback *ActiveFallbackApplyConfiguration `json:"activeFallback,omitempty"`
+}
+
+// WorkerGroupStatusApplyConfiguration constructs a declarative configuration of the WorkerGroupStatus type for use with
+// apply.
+func WorkerGroupStatus() *WorkerGroupStatusApplyConfiguration {
+	return &WorkerGroupStatusApplyConfiguration{}
+}
+
+// WithGroupName sets the GroupName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GroupName field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithGroupName(value string) *WorkerGroupStatusApplyConfiguration {
+	b.GroupName = &value
+	return b
+}
+
+// WithDesiredReplicas sets the DesiredReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DesiredReplicas field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithDesiredReplicas(value int32) *WorkerGroupStatusApplyConfiguration {
+	b.DesiredReplicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithReadyReplicas(value int32) *WorkerGroupStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithAvailableReplicas sets the AvailableReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailableReplicas field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithAvailableReplicas(value int32) *WorkerGroupStatusApplyConfiguration {
+	b.AvailableReplicas = &value
+	return b
+}
+
+// WithReadyReplicaGroups sets the ReadyReplicaGroups field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicaGroups field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithReadyReplicaGroups(value int32) *WorkerGroupStatusApplyConfiguration {
+	b.ReadyReplicaGroups = &value
+	return b
+}
+
+// WithPendingPodReasons puts the entries into the PendingPodReasons field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the PendingPodReasons field,
+// overwriting an existing map entries in PendingPodReasons field with the same key.
+func (b *WorkerGroupStatusApplyConfiguration) WithPendingPodReasons(entries map[string]int32) *WorkerGroupStatusApplyConfiguration {
+	if b.PendingPodReasons == nil && len(entries) > 0 {
+		b.PendingPodReasons = make(map[string]int32, len(entries))
+	}
+	for k, v := range entries {
+		b.PendingPodReasons[k] = v
+	}
+	return b
+}
+
+// WithLastScaleTime sets the LastScaleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastScaleTime field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithLastScaleTime(value metav1.Time) *WorkerGroupStatusApplyConfiguration {
+	b.LastScaleTime = &value
+	return b
+}
+
+// WithActiveScalingSchedule sets the ActiveScalingSchedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveScalingSchedule field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithActiveScalingSchedule(value *ActiveScalingScheduleApplyConfiguration) *WorkerGroupStatusApplyConfiguration {
+	b.ActiveScalingSchedule = value
+	return b
+}
+
+// WithPreemptions sets the Preemptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Preemptions field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithPreemptions(value int32) *WorkerGroupStatusApplyConfiguration {
+	b.Preemptions = &value
+	return b
+}
+
+// WithLastPreemptionTime sets the LastPreemptionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastPreemptionTime field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithLastPreemptionTime(value metav1.Time) *WorkerGroupStatusApplyConfiguration {
+	b.LastPreemptionTime = &value
+	return b
+}
+
+// WithRecentPreemptionTimes adds the given value to the RecentPreemptionTimes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RecentPreemptionTimes field.
+func (b *WorkerGroupStatusApplyConfiguration) WithRecentPreemptionTimes(values ...metav1.Time) *WorkerGroupStatusApplyConfiguration {
+	for i := range values {
+		b.RecentPreemptionTimes = append(b.RecentPreemptionTimes, values[i])
+	}
+	return b
+}
+
+// WithPreemptedPodUIDs adds the given value to the PreemptedPodUIDs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PreemptedPodUIDs field.
+func (b *WorkerGroupStatusApplyConfiguration) WithPreemptedPodUIDs(values ...string) *WorkerGroupStatusApplyConfiguration {
+	for i := range values {
+		b.PreemptedPodUIDs = append(b.PreemptedPodUIDs, values[i])
+	}
+	return b
+}
+
+// WithActiveFallback sets the ActiveFallback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveFallback field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithActiveFallback(value *ActiveFallbackApplyConfiguration) *WorkerGroupStatusApplyConfiguration {
+	b.ActiveFallback = value
+	return b
+}
@@ -0,0 +1,29 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// WorkerGroupTopologyApplyConfiguration represents a declarative configuration of the WorkerGroupTopology type for use
+// with apply.
+//
+// WorkerGroupTopology configures the placement of the hosts of each multi-host replica of a worker group.
+type WorkerGroupTopologyApplyConfiguration struct {
+	// RequiredTopologyKey is the key of the node label whose value defines a topology domain, such as a TPU slice or
+	// an NVLink domain. All hosts of a replica must run on nodes in the same domain. KubeRay adds a required Pod
+	// affinity to the hosts of each replica, which pins them to the domain of the first scheduled host, and
+	// recreates the replica if its hosts are scheduled onto more than one domain.
+	RequiredTopologyKey *string `json:"requiredTopologyKey,omitempty"`
+}
+
+// WorkerGroupTopologyApplyConfiguration constructs a declarative configuration of the WorkerGroupTopology type for use with
+// apply.
+func WorkerGroupTopology() *WorkerGroupTopologyApplyConfiguration {
+	return &WorkerGroupTopologyApplyConfiguration{}
+}
+
+// WithRequiredTopologyKey sets the RequiredTopologyKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequiredTopologyKey field is set to the value of the last call.
+func (b *WorkerGroupTopologyApplyConfiguration) WithRequiredTopologyKey(value string) *WorkerGroupTopologyApplyConfiguration {
+	b.RequiredTopologyKey = &value
+	return b
+}
@@ -16,10 +16,16 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=ray.io, Version=v1
+	case v1.SchemeGroupVersion.WithKind("ActiveFallback"):
+		return &rayv1.ActiveFallbackApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ActiveScalingSchedule"):
+		return &rayv1.ActiveScalingScheduleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AppStatus"):
 		return &rayv1.AppStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AuthOptions"):
 		return &rayv1.AuthOptionsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AuthTokenStatus"):
+		return &rayv1.AuthTokenStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AutoscalerOptions"):
 		return &rayv1.AutoscalerOptionsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ClusterUpgradeOptions"):
@@ -38,6 +44,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &rayv1.HeadGroupSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HeadInfo"):
 		return &rayv1.HeadInfoApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IdlePolicy"):
+		return &rayv1.IdlePolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IdleStatus"):
+		return &rayv1.IdleStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ImagePrePull"):
+		return &rayv1.ImagePrePullApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ManagedRedisOptions"):
+		return &rayv1.ManagedRedisOptionsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkIsolation"):
+		return &rayv1.NetworkIsolationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PodDisruptionBudgetSpec"):
+		return &rayv1.PodDisruptionBudgetSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayCluster"):
 		return &rayv1.RayClusterApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayClusterSpec"):
@@ -70,16 +88,40 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &rayv1.RayServiceStatusesApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayServiceUpgradeStrategy"):
 		return &rayv1.RayServiceUpgradeStrategyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayWorkerGroupScaler"):
+		return &rayv1.RayWorkerGroupScalerApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayWorkerGroupScalerSpec"):
+		return &rayv1.RayWorkerGroupScalerSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayWorkerGroupScalerStatus"):
+		return &rayv1.RayWorkerGroupScalerStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RedisCredential"):
 		return &rayv1.RedisCredentialApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ScaleStrategy"):
 		return &rayv1.ScaleStrategyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ScalingSchedule"):
+		return &rayv1.ScalingScheduleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ServeConfigV2Source"):
+		return &rayv1.ServeConfigV2SourceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServeDeploymentStatus"):
 		return &rayv1.ServeDeploymentStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ServeRolloutStrategy"):
+		return &rayv1.ServeRolloutStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SubmitterConfig"):
 		return &rayv1.SubmitterConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TLSOptions"):
+		return &rayv1.TLSOptionsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VolumeClaimRetentionPolicy"):
+		return &rayv1.VolumeClaimRetentionPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VolumeClaimTemplate"):
+		return &rayv1.VolumeClaimTemplateApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WorkerGroupFallback"):
+		return &rayv1.WorkerGroupFallbackApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WorkerGroupSpec"):
 		return &rayv1.WorkerGroupSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WorkerGroupStatus"):
+		return &rayv1.WorkerGroupStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WorkerGroupTopology"):
+		return &rayv1.WorkerGroupTopologyApplyConfiguration{}
 
 	}
 	return nil
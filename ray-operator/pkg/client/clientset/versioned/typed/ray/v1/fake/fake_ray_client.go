@@ -28,6 +28,10 @@ func (c *FakeRayV1) RayServices(namespace string) v1.RayServiceInterface {
 	return newFakeRayServices(c, namespace)
 }
 
+func (c *FakeRayV1) RayWorkerGroupScalers(namespace string) v1.RayWorkerGroupScalerInterface {
+	return newFakeRayWorkerGroupScalers(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeRayV1) RESTClient() rest.Interface {
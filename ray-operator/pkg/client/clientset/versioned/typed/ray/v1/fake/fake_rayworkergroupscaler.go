@@ -0,0 +1,66 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	context "context"
+
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/applyconfiguration/ray/v1"
+	typedrayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/typed/ray/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+	testing "k8s.io/client-go/testing"
+)
+
+// fakeRayWorkerGroupScalers implements RayWorkerGroupScalerInterface
+type fakeRayWorkerGroupScalers struct {
+	*gentype.FakeClientWithListAndApply[*v1.RayWorkerGroupScaler, *v1.RayWorkerGroupScalerList, *rayv1.RayWorkerGroupScalerApplyConfiguration]
+	Fake *FakeRayV1
+}
+
+func newFakeRayWorkerGroupScalers(fake *FakeRayV1, namespace string) typedrayv1.RayWorkerGroupScalerInterface {
+	return &fakeRayWorkerGroupScalers{
+		gentype.NewFakeClientWithListAndApply[*v1.RayWorkerGroupScaler, *v1.RayWorkerGroupScalerList, *rayv1.RayWorkerGroupScalerApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("rayworkergroupscalers"),
+			v1.SchemeGroupVersion.WithKind("RayWorkerGroupScaler"),
+			func() *v1.RayWorkerGroupScaler { return &v1.RayWorkerGroupScaler{} },
+			func() *v1.RayWorkerGroupScalerList { return &v1.RayWorkerGroupScalerList{} },
+			func(dst, src *v1.RayWorkerGroupScalerList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.RayWorkerGroupScalerList) []*v1.RayWorkerGroupScaler {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1.RayWorkerGroupScalerList, items []*v1.RayWorkerGroupScaler) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
+
+// GetScale takes name of the rayWorkerGroupScaler, and returns the corresponding scale object, and an error if there is any.
+func (c *fakeRayWorkerGroupScalers) GetScale(ctx context.Context, rayWorkerGroupScalerName string, options metav1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	emptyResult := &autoscalingv1.Scale{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceActionWithOptions(c.Resource(), c.Namespace(), "scale", rayWorkerGroupScalerName, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
+
+// UpdateScale takes the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *fakeRayWorkerGroupScalers) UpdateScale(ctx context.Context, rayWorkerGroupScalerName string, scale *autoscalingv1.Scale, opts metav1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	emptyResult := &autoscalingv1.Scale{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(c.Resource(), "scale", c.Namespace(), scale, opts), &autoscalingv1.Scale{})
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
@@ -9,3 +9,5 @@ type RayCronJobExpansion interface{}
 type RayJobExpansion interface{}
 
 type RayServiceExpansion interface{}
+
+type RayWorkerGroupScalerExpansion interface{}
@@ -16,6 +16,7 @@ type RayV1Interface interface {
 	RayCronJobsGetter
 	RayJobsGetter
 	RayServicesGetter
+	RayWorkerGroupScalersGetter
 }
 
 // RayV1Client is used to interact with features provided by the ray.io group.
@@ -39,6 +40,10 @@ func (c *RayV1Client) RayServices(namespace string) RayServiceInterface {
 	return newRayServices(c, namespace)
 }
 
+func (c *RayV1Client) RayWorkerGroupScalers(namespace string) RayWorkerGroupScalerInterface {
+	return newRayWorkerGroupScalers(c, namespace)
+}
+
 // NewForConfig creates a new RayV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,91 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	applyconfigurationrayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/applyconfiguration/ray/v1"
+	scheme "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/scheme"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// RayWorkerGroupScalersGetter has a method to return a RayWorkerGroupScalerInterface.
+// A group's client should implement this interface.
+type RayWorkerGroupScalersGetter interface {
+	RayWorkerGroupScalers(namespace string) RayWorkerGroupScalerInterface
+}
+
+// RayWorkerGroupScalerInterface has methods to work with RayWorkerGroupScaler resources.
+type RayWorkerGroupScalerInterface interface {
+	Create(ctx context.Context, rayWorkerGroupScaler *rayv1.RayWorkerGroupScaler, opts metav1.CreateOptions) (*rayv1.RayWorkerGroupScaler, error)
+	Update(ctx context.Context, rayWorkerGroupScaler *rayv1.RayWorkerGroupScaler, opts metav1.UpdateOptions) (*rayv1.RayWorkerGroupScaler, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, rayWorkerGroupScaler *rayv1.RayWorkerGroupScaler, opts metav1.UpdateOptions) (*rayv1.RayWorkerGroupScaler, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*rayv1.RayWorkerGroupScaler, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*rayv1.RayWorkerGroupScalerList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *rayv1.RayWorkerGroupScaler, err error)
+	Apply(ctx context.Context, rayWorkerGroupScaler *applyconfigurationrayv1.RayWorkerGroupScalerApplyConfiguration, opts metav1.ApplyOptions) (result *rayv1.RayWorkerGroupScaler, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, rayWorkerGroupScaler *applyconfigurationrayv1.RayWorkerGroupScalerApplyConfiguration, opts metav1.ApplyOptions) (result *rayv1.RayWorkerGroupScaler, err error)
+	GetScale(ctx context.Context, rayWorkerGroupScalerName string, options metav1.GetOptions) (*autoscalingv1.Scale, error)
+	UpdateScale(ctx context.Context, rayWorkerGroupScalerName string, scale *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error)
+
+	RayWorkerGroupScalerExpansion
+}
+
+// rayWorkerGroupScalers implements RayWorkerGroupScalerInterface
+type rayWorkerGroupScalers struct {
+	*gentype.ClientWithListAndApply[*rayv1.RayWorkerGroupScaler, *rayv1.RayWorkerGroupScalerList, *applyconfigurationrayv1.RayWorkerGroupScalerApplyConfiguration]
+}
+
+// newRayWorkerGroupScalers returns a RayWorkerGroupScalers
+func newRayWorkerGroupScalers(c *RayV1Client, namespace string) *rayWorkerGroupScalers {
+	return &rayWorkerGroupScalers{
+		gentype.NewClientWithListAndApply[*rayv1.RayWorkerGroupScaler, *rayv1.RayWorkerGroupScalerList, *applyconfigurationrayv1.RayWorkerGroupScalerApplyConfiguration](
+			"rayworkergroupscalers",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *rayv1.RayWorkerGroupScaler { return &rayv1.RayWorkerGroupScaler{} },
+			func() *rayv1.RayWorkerGroupScalerList { return &rayv1.RayWorkerGroupScalerList{} },
+		),
+	}
+}
+
+// GetScale takes name of the rayWorkerGroupScaler, and returns the corresponding autoscalingv1.Scale object, and an error if there is any.
+func (c *rayWorkerGroupScalers) GetScale(ctx context.Context, rayWorkerGroupScalerName string, options metav1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.GetClient().Get().
+		Namespace(c.GetNamespace()).
+		Resource("rayworkergroupscalers").
+		Name(rayWorkerGroupScalerName).
+		SubResource("scale").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateScale takes the top resource name and the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *rayWorkerGroupScalers) UpdateScale(ctx context.Context, rayWorkerGroupScalerName string, scale *autoscalingv1.Scale, opts metav1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.GetClient().Put().
+		Namespace(c.GetNamespace()).
+		Resource("rayworkergroupscalers").
+		Name(rayWorkerGroupScalerName).
+		SubResource("scale").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scale).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -16,6 +16,8 @@ type Interface interface {
 	RayJobs() RayJobInformer
 	// RayServices returns a RayServiceInformer.
 	RayServices() RayServiceInformer
+	// RayWorkerGroupScalers returns a RayWorkerGroupScalerInformer.
+	RayWorkerGroupScalers() RayWorkerGroupScalerInformer
 }
 
 type version struct {
@@ -48,3 +50,8 @@ func (v *version) RayJobs() RayJobInformer {
 func (v *version) RayServices() RayServiceInformer {
 	return &rayServiceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// RayWorkerGroupScalers returns a RayWorkerGroupScalerInformer.
+func (v *version) RayWorkerGroupScalers() RayWorkerGroupScalerInformer {
+	return &rayWorkerGroupScalerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,86 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	time "time"
+
+	apisrayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	versioned "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/ray-project/kuberay/ray-operator/pkg/client/informers/externalversions/internalinterfaces"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/listers/ray/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// RayWorkerGroupScalerInformer provides access to a shared informer and lister for
+// RayWorkerGroupScalers.
+type RayWorkerGroupScalerInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() rayv1.RayWorkerGroupScalerLister
+}
+
+type rayWorkerGroupScalerInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewRayWorkerGroupScalerInformer constructs a new informer for RayWorkerGroupScaler type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewRayWorkerGroupScalerInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredRayWorkerGroupScalerInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredRayWorkerGroupScalerInformer constructs a new informer for RayWorkerGroupScaler type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredRayWorkerGroupScalerInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RayV1().RayWorkerGroupScalers(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RayV1().RayWorkerGroupScalers(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RayV1().RayWorkerGroupScalers(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RayV1().RayWorkerGroupScalers(namespace).Watch(ctx, options)
+			},
+		}, client),
+		&apisrayv1.RayWorkerGroupScaler{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *rayWorkerGroupScalerInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredRayWorkerGroupScalerInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *rayWorkerGroupScalerInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apisrayv1.RayWorkerGroupScaler{}, f.defaultInformer)
+}
+
+func (f *rayWorkerGroupScalerInformer) Lister() rayv1.RayWorkerGroupScalerLister {
+	return rayv1.NewRayWorkerGroupScalerLister(f.Informer().GetIndexer())
+}
@@ -33,3 +33,11 @@ type RayServiceListerExpansion interface{}
 // RayServiceNamespaceListerExpansion allows custom methods to be added to
 // RayServiceNamespaceLister.
 type RayServiceNamespaceListerExpansion interface{}
+
+// RayWorkerGroupScalerListerExpansion allows custom methods to be added to
+// RayWorkerGroupScalerLister.
+type RayWorkerGroupScalerListerExpansion interface{}
+
+// RayWorkerGroupScalerNamespaceListerExpansion allows custom methods to be added to
+// RayWorkerGroupScalerNamespaceLister.
+type RayWorkerGroupScalerNamespaceListerExpansion interface{}
@@ -0,0 +1,54 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// RayWorkerGroupScalerLister helps list RayWorkerGroupScalers.
+// All objects returned here must be treated as read-only.
+type RayWorkerGroupScalerLister interface {
+	// List lists all RayWorkerGroupScalers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*rayv1.RayWorkerGroupScaler, err error)
+	// RayWorkerGroupScalers returns an object that can list and get RayWorkerGroupScalers.
+	RayWorkerGroupScalers(namespace string) RayWorkerGroupScalerNamespaceLister
+	RayWorkerGroupScalerListerExpansion
+}
+
+// rayWorkerGroupScalerLister implements the RayWorkerGroupScalerLister interface.
+type rayWorkerGroupScalerLister struct {
+	listers.ResourceIndexer[*rayv1.RayWorkerGroupScaler]
+}
+
+// NewRayWorkerGroupScalerLister returns a new RayWorkerGroupScalerLister.
+func NewRayWorkerGroupScalerLister(indexer cache.Indexer) RayWorkerGroupScalerLister {
+	return &rayWorkerGroupScalerLister{listers.New[*rayv1.RayWorkerGroupScaler](indexer, rayv1.Resource("rayworkergroupscaler"))}
+}
+
+// RayWorkerGroupScalers returns an object that can list and get RayWorkerGroupScalers.
+func (s *rayWorkerGroupScalerLister) RayWorkerGroupScalers(namespace string) RayWorkerGroupScalerNamespaceLister {
+	return rayWorkerGroupScalerNamespaceLister{listers.NewNamespaced[*rayv1.RayWorkerGroupScaler](s.ResourceIndexer, namespace)}
+}
+
+// RayWorkerGroupScalerNamespaceLister helps list and get RayWorkerGroupScalers.
+// All objects returned here must be treated as read-only.
+type RayWorkerGroupScalerNamespaceLister interface {
+	// List lists all RayWorkerGroupScalers in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*rayv1.RayWorkerGroupScaler, err error)
+	// Get retrieves the RayWorkerGroupScaler from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*rayv1.RayWorkerGroupScaler, error)
+	RayWorkerGroupScalerNamespaceListerExpansion
+}
+
+// rayWorkerGroupScalerNamespaceLister implements the RayWorkerGroupScalerNamespaceLister
+// interface.
+type rayWorkerGroupScalerNamespaceLister struct {
+	listers.ResourceIndexer[*rayv1.RayWorkerGroupScaler]
+}
@@ -54,6 +54,12 @@ const (
 	//
 	// Enables asynchronous job info querying.
 	AsyncJobInfoQuery featuregate.Feature = "AsyncJobInfoQuery"
+
+	// rep: N/A
+	// alpha: v1.6
+	//
+	// Enables RayWorkerGroupScaler controller which exposes the scale subresource for a single worker group.
+	RayWorkerGroupScaler featuregate.Feature = "RayWorkerGroupScaler"
 )
 
 func init() {
@@ -67,6 +73,7 @@ var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	RayServiceIncrementalUpgrade: {Default: false, PreRelease: featuregate.Alpha},
 	RayCronJob:                   {Default: false, PreRelease: featuregate.Alpha},
 	AsyncJobInfoQuery:            {Default: false, PreRelease: featuregate.Alpha},
+	RayWorkerGroupScaler:         {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // SetFeatureGateDuringTest is a helper method to override feature gates in tests.